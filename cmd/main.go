@@ -6,10 +6,19 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
+	"time"
+	_ "time/tzdata"
 
 	"pr-review/internal/config"
+	"pr-review/internal/cron"
+	"pr-review/internal/http/api"
+	"pr-review/internal/http/apiv2"
+	"pr-review/internal/http/errors"
 	"pr-review/internal/http/handlers"
+	"pr-review/internal/http/middleware"
+	"pr-review/internal/notify"
 	"pr-review/internal/repo/postgres"
 	"pr-review/internal/service"
 
@@ -18,15 +27,27 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "snapshot" {
+		if err := runSnapshot(os.Args[2:]); err != nil {
+			log.Fatalf("snapshot: %v", err)
+		}
+		return
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	db := setupDatabase(ctx)
 	defer closeDatabase(db)
 
-	services := setupServices(db)
-	handlers := setupHandlers(services)
-	router := setupRouter(handlers)
+	digestConfig := config.LoadDigestConfig()
+	digestSchedule := setupDigestSchedule(digestConfig)
+
+	orgService := service.NewOrganizationService(postgres.NewOrganizationRepository(db), config.LoadTenantConfig())
+	tenants := newTenants(db, setupNotifier(digestConfig))
+	go tenants.runJobs(ctx, orgService, digestSchedule)
+
+	router := setupRouter(tenants, orgService)
 
 	server := startServer(router)
 	defer shutdownServer(server, db)
@@ -51,42 +72,169 @@ func closeDatabase(db *pgxpool.Pool) {
 }
 
 type Services struct {
-	prService   *service.PullRequestService
-	teamService *service.TeamService
-	userService *service.UserService
+	prService           *service.PullRequestService
+	teamService         *service.TeamService
+	userService         *service.UserService
+	availabilityService *service.AvailabilityService
+	slaService          *service.SLAService
+	digestService       *service.DigestService
+	affinityService     *service.AffinityService
+	repositoryService   *service.RepositoryService
+	expertiseService    *service.ExpertiseService
+	bulkService         *service.BulkService
 }
 
-func setupServices(db *pgxpool.Pool) *Services {
-	teamRepo := postgres.NewTeamRepository(db)
-	userRepo := postgres.NewUserRepository(db)
-	prRepo := postgres.NewPullRequestRepository(db)
-
-	prService := service.NewPullRequestService(prRepo, userRepo, teamRepo)
+func setupServices(db *pgxpool.Pool, orgID string, notifier service.Notifier) *Services {
+	teamRepo := postgres.NewTeamRepository(db, orgID)
+	userRepo := postgres.NewUserRepository(db, orgID)
+	prRepo := postgres.NewPullRequestRepository(db, orgID)
+	availabilityRepo := postgres.NewAvailabilityRepository(db, orgID)
+	slaRepo := postgres.NewSLARepository(db, orgID)
+	digestRepo := postgres.NewDigestRepository(db, orgID)
+	affinityRepo := postgres.NewAffinityRepository(db, orgID)
+	repositoryRepo := postgres.NewRepositoryRepository(db, orgID)
+	expertiseRepo := postgres.NewExpertiseRepository(db, orgID)
+	bulkRepo := postgres.NewBulkRepository(db, orgID)
+
+	prService := service.NewPullRequestService(prRepo, userRepo, teamRepo, availabilityRepo, affinityRepo, repositoryRepo, expertiseRepo)
 	teamService := service.NewTeamService(teamRepo, userRepo)
 	userService := service.NewUserService(userRepo, prService)
+	availabilityService := service.NewAvailabilityService(availabilityRepo, userRepo, prService)
+	slaService := service.NewSLAService(slaRepo, teamRepo, prService, service.LogEscalationPublisher{})
+	digestService := service.NewDigestService(digestRepo, prRepo, userRepo, notifier)
+	affinityService := service.NewAffinityService(affinityRepo, userRepo)
+	repositoryService := service.NewRepositoryService(repositoryRepo, teamRepo)
+	expertiseService := service.NewExpertiseService(expertiseRepo, userRepo)
+	bulkService := service.NewBulkService(bulkRepo, teamRepo, userRepo, prRepo, teamService)
 
 	return &Services{
-		prService:   prService,
-		teamService: teamService,
-		userService: userService,
+		prService:           prService,
+		teamService:         teamService,
+		userService:         userService,
+		availabilityService: availabilityService,
+		slaService:          slaService,
+		digestService:       digestService,
+		affinityService:     affinityService,
+		repositoryService:   repositoryService,
+		expertiseService:    expertiseService,
+		bulkService:         bulkService,
 	}
 }
 
-type Handlers struct {
-	teamHandler *handlers.TeamHandler
-	userHandler *handlers.UserHandler
-	prHandler   *handlers.PullRequestHandler
+type Tenants struct {
+	db       *pgxpool.Pool
+	notifier service.Notifier
+
+	mu       sync.Mutex
+	services map[string]*Services
+	handlers map[string]*handlers.Handlers
 }
 
-func setupHandlers(services *Services) *Handlers {
-	return &Handlers{
-		teamHandler: handlers.NewTeamHandler(services.teamService),
-		userHandler: handlers.NewUserHandler(services.userService),
-		prHandler:   handlers.NewPullRequestHandler(services.prService),
+func newTenants(db *pgxpool.Pool, notifier service.Notifier) *Tenants {
+	return &Tenants{
+		db:       db,
+		notifier: notifier,
+		services: make(map[string]*Services),
+		handlers: make(map[string]*handlers.Handlers),
+	}
+}
+
+func (t *Tenants) Services(orgID string) *Services {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.servicesLocked(orgID)
+}
+
+func (t *Tenants) Handlers(orgID string) *handlers.Handlers {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if h, ok := t.handlers[orgID]; ok {
+		return h
 	}
+	h := setupHandlers(t.servicesLocked(orgID))
+	t.handlers[orgID] = h
+	return h
 }
 
-func setupRouter(handlers *Handlers) *gin.Engine {
+func (t *Tenants) servicesLocked(orgID string) *Services {
+	if s, ok := t.services[orgID]; ok {
+		return s
+	}
+	s := setupServices(t.db, orgID, t.notifier)
+	t.services[orgID] = s
+	return s
+}
+
+func (t *Tenants) runJobs(ctx context.Context, orgService *service.OrganizationService, digestSchedule *cron.Schedule) {
+	ticker := time.NewTicker(config.OrganizationRefreshInterval)
+	defer ticker.Stop()
+
+	started := make(map[string]bool)
+	for {
+		organizations, err := orgService.ListOrganizations()
+		if err != nil {
+			log.Printf("Failed to list organizations for background jobs: %v", err)
+		}
+		for _, org := range organizations {
+			if started[org.ID] {
+				continue
+			}
+			started[org.ID] = true
+
+			services := t.Services(org.ID)
+			go services.availabilityService.Run(ctx, config.AvailabilityCheckInterval)
+			go services.slaService.Run(ctx, config.SLACheckInterval)
+			go services.digestService.Run(ctx, digestSchedule)
+			log.Printf("Background jobs started for organization %s", org.ID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func setupDigestSchedule(digestConfig *config.DigestConfig) *cron.Schedule {
+	schedule, err := cron.Parse(digestConfig.Schedule)
+	if err != nil {
+		log.Fatalf("Invalid DIGEST_SCHEDULE %q: %v", digestConfig.Schedule, err)
+	}
+	return schedule
+}
+
+func setupNotifier(digestConfig *config.DigestConfig) service.Notifier {
+	switch digestConfig.Notifier {
+	case config.DigestNotifierSMTP:
+		return notify.NewSMTPNotifier(digestConfig.SMTP)
+	case config.DigestNotifierWebhook:
+		if digestConfig.WebhookURL == "" {
+			log.Fatalf("DIGEST_WEBHOOK_URL is required for the webhook digest notifier")
+		}
+		return notify.NewWebhookNotifier(digestConfig.WebhookURL)
+	case config.DigestNotifierLog:
+		return notify.LogNotifier{}
+	default:
+		log.Fatalf("Unknown DIGEST_NOTIFIER %q", digestConfig.Notifier)
+		return nil
+	}
+}
+
+func setupHandlers(services *Services) *handlers.Handlers {
+	return &handlers.Handlers{
+		Team:        handlers.NewTeamHandler(services.teamService),
+		User:        handlers.NewUserHandler(services.userService, services.availabilityService, services.digestService, services.expertiseService),
+		PullRequest: handlers.NewPullRequestHandler(services.prService),
+		SLA:         handlers.NewSLAHandler(services.slaService),
+		Affinity:    handlers.NewAffinityHandler(services.affinityService),
+		Repository:  handlers.NewRepositoryHandler(services.repositoryService),
+		Admin:       handlers.NewAdminHandler(services.bulkService),
+	}
+}
+
+func setupRouter(tenants *Tenants, orgService *service.OrganizationService) *gin.Engine {
 	if getEnv("GIN_MODE", "release") == "debug" {
 		gin.SetMode(gin.DebugMode)
 	} else {
@@ -103,37 +251,35 @@ func setupRouter(handlers *Handlers) *gin.Engine {
 			"status": "ok",
 		})
 	})
+	router.GET(config.ErrorDocsPath, errors.Docs)
 
-	setupTeamRoutes(router, handlers.teamHandler)
-	setupUserRoutes(router, handlers.userHandler)
-	setupPullRequestRoutes(router, handlers.prHandler)
-
-	return router
-}
-
-func setupTeamRoutes(router *gin.Engine, teamHandler *handlers.TeamHandler) {
-	teamRoutes := router.Group("/team")
-	{
-		teamRoutes.POST("/add", teamHandler.Add)
-		teamRoutes.GET("/get", teamHandler.Get)
+	spec, err := api.GetSwagger()
+	if err != nil {
+		log.Fatalf("Failed to load OpenAPI spec: %v", err)
+	}
+	validation, err := middleware.Validation(spec, gin.IsDebugging())
+	if err != nil {
+		log.Fatalf("Failed to build OpenAPI validation: %v", err)
 	}
-}
 
-func setupUserRoutes(router *gin.Engine, userHandler *handlers.UserHandler) {
-	userRoutes := router.Group("/users")
-	{
-		userRoutes.POST("/setIsActive", userHandler.SetIsActive)
-		userRoutes.GET("/getReview", userHandler.GetReview)
+	specV2, err := apiv2.GetSwagger()
+	if err != nil {
+		log.Fatalf("Failed to load OpenAPI v2 spec: %v", err)
+	}
+	validationV2, err := middleware.Validation(specV2, gin.IsDebugging())
+	if err != nil {
+		log.Fatalf("Failed to build OpenAPI v2 validation: %v", err)
 	}
-}
 
-func setupPullRequestRoutes(router *gin.Engine, prHandler *handlers.PullRequestHandler) {
-	prRoutes := router.Group("/pullRequest")
-	{
-		prRoutes.POST("/create", prHandler.Create)
-		prRoutes.POST("/merge", prHandler.Merge)
-		prRoutes.POST("/reassign", prHandler.Reassign)
+	resolve := func(c *gin.Context) *handlers.Handlers {
+		return tenants.Handlers(middleware.OrganizationID(c))
 	}
+	group := router.Group("/", middleware.Tenant(orgService), validation)
+	handlers.Register(group, handlers.NewServer(resolve))
+	groupV2 := router.Group("/", middleware.Tenant(orgService), validationV2)
+	handlers.RegisterV2(groupV2, handlers.NewServerV2(resolve))
+
+	return router
 }
 
 func startServer(router *gin.Engine) *http.Server {
@@ -0,0 +1,361 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"pr-review/pkg/client"
+
+	"gopkg.in/yaml.v3"
+)
+
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func parseFlags(flags *flag.FlagSet, args []string, positional ...string) ([]string, error) {
+	flags.SetOutput(io.Discard)
+	if err := flags.Parse(args); err != nil {
+		return nil, usageError("%s: %v", flags.Name(), err)
+	}
+	if flags.NArg() != len(positional) {
+		return nil, usageError("%s: expected arguments: %s", flags.Name(), strings.Join(positional, " "))
+	}
+	return flags.Args(), nil
+}
+
+func (a *app) teamAdd(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("team add", flag.ContinueOnError)
+	file := flags.String("f", "", "YAML file with one team per document (- for stdin)")
+	if _, err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return usageError("team add: -f is required")
+	}
+
+	teams, err := a.readTeams(*file)
+	if err != nil {
+		return err
+	}
+
+	created := make([]*client.Team, 0, len(teams))
+	for _, team := range teams {
+		result, err := a.client.AddTeam(ctx, team)
+		if err != nil {
+			return fmt.Errorf("team %s: %w", team.TeamName, err)
+		}
+		created = append(created, result)
+	}
+
+	rows := make([][]string, 0)
+	for _, team := range created {
+		rows = append(rows, memberRows(team)...)
+	}
+	return a.out.print(created, memberHeader, rows)
+}
+
+func (a *app) readTeams(path string) ([]*client.Team, error) {
+	var r io.Reader = a.stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	teams := make([]*client.Team, 0)
+	decoder := yaml.NewDecoder(r)
+	for {
+		var team client.Team
+		err := decoder.Decode(&team)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid team file %s: %w", path, err)
+		}
+		if team.TeamName == "" {
+			return nil, fmt.Errorf("invalid team file %s: team %d has no team_name", path, len(teams)+1)
+		}
+		teams = append(teams, &team)
+	}
+	if len(teams) == 0 {
+		return nil, fmt.Errorf("team file %s contains no teams", path)
+	}
+	return teams, nil
+}
+
+var memberHeader = []string{"TEAM", "USER_ID", "USERNAME", "ACTIVE", "GRADE"}
+
+func memberRows(team *client.Team) [][]string {
+	rows := make([][]string, 0, len(team.Members))
+	for _, member := range team.Members {
+		rows = append(rows, []string{team.TeamName, member.UserID, member.Username, formatBool(member.IsActive), orDash(string(member.Grade))})
+	}
+	return rows
+}
+
+func (a *app) teamGet(ctx context.Context, args []string) error {
+	args, err := parseFlags(flag.NewFlagSet("team get", flag.ContinueOnError), args, "TEAM")
+	if err != nil {
+		return err
+	}
+
+	team, err := a.client.GetTeam(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return a.out.print(team, memberHeader, memberRows(team))
+}
+
+func (a *app) teamStats(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("team stats", flag.ContinueOnError)
+	subTeams := flags.Bool("sub-teams", false, "include all sub-teams")
+	args, err := parseFlags(flags, args, "TEAM")
+	if err != nil {
+		return err
+	}
+
+	stats, err := a.client.GetTeamStats(ctx, args[0], *subTeams)
+	if err != nil {
+		return err
+	}
+	rows := [][]string{
+		{"teams", formatList(stats.Teams)},
+		{"members", strconv.Itoa(stats.Members)},
+		{"active_members", strconv.Itoa(stats.ActiveMembers)},
+		{"open_pull_requests", strconv.Itoa(stats.OpenPullRequests)},
+		{"merged_pull_requests", strconv.Itoa(stats.MergedPullRequests)},
+		{"open_review_assignments", strconv.Itoa(stats.OpenReviewAssignments)},
+	}
+	return a.out.print(stats, []string{"FIELD", "VALUE"}, rows)
+}
+
+var userHeader = []string{"USER_ID", "USERNAME", "TEAM", "ACTIVE", "GRADE"}
+
+func userRow(user *client.User) []string {
+	return []string{user.UserID, user.Username, user.TeamName, formatBool(user.IsActive), orDash(string(user.Grade))}
+}
+
+func (a *app) userGet(ctx context.Context, args []string) error {
+	args, err := parseFlags(flag.NewFlagSet("user get", flag.ContinueOnError), args, "USER_ID")
+	if err != nil {
+		return err
+	}
+
+	user, err := a.client.GetUser(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return a.out.print(user, userHeader, [][]string{userRow(user)})
+}
+
+func (a *app) userList(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("user list", flag.ContinueOnError)
+	opts := client.ListUsersOptions{}
+	flags.StringVar(&opts.TeamName, "team", "", "only members of the team")
+	active := flags.String("active", "", "only active (true) or inactive (false) users")
+	flags.StringVar(&opts.UsernamePrefix, "prefix", "", "username prefix")
+	flags.IntVar(&opts.Limit, "limit", 0, "page size")
+	flags.IntVar(&opts.Offset, "offset", 0, "page offset")
+	if _, err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if *active != "" {
+		value, err := strconv.ParseBool(*active)
+		if err != nil {
+			return usageError("user list: -active must be true or false")
+		}
+		opts.IsActive = &value
+	}
+
+	page, err := a.client.ListUsers(ctx, opts)
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, len(page.Users))
+	for i, user := range page.Users {
+		rows[i] = userRow(user)
+	}
+	return a.out.print(page, userHeader, rows)
+}
+
+func (a *app) userActivate(ctx context.Context, args []string) error {
+	args, err := parseFlags(flag.NewFlagSet("user activate", flag.ContinueOnError), args, "USER_ID")
+	if err != nil {
+		return err
+	}
+	return a.setIsActive(ctx, &client.SetIsActiveRequest{UserID: args[0], IsActive: true})
+}
+
+func (a *app) userDeactivate(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("user deactivate", flag.ContinueOnError)
+	reassign := flags.Bool("reassign", false, "reassign open reviews (default: team policy)")
+	args, err := parseFlags(flags, args, "USER_ID")
+	if err != nil {
+		return err
+	}
+
+	req := &client.SetIsActiveRequest{UserID: args[0], IsActive: false}
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name == "reassign" {
+			req.ReassignReviews = reassign
+		}
+	})
+	return a.setIsActive(ctx, req)
+}
+
+func (a *app) setIsActive(ctx context.Context, req *client.SetIsActiveRequest) error {
+	result, err := a.client.SetIsActive(ctx, req)
+	if err != nil {
+		return err
+	}
+	if a.out.format == outputJSON || result.Handover == nil {
+		return a.out.print(result, userHeader, [][]string{userRow(result.User)})
+	}
+
+	if err := a.out.print(result, userHeader, [][]string{userRow(result.User)}); err != nil {
+		return err
+	}
+	fmt.Fprintln(a.out.w)
+	return a.out.print(result.Handover, handoverHeader, handoverRows(result.Handover))
+}
+
+var handoverHeader = []string{"PR_ID", "OLD_REVIEWER", "NEW_REVIEWER"}
+
+func handoverRows(report *client.ReassignmentReport) [][]string {
+	rows := make([][]string, 0, len(report.Reassignments)+len(report.UnreplacedPRs))
+	for _, r := range report.Reassignments {
+		rows = append(rows, []string{r.PullRequestID, r.OldReviewerID, r.NewReviewerID})
+	}
+	for _, id := range report.UnreplacedPRs {
+		rows = append(rows, []string{id, "-", "-"})
+	}
+	return rows
+}
+
+var pullRequestHeader = []string{"PR_ID", "NAME", "AUTHOR", "STATUS", "REVIEWERS", "PRIORITY", "CREATED", "MERGED"}
+
+func pullRequestRow(pr *client.PullRequest) []string {
+	return []string{
+		pr.PullRequestID,
+		pr.PullRequestName,
+		pr.AuthorID,
+		string(pr.Status),
+		formatList(pr.AssignedReviewers),
+		orDash(string(pr.Priority)),
+		formatTime(pr.CreatedAt),
+		formatTime(pr.MergedAt),
+	}
+}
+
+func (a *app) prCreate(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("pr create", flag.ContinueOnError)
+	req := &client.CreatePullRequestRequest{}
+	var labels, files stringList
+	flags.StringVar(&req.PullRequestID, "id", "", "pull request ID")
+	flags.StringVar(&req.PullRequestName, "name", "", "pull request title")
+	flags.StringVar(&req.AuthorID, "author", "", "author user ID")
+	flags.StringVar(&req.Repository, "repo", "", "repository name")
+	flags.StringVar(&req.Description, "description", "", "description")
+	priority := flags.String("priority", "", "priority: low, normal or high")
+	flags.Var(&labels, "label", "label (repeatable)")
+	flags.Var(&files, "file", "changed file path (repeatable)")
+	if _, err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if req.PullRequestID == "" || req.PullRequestName == "" || req.AuthorID == "" {
+		return usageError("pr create: -id, -name and -author are required")
+	}
+	req.Priority = client.Priority(*priority)
+	req.Labels = labels
+	req.ChangedFiles = files
+
+	pr, err := a.client.CreatePullRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+	return a.out.print(pr, pullRequestHeader, [][]string{pullRequestRow(pr)})
+}
+
+func (a *app) prMerge(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("pr merge", flag.ContinueOnError)
+	mergedBy := flags.String("by", "", "user who merges the pull request")
+	args, err := parseFlags(flags, args, "PR_ID")
+	if err != nil {
+		return err
+	}
+
+	pr, err := a.client.MergePullRequest(ctx, args[0], *mergedBy)
+	if err != nil {
+		return err
+	}
+	return a.out.print(pr, pullRequestHeader, [][]string{pullRequestRow(pr)})
+}
+
+func (a *app) prApprove(ctx context.Context, args []string) error {
+	args, err := parseFlags(flag.NewFlagSet("pr approve", flag.ContinueOnError), args, "PR_ID", "USER_ID")
+	if err != nil {
+		return err
+	}
+
+	pr, err := a.client.ApprovePullRequest(ctx, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	return a.out.print(pr, pullRequestHeader, [][]string{pullRequestRow(pr)})
+}
+
+func (a *app) prReassign(ctx context.Context, args []string) error {
+	args, err := parseFlags(flag.NewFlagSet("pr reassign", flag.ContinueOnError), args, "PR_ID", "OLD_REVIEWER_ID")
+	if err != nil {
+		return err
+	}
+
+	result, err := a.client.ReassignReviewer(ctx, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	rows := [][]string{{result.PR.PullRequestID, args[1], result.ReplacedBy, formatList(result.PR.AssignedReviewers)}}
+	return a.out.print(result, []string{"PR_ID", "OLD_REVIEWER", "NEW_REVIEWER", "REVIEWERS"}, rows)
+}
+
+func (a *app) queue(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("queue", flag.ContinueOnError)
+	var labels stringList
+	flags.Var(&labels, "label", "only pull requests with the label (repeatable)")
+	priority := flags.String("priority", "", "only pull requests with the priority")
+	args, err := parseFlags(flags, args, "USER_ID")
+	if err != nil {
+		return err
+	}
+
+	prs, err := a.client.GetReview(ctx, args[0], client.ReviewQueueOptions{
+		Labels:   labels,
+		Priority: client.Priority(*priority),
+	})
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, len(prs))
+	for i, pr := range prs {
+		rows[i] = []string{pr.PullRequestID, pr.PullRequestName, pr.AuthorID, string(pr.Status), orDash(string(pr.Priority)), formatList(pr.Labels)}
+	}
+	return a.out.print(prs, []string{"PR_ID", "NAME", "AUTHOR", "STATUS", "PRIORITY", "LABELS"}, rows)
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+type Config struct {
+	BaseURL      string `yaml:"base_url"`
+	Token        string `yaml:"token"`
+	Organization string `yaml:"organization"`
+	Output       string `yaml:"output"`
+}
+
+func defaultConfigPath() string {
+	if path := os.Getenv("PRCTL_CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "prctl", "config.yaml")
+}
+
+func loadConfig(path string, required bool) (*Config, error) {
+	cfg := &Config{}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist) && !required:
+		case err != nil:
+			return nil, err
+		default:
+			if err := yaml.Unmarshal(data, cfg); err != nil {
+				return nil, fmt.Errorf("invalid config %s: %w", path, err)
+			}
+		}
+	}
+
+	overrideFromEnv(&cfg.BaseURL, "PRCTL_URL")
+	overrideFromEnv(&cfg.Token, "PRCTL_TOKEN")
+	overrideFromEnv(&cfg.Organization, "PRCTL_ORGANIZATION")
+	overrideFromEnv(&cfg.Output, "PRCTL_OUTPUT")
+	return cfg, nil
+}
+
+func overrideFromEnv(field *string, key string) {
+	if value := os.Getenv(key); value != "" {
+		*field = value
+	}
+}
+
+func (c *Config) validate() error {
+	if c.Output == "" {
+		c.Output = outputTable
+	}
+	if c.Output != outputTable && c.Output != outputJSON {
+		return fmt.Errorf("output must be one of: %s, %s", outputTable, outputJSON)
+	}
+	return nil
+}
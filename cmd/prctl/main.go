@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"pr-review/pkg/client"
+)
+
+const usage = `Usage: prctl [flags] <command> [command flags] [args]
+
+Commands:
+  team add -f FILE                      Create teams from a YAML file
+  team get TEAM                         Show a team and its members
+  team stats [-sub-teams] TEAM          Show team statistics
+  user get USER_ID                      Show a user
+  user list [-team T] [-active BOOL]    List users
+  user activate USER_ID                 Mark a user as active
+  user deactivate [-reassign BOOL] USER_ID
+                                        Mark a user as inactive
+  pr create -id ID -name NAME -author USER_ID [-label L] [-file F] [-priority P]
+                                        Create a pull request and assign reviewers
+  pr merge [-by USER_ID] PR_ID          Merge a pull request
+  pr approve PR_ID USER_ID              Approve a pull request as a reviewer
+  pr reassign PR_ID OLD_REVIEWER_ID     Replace a reviewer
+  queue [-label L] [-priority P] USER_ID
+                                        List pull requests waiting for a reviewer
+
+Flags:
+`
+
+type errUsage struct {
+	message string
+}
+
+func (e *errUsage) Error() string {
+	return e.message
+}
+
+func usageError(format string, args ...any) error {
+	return &errUsage{message: fmt.Sprintf(format, args...)}
+}
+
+type app struct {
+	client *client.Client
+	out    *printer
+	stdin  io.Reader
+}
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if err := run(ctx, os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, "prctl:", err)
+		var usageErr *errUsage
+		if errors.As(err, &usageErr) {
+			os.Exit(2)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("prctl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+	configPath := flags.String("config", defaultConfigPath(), "config file (env PRCTL_CONFIG)")
+	baseURL := flags.String("url", "", "API base URL (env PRCTL_URL, default "+client.DefaultBaseURL+")")
+	token := flags.String("token", "", "organization access token (env PRCTL_TOKEN)")
+	organization := flags.String("org", "", "organization ID sent in "+client.OrganizationHeader+" (env PRCTL_ORGANIZATION)")
+	output := flags.String("o", "", "output format: table or json (env PRCTL_OUTPUT)")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return &errUsage{message: err.Error()}
+	}
+
+	configRequired := false
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name == "config" {
+			configRequired = true
+		}
+	})
+	cfg, err := loadConfig(*configPath, configRequired)
+	if err != nil {
+		return err
+	}
+	overrideFromFlag(&cfg.BaseURL, *baseURL)
+	overrideFromFlag(&cfg.Token, *token)
+	overrideFromFlag(&cfg.Organization, *organization)
+	overrideFromFlag(&cfg.Output, *output)
+	if err := cfg.validate(); err != nil {
+		return &errUsage{message: err.Error()}
+	}
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return usageError("command is required")
+	}
+
+	c, err := client.New(client.Config{
+		BaseURL:      cfg.BaseURL,
+		Token:        cfg.Token,
+		Organization: cfg.Organization,
+	})
+	if err != nil {
+		return err
+	}
+
+	a := &app{
+		client: c,
+		out:    &printer{format: cfg.Output, w: stdout},
+		stdin:  os.Stdin,
+	}
+	return a.dispatch(ctx, flags.Args())
+}
+
+func overrideFromFlag(field *string, value string) {
+	if value != "" {
+		*field = value
+	}
+}
+
+func (a *app) dispatch(ctx context.Context, args []string) error {
+	commands := map[string]func(context.Context, []string) error{
+		"team add":        a.teamAdd,
+		"team get":        a.teamGet,
+		"team stats":      a.teamStats,
+		"user get":        a.userGet,
+		"user list":       a.userList,
+		"user activate":   a.userActivate,
+		"user deactivate": a.userDeactivate,
+		"pr create":       a.prCreate,
+		"pr merge":        a.prMerge,
+		"pr approve":      a.prApprove,
+		"pr reassign":     a.prReassign,
+	}
+
+	if args[0] == "queue" {
+		return a.queue(ctx, args[1:])
+	}
+	if len(args) < 2 {
+		return usageError("unknown command %q", args[0])
+	}
+	command, ok := commands[args[0]+" "+args[1]]
+	if !ok {
+		return usageError("unknown command %q", strings.Join(args[:2], " "))
+	}
+	return command(ctx, args[2:])
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/repo/postgres"
+	"pr-review/internal/service"
+)
+
+const snapshotUsage = `Usage:
+  pr-review snapshot create [-o file]
+  pr-review snapshot restore [-i file]`
+
+func runSnapshot(args []string) error {
+	if len(args) == 0 {
+		return errors.New(snapshotUsage)
+	}
+
+	switch args[0] {
+	case "create":
+		flags := flag.NewFlagSet("snapshot create", flag.ContinueOnError)
+		output := flags.String("o", "", "archive path (default: stdout)")
+		if err := flags.Parse(args[1:]); err != nil {
+			return err
+		}
+		return createSnapshot(*output)
+	case "restore":
+		flags := flag.NewFlagSet("snapshot restore", flag.ContinueOnError)
+		input := flags.String("i", "", "archive path (default: stdin)")
+		if err := flags.Parse(args[1:]); err != nil {
+			return err
+		}
+		return restoreSnapshot(*input)
+	default:
+		return fmt.Errorf("unknown snapshot command %q\n%s", args[0], snapshotUsage)
+	}
+}
+
+func createSnapshot(output string) error {
+	snapshotService, closeDB, err := setupSnapshotService()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	if output == "" {
+		manifest, err := snapshotService.Create(os.Stdout)
+		if err != nil {
+			return err
+		}
+		logSnapshot("created", manifest)
+		return nil
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	manifest, err := snapshotService.Create(file)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(output)
+		return err
+	}
+
+	logSnapshot("created", manifest)
+	return nil
+}
+
+func restoreSnapshot(input string) error {
+	var r io.Reader = os.Stdin
+	if input != "" {
+		file, err := os.Open(input)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	snapshotService, closeDB, err := setupSnapshotService()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	manifest, err := snapshotService.Restore(r)
+	if err != nil {
+		return err
+	}
+
+	logSnapshot("restored", manifest)
+	return nil
+}
+
+func logSnapshot(action string, manifest *entity.SnapshotManifest) {
+	var rows int64
+	for _, table := range manifest.Tables {
+		rows += table.Rows
+	}
+	log.Printf("Snapshot %s: schema version %d, created at %s, %d tables, %d rows",
+		action, manifest.SchemaVersion, manifest.CreatedAt.Format(time.RFC3339), len(manifest.Tables), rows)
+}
+
+func setupSnapshotService() (*service.SnapshotService, func(), error) {
+	db, err := config.ConnectDatabase(context.Background(), config.LoadDatabaseConfig())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	return service.NewSnapshotService(postgres.NewSnapshotRepository(db)), db.Close, nil
+}
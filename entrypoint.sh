@@ -15,12 +15,40 @@ until psql -h "$DB_HOST" -p "$DB_PORT" -U "$DB_USER" -d "$DB_NAME" -c '\q' >/dev
   sleep 1
 done
 
+psql_db() {
+  psql -h "$DB_HOST" -p "$DB_PORT" -U "$DB_USER" -d "$DB_NAME" -v ON_ERROR_STOP=1 -q "$@"
+}
+
+migrations_table_exists() {
+  [ "$(psql_db -tA -c "SELECT to_regclass('schema_migrations') IS NOT NULL")" = "t" ]
+}
+
+migration_applied() {
+  migrations_table_exists &&
+    [ "$(psql_db -tA -c "SELECT COUNT(*) FROM schema_migrations WHERE version = $1")" = "1" ]
+}
+
 if [ -d /migrations ]; then
+  # versions applied before schema_migrations itself exists are recorded once it is created
+  unrecorded=""
   for f in /migrations/*.sql; do
     [ -e "$f" ] || continue
+    version=$(basename "$f" | sed 's/^0*\([0-9][0-9]*\)_.*/\1/')
+    if migration_applied "$version"; then
+      continue
+    fi
+
     echo "Applying migration $f"
-    psql -h "$DB_HOST" -p "$DB_PORT" -U "$DB_USER" -d "$DB_NAME" -f "$f"
+    psql_db -f "$f"
+    unrecorded="$unrecorded $version"
+
+    if migrations_table_exists; then
+      for v in $unrecorded; do
+        psql_db -c "INSERT INTO schema_migrations (version) VALUES (${v}) ON CONFLICT (version) DO NOTHING"
+      done
+      unrecorded=""
+    fi
   done
 fi
 
-exec /usr/local/bin/pr-review
+exec /usr/local/bin/pr-review "$@"
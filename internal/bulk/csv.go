@@ -0,0 +1,317 @@
+package bulk
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"pr-review/internal/entity"
+)
+
+const ReviewerSeparator = ";"
+
+var columns = map[entity.BulkResource][]string{
+	entity.BulkResourceTeams: {
+		"team_name", "required_reviewers", "selection_strategy", "allow_self_merge", "required_approvals",
+		"reassign_on_deactivation", "composition_rules", "security_team", "max_open_reviews", "hierarchy_fallback",
+		"fallback_teams", "shared_reviewers",
+	},
+	entity.BulkResourceUsers:        {"user_id", "username", "team_name", "is_active", "grade"},
+	entity.BulkResourcePullRequests: {"pull_request_id", "pull_request_name", "author_id", "status", "assigned_reviewers", "createdAt", "mergedAt"},
+}
+
+var requiredColumns = map[entity.BulkResource][]string{
+	entity.BulkResourceTeams:        {"team_name"},
+	entity.BulkResourceUsers:        {"user_id", "username", "team_name"},
+	entity.BulkResourcePullRequests: {"pull_request_id", "pull_request_name", "author_id"},
+}
+
+func ReadCSV(resource entity.BulkResource, r io.Reader, maxRows int) (*entity.BulkData, error) {
+	if !resource.IsValid() {
+		return nil, fmt.Errorf("unknown resource %q", resource)
+	}
+
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("CSV header is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := headerIndex(resource, header)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &entity.BulkData{
+		Teams:        make([]entity.BulkTeam, 0),
+		Users:        make([]entity.User, 0),
+		PullRequests: make([]entity.BulkPullRequest, 0),
+	}
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if row > maxRows {
+			return nil, fmt.Errorf("CSV cannot contain more than %d rows", maxRows)
+		}
+
+		field := func(name string) string {
+			if i, ok := index[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		if err := appendRecord(data, resource, field); err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+	}
+
+	return data, nil
+}
+
+func headerIndex(resource entity.BulkResource, header []string) (map[string]int, error) {
+	known := make(map[string]bool, len(columns[resource]))
+	for _, column := range columns[resource] {
+		known[column] = true
+	}
+
+	index := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))
+		if !known[column] {
+			return nil, fmt.Errorf("unknown column %q for %s", column, resource)
+		}
+		if _, ok := index[column]; ok {
+			return nil, fmt.Errorf("duplicate column %q", column)
+		}
+		index[column] = i
+	}
+	for _, column := range requiredColumns[resource] {
+		if _, ok := index[column]; !ok {
+			return nil, fmt.Errorf("missing column %q for %s", column, resource)
+		}
+	}
+	return index, nil
+}
+
+func appendRecord(data *entity.BulkData, resource entity.BulkResource, field func(string) string) error {
+	switch resource {
+	case entity.BulkResourceTeams:
+		team := entity.BulkTeam{
+			Name:              field("team_name"),
+			SelectionStrategy: entity.SelectionStrategy(field("selection_strategy")),
+			SecurityTeam:      field("security_team"),
+			FallbackTeams:     splitList(field("fallback_teams")),
+			SharedReviewers:   splitList(field("shared_reviewers")),
+		}
+		var err error
+		if value := field("required_reviewers"); value != "" {
+			required, err := parseInt(value)
+			if err != nil {
+				return fmt.Errorf("required_reviewers %w", err)
+			}
+			team.RequiredReviewers = &required
+		}
+		if value := field("allow_self_merge"); value != "" {
+			allow, err := parseBool(value, true)
+			if err != nil {
+				return fmt.Errorf("allow_self_merge %w", err)
+			}
+			team.AllowSelfMerge = &allow
+		}
+		if team.RequiredApprovals, err = parseInt(field("required_approvals")); err != nil {
+			return fmt.Errorf("required_approvals %w", err)
+		}
+		if team.ReassignOnDeactivation, err = parseBool(field("reassign_on_deactivation"), false); err != nil {
+			return fmt.Errorf("reassign_on_deactivation %w", err)
+		}
+		if team.MaxOpenReviews, err = parseInt(field("max_open_reviews")); err != nil {
+			return fmt.Errorf("max_open_reviews %w", err)
+		}
+		if team.HierarchyFallback, err = parseBool(field("hierarchy_fallback"), false); err != nil {
+			return fmt.Errorf("hierarchy_fallback %w", err)
+		}
+		if value := field("composition_rules"); value != "" {
+			if err := json.Unmarshal([]byte(value), &team.CompositionRules); err != nil {
+				return errors.New("composition_rules must be a JSON array of rules")
+			}
+		}
+		data.Teams = append(data.Teams, team)
+	case entity.BulkResourceUsers:
+		isActive, err := parseBool(field("is_active"), true)
+		if err != nil {
+			return fmt.Errorf("is_active %w", err)
+		}
+		data.Users = append(data.Users, entity.User{
+			ID:       field("user_id"),
+			Name:     field("username"),
+			Team:     field("team_name"),
+			IsActive: isActive,
+			Grade:    entity.Grade(field("grade")),
+		})
+	case entity.BulkResourcePullRequests:
+		createdAt, err := parseTime(field("createdAt"))
+		if err != nil {
+			return fmt.Errorf("createdAt %w", err)
+		}
+		mergedAt, err := parseTime(field("mergedAt"))
+		if err != nil {
+			return fmt.Errorf("mergedAt %w", err)
+		}
+		data.PullRequests = append(data.PullRequests, entity.BulkPullRequest{
+			ID:                field("pull_request_id"),
+			Name:              field("pull_request_name"),
+			AuthorID:          field("author_id"),
+			Status:            entity.Status(field("status")),
+			AssignedReviewers: splitList(field("assigned_reviewers")),
+			CreatedAt:         createdAt,
+			MergedAt:          mergedAt,
+		})
+	}
+	return nil
+}
+
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ReviewerSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func parseInt(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, errors.New("must be an integer")
+	}
+	return parsed, nil
+}
+
+func parseBool(value string, fallback bool) (bool, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.New("must be true or false")
+	}
+	return parsed, nil
+}
+
+func parseTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.New("must be an RFC 3339 timestamp")
+	}
+	return &parsed, nil
+}
+
+func WriteCSV(resource entity.BulkResource, data *entity.BulkData, w io.Writer) error {
+	if !resource.IsValid() {
+		return fmt.Errorf("unknown resource %q", resource)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(columns[resource]); err != nil {
+		return err
+	}
+
+	switch resource {
+	case entity.BulkResourceTeams:
+		for _, team := range data.Teams {
+			rules := ""
+			if len(team.CompositionRules) > 0 {
+				encoded, err := json.Marshal(team.CompositionRules)
+				if err != nil {
+					return err
+				}
+				rules = string(encoded)
+			}
+			record := []string{
+				team.Name,
+				formatInt(team.RequiredReviewers),
+				string(team.SelectionStrategy),
+				formatBool(team.AllowSelfMerge),
+				strconv.Itoa(team.RequiredApprovals),
+				strconv.FormatBool(team.ReassignOnDeactivation),
+				rules,
+				team.SecurityTeam,
+				strconv.Itoa(team.MaxOpenReviews),
+				strconv.FormatBool(team.HierarchyFallback),
+				strings.Join(team.FallbackTeams, ReviewerSeparator),
+				strings.Join(team.SharedReviewers, ReviewerSeparator),
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	case entity.BulkResourceUsers:
+		for _, user := range data.Users {
+			record := []string{user.ID, user.Name, user.Team, strconv.FormatBool(user.IsActive), string(user.Grade)}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	case entity.BulkResourcePullRequests:
+		for _, pr := range data.PullRequests {
+			record := []string{
+				pr.ID,
+				pr.Name,
+				pr.AuthorID,
+				string(pr.Status),
+				strings.Join(pr.AssignedReviewers, ReviewerSeparator),
+				formatTime(pr.CreatedAt),
+				formatTime(pr.MergedAt),
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatInt(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
+
+func formatBool(value *bool) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatBool(*value)
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
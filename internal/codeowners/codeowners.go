@@ -0,0 +1,177 @@
+package codeowners
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type Rule struct {
+	Line    int
+	Pattern string
+	Owners  []string
+	matcher *regexp.Regexp
+}
+
+type File struct {
+	Rules []Rule
+}
+
+func Parse(content string) (*File, error) {
+	file := &File{Rules: make([]Rule, 0)}
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		fields := strings.Fields(stripComment(scanner.Text()))
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern := fields[0]
+		matcher, err := compilePattern(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		owners := fields[1:]
+		for _, owner := range owners {
+			if !isValidOwner(owner) {
+				return nil, fmt.Errorf("line %d: invalid owner %q", lineNumber, owner)
+			}
+		}
+
+		file.Rules = append(file.Rules, Rule{
+			Line:    lineNumber,
+			Pattern: pattern,
+			Owners:  owners,
+			matcher: matcher,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (f *File) Owners(path string) []string {
+	path = strings.TrimPrefix(path, "/")
+	for i := len(f.Rules) - 1; i >= 0; i-- {
+		if f.Rules[i].matcher.MatchString(path) {
+			return f.Rules[i].Owners
+		}
+	}
+	return nil
+}
+
+func (f *File) OwnersForPaths(paths []string) []string {
+	seen := make(map[string]bool)
+	owners := make([]string, 0)
+	for _, path := range paths {
+		for _, owner := range f.Owners(path) {
+			if !seen[owner] {
+				seen[owner] = true
+				owners = append(owners, owner)
+			}
+		}
+	}
+	return owners
+}
+
+func stripComment(line string) string {
+	var b strings.Builder
+	escaped := false
+	for _, r := range line {
+		switch {
+		case escaped:
+			b.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '#':
+			return b.String()
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func isValidOwner(owner string) bool {
+	if strings.HasPrefix(owner, "@") {
+		name := owner[1:]
+		if name == "" || strings.HasSuffix(name, "/") || strings.Count(name, "/") > 1 {
+			return false
+		}
+		return !strings.HasPrefix(name, "/")
+	}
+	at := strings.Index(owner, "@")
+	return at > 0 && at < len(owner)-1
+}
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if strings.HasPrefix(pattern, "!") {
+		return nil, fmt.Errorf("negated pattern %q is not supported", pattern)
+	}
+	if strings.ContainsAny(pattern, "[]") {
+		return nil, fmt.Errorf("character ranges in pattern %q are not supported", pattern)
+	}
+
+	anchored := strings.HasPrefix(pattern, "/") || strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	directory := strings.HasSuffix(pattern, "/")
+	trimmed := strings.Trim(pattern, "/")
+	if trimmed == "" {
+		return nil, fmt.Errorf("empty pattern %q", pattern)
+	}
+
+	segments := strings.Split(trimmed, "/")
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i, segment := range segments {
+		last := i == len(segments)-1
+		if segment == "**" {
+			if last {
+				b.WriteString(".*")
+			} else {
+				b.WriteString("(?:.*/)?")
+			}
+			continue
+		}
+		writeSegment(&b, segment)
+		if !last {
+			b.WriteString("/")
+		}
+	}
+
+	lastSegment := segments[len(segments)-1]
+	switch {
+	case directory:
+		b.WriteString("/.*")
+	case lastSegment == "**":
+	case strings.ContainsAny(lastSegment, "*?"):
+	default:
+		b.WriteString("(?:/.*)?")
+	}
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
+
+func writeSegment(b *strings.Builder, segment string) {
+	for _, r := range segment {
+		switch r {
+		case '*':
+			b.WriteString("[^/]*")
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+}
@@ -41,6 +41,8 @@ const (
 	DefaultDBMinConns        = 5
 	DefaultDBMaxConnLifetime = time.Hour
 	DefaultDBMaxConnIdleTime = 30 * time.Minute
+
+	SchemaVersion = 20
 )
 
 func getEnv(key, defaultValue string) string {
@@ -0,0 +1,43 @@
+package config
+
+import "time"
+
+type DigestConfig struct {
+	Schedule   string
+	Notifier   string
+	WebhookURL string
+	SMTP       SMTPConfig
+}
+
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	From     string
+	User     string
+	Password string
+}
+
+func LoadDigestConfig() *DigestConfig {
+	return &DigestConfig{
+		Schedule:   getEnv("DIGEST_SCHEDULE", DefaultDigestSchedule),
+		Notifier:   getEnv("DIGEST_NOTIFIER", DigestNotifierLog),
+		WebhookURL: getEnv("DIGEST_WEBHOOK_URL", ""),
+		SMTP: SMTPConfig{
+			Host:     getEnv("SMTP_HOST", "localhost"),
+			Port:     getEnv("SMTP_PORT", DefaultSMTPPort),
+			From:     getEnv("SMTP_FROM", "pr-review@localhost"),
+			User:     getEnv("SMTP_USER", ""),
+			Password: getEnv("SMTP_PASSWORD", ""),
+		},
+	}
+}
+
+const (
+	DefaultDigestSchedule = "0 9 * * 1-5"
+	DefaultSMTPPort       = "25"
+	WebhookTimeout        = 10 * time.Second
+
+	DigestNotifierLog     = "log"
+	DigestNotifierSMTP    = "smtp"
+	DigestNotifierWebhook = "webhook"
+)
@@ -8,11 +8,38 @@ const (
 	MaxTeamMembers           = 100
 	DefaultReviewers         = 2
 	ReplacementReviewerCount = 1
+	HandoverAttempts         = 3
+	MaxSLAMinutes            = 365 * 24 * 60
+	MaxFallbackTeams         = 10
+	MaxRequiredReviewers     = 10
+	MaxCompositionRules      = 10
+	MaxAffinityWeight        = 10
+	MaxChangedFiles          = 1000
+	MaxPathLength            = 1024
+	MaxCodeOwnersSize        = 64 * 1024
+	MaxLabels                = 20
+	MaxLabelLength           = 64
+	ExpertisePathDepth       = 3
+	ExpertiseRandomness      = 0.3
+	MinExpertiseScore        = 0.01
+	MaxOpenReviewsLimit      = 100
+	MaxDescriptionLength     = 4096
+	MaxTeamDepth             = 10
+	DefaultPageSize          = 50
+	MaxPageSize              = 100
+	MaxImportRows            = 5000
+	MaxImportSize            = 16 << 20
 
 	DefaultHTTPAddr = "0.0.0.0"
+	ErrorDocsPath   = "/docs/errors"
 
 	ReadTimeout     = 15 * time.Second
 	WriteTimeout    = 15 * time.Second
 	IdleTimeout     = 60 * time.Second
 	ShutdownTimeout = 30 * time.Second
+
+	AvailabilityCheckInterval = time.Minute
+	SLACheckInterval          = time.Minute
+	DigestIntervalSlack       = 5 * time.Minute
+	ExpertiseHalfLife         = 30 * 24 * time.Hour
 )
@@ -0,0 +1,22 @@
+package config
+
+import "time"
+
+type TenantConfig struct {
+	DefaultOrganization string
+	AllowHeader         bool
+}
+
+func LoadTenantConfig() *TenantConfig {
+	return &TenantConfig{
+		DefaultOrganization: getEnv("DEFAULT_ORGANIZATION", DefaultOrganizationID),
+		AllowHeader:         getEnv("ALLOW_ORGANIZATION_HEADER", "false") == "true",
+	}
+}
+
+const (
+	DefaultOrganizationID       = "default"
+	NoDefaultOrganization       = "none"
+	OrganizationHeader          = "X-Organization-ID"
+	OrganizationRefreshInterval = time.Minute
+)
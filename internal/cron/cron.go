@@ -0,0 +1,163 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxLookahead = 5 * 366 * 24 * time.Hour
+
+type Schedule struct {
+	minutes  map[int]bool
+	hours    map[int]bool
+	days     map[int]bool
+	months   map[int]bool
+	weekdays map[int]bool
+
+	anyDay     bool
+	anyWeekday bool
+}
+
+type field struct {
+	name string
+	min  int
+	max  int
+}
+
+var (
+	minuteField  = field{name: "minute", min: 0, max: 59}
+	hourField    = field{name: "hour", min: 0, max: 23}
+	dayField     = field{name: "day of month", min: 1, max: 31}
+	monthField   = field{name: "month", min: 1, max: 12}
+	weekdayField = field{name: "day of week", min: 0, max: 7}
+)
+
+func Parse(expr string) (*Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("cron expression must have 5 fields, got %d", len(parts))
+	}
+
+	var err error
+	s := &Schedule{
+		anyDay:     parts[2] == "*",
+		anyWeekday: parts[4] == "*",
+	}
+	if s.minutes, err = parseField(parts[0], minuteField); err != nil {
+		return nil, err
+	}
+	if s.hours, err = parseField(parts[1], hourField); err != nil {
+		return nil, err
+	}
+	if s.days, err = parseField(parts[2], dayField); err != nil {
+		return nil, err
+	}
+	if s.months, err = parseField(parts[3], monthField); err != nil {
+		return nil, err
+	}
+	if s.weekdays, err = parseField(parts[4], weekdayField); err != nil {
+		return nil, err
+	}
+	if s.weekdays[7] {
+		s.weekdays[0] = true
+	}
+
+	return s, nil
+}
+
+func (s *Schedule) Matches(t time.Time) bool {
+	if !s.minutes[t.Minute()] || !s.hours[t.Hour()] || !s.months[int(t.Month())] {
+		return false
+	}
+
+	dayMatches := s.days[t.Day()]
+	weekdayMatches := s.weekdays[int(t.Weekday())]
+	switch {
+	case s.anyDay && s.anyWeekday:
+		return true
+	case s.anyDay:
+		return weekdayMatches
+	case s.anyWeekday:
+		return dayMatches
+	default:
+		return dayMatches || weekdayMatches
+	}
+}
+
+func (s *Schedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	deadline := after.Add(maxLookahead)
+	for t.Before(deadline) {
+		if s.Matches(t) {
+			return t
+		}
+		t = t.Add(time.Minute)
+	}
+	return time.Time{}
+}
+
+func parseField(expr string, f field) (map[int]bool, error) {
+	values := make(map[int]bool)
+	for _, part := range strings.Split(expr, ",") {
+		if err := parsePart(part, f, values); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+func parsePart(part string, f field, values map[int]bool) error {
+	rangeExpr, step := part, 1
+	if idx := strings.Index(part, "/"); idx >= 0 {
+		rangeExpr = part[:idx]
+		n, err := strconv.Atoi(part[idx+1:])
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid step %q in %s field", part[idx+1:], f.name)
+		}
+		step = n
+	}
+
+	lo, hi := f.min, f.max
+	switch {
+	case rangeExpr == "*":
+	case strings.Contains(rangeExpr, "-"):
+		bounds := strings.SplitN(rangeExpr, "-", 2)
+		var err error
+		if lo, err = parseValue(bounds[0], f); err != nil {
+			return err
+		}
+		if hi, err = parseValue(bounds[1], f); err != nil {
+			return err
+		}
+		if lo > hi {
+			return fmt.Errorf("invalid range %q in %s field", rangeExpr, f.name)
+		}
+	default:
+		value, err := parseValue(rangeExpr, f)
+		if err != nil {
+			return err
+		}
+		lo = value
+		if step == 1 {
+			hi = value
+		}
+	}
+
+	for v := lo; v <= hi; v += step {
+		values[v] = true
+	}
+	return nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", s, f.name)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s value %d out of range %d-%d", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
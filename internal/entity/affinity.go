@@ -0,0 +1,9 @@
+package entity
+
+type ReviewerAffinity struct {
+	AuthorID   string `json:"author_id"`
+	ReviewerID string `json:"reviewer_id"`
+	Weight     int    `json:"weight"`
+	Blocked    bool   `json:"blocked"`
+	Reason     string `json:"reason,omitempty"`
+}
@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+type AwayPeriod struct {
+	ID           int64      `json:"id"`
+	UserID       string     `json:"user_id"`
+	From         time.Time  `json:"from"`
+	Until        time.Time  `json:"until"`
+	Reason       string     `json:"reason,omitempty"`
+	Handover     bool       `json:"handover"`
+	HandedOverAt *time.Time `json:"handed_over_at,omitempty"`
+	ReturnedAt   *time.Time `json:"returned_at,omitempty"`
+}
+
+func (p *AwayPeriod) IsActiveAt(at time.Time) bool {
+	return !at.Before(p.From) && at.Before(p.Until)
+}
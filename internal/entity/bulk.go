@@ -0,0 +1,87 @@
+package entity
+
+import "time"
+
+type BulkResource string
+
+const (
+	BulkResourceTeams        BulkResource = "teams"
+	BulkResourceUsers        BulkResource = "users"
+	BulkResourcePullRequests BulkResource = "pull_requests"
+)
+
+func (r BulkResource) IsValid() bool {
+	switch r {
+	case BulkResourceTeams, BulkResourceUsers, BulkResourcePullRequests:
+		return true
+	default:
+		return false
+	}
+}
+
+type BulkTeam struct {
+	Name                   string            `json:"team_name"`
+	RequiredReviewers      *int              `json:"required_reviewers,omitempty"`
+	SelectionStrategy      SelectionStrategy `json:"selection_strategy,omitempty"`
+	AllowSelfMerge         *bool             `json:"allow_self_merge,omitempty"`
+	RequiredApprovals      int               `json:"required_approvals"`
+	ReassignOnDeactivation bool              `json:"reassign_on_deactivation"`
+	CompositionRules       []CompositionRule `json:"composition_rules"`
+	SecurityTeam           string            `json:"security_team,omitempty"`
+	MaxOpenReviews         int               `json:"max_open_reviews"`
+	HierarchyFallback      bool              `json:"hierarchy_fallback"`
+	FallbackTeams          []string          `json:"fallback_teams"`
+	SharedReviewers        []string          `json:"shared_reviewers"`
+}
+
+func (t *BulkTeam) Policy() *TeamPolicy {
+	policy := &TeamPolicy{
+		TeamName:               t.Name,
+		SelectionStrategy:      t.SelectionStrategy,
+		RequiredApprovals:      t.RequiredApprovals,
+		ReassignOnDeactivation: t.ReassignOnDeactivation,
+		CompositionRules:       t.CompositionRules,
+		SecurityTeam:           t.SecurityTeam,
+		MaxOpenReviews:         t.MaxOpenReviews,
+		HierarchyFallback:      t.HierarchyFallback,
+	}
+	if t.RequiredReviewers != nil {
+		policy.RequiredReviewers = *t.RequiredReviewers
+	}
+	if t.AllowSelfMerge != nil {
+		policy.AllowSelfMerge = *t.AllowSelfMerge
+	}
+	return policy
+}
+
+type BulkPullRequest struct {
+	ID                string     `json:"pull_request_id"`
+	Name              string     `json:"pull_request_name"`
+	AuthorID          string     `json:"author_id"`
+	Status            Status     `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	CreatedAt         *time.Time `json:"createdAt,omitempty"`
+	MergedAt          *time.Time `json:"mergedAt,omitempty"`
+}
+
+type BulkData struct {
+	Teams        []BulkTeam        `json:"teams"`
+	Users        []User            `json:"users"`
+	PullRequests []BulkPullRequest `json:"pull_requests"`
+}
+
+type ImportError struct {
+	Resource BulkResource `json:"resource"`
+	Row      int          `json:"row"`
+	ID       string       `json:"id,omitempty"`
+	Message  string       `json:"message"`
+}
+
+type ImportReport struct {
+	DryRun       bool          `json:"dry_run"`
+	Applied      bool          `json:"applied"`
+	Teams        int           `json:"teams"`
+	Users        int           `json:"users"`
+	PullRequests int           `json:"pull_requests"`
+	Errors       []ImportError `json:"errors"`
+}
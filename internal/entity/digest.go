@@ -0,0 +1,54 @@
+package entity
+
+import "time"
+
+type DigestFrequency string
+
+const (
+	DigestFrequencyHourly DigestFrequency = "hourly"
+	DigestFrequencyDaily  DigestFrequency = "daily"
+	DigestFrequencyWeekly DigestFrequency = "weekly"
+)
+
+func (f DigestFrequency) Interval() time.Duration {
+	switch f {
+	case DigestFrequencyHourly:
+		return time.Hour
+	case DigestFrequencyWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+func (f DigestFrequency) IsValid() bool {
+	switch f {
+	case DigestFrequencyHourly, DigestFrequencyDaily, DigestFrequencyWeekly:
+		return true
+	}
+	return false
+}
+
+type DigestPreferences struct {
+	UserID     string          `json:"user_id"`
+	Enabled    bool            `json:"enabled"`
+	Frequency  DigestFrequency `json:"frequency"`
+	Email      string          `json:"email,omitempty"`
+	LastSentAt *time.Time      `json:"last_sent_at,omitempty"`
+}
+
+type DigestItem struct {
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        string    `json:"author_id"`
+	CreatedAt       time.Time `json:"created_at"`
+	AgeSeconds      int64     `json:"age_seconds"`
+}
+
+type ReviewDigest struct {
+	UserID      string       `json:"user_id"`
+	Username    string       `json:"username"`
+	Email       string       `json:"email,omitempty"`
+	GeneratedAt time.Time    `json:"generated_at"`
+	Items       []DigestItem `json:"items"`
+}
@@ -1,19 +1,81 @@
 package entity
 
+import "pr-review/internal/i18n"
+
 type ErrorCode string
 
 const (
-	ErrorCodeTeamExists  ErrorCode = "TEAM_EXISTS"
-	ErrorCodePRExists    ErrorCode = "PR_EXISTS"
-	ErrorCodePRMerged    ErrorCode = "PR_MERGED"
-	ErrorCodeNotAssigned ErrorCode = "NOT_ASSIGNED"
-	ErrorCodeNoCandidate ErrorCode = "NO_CANDIDATE"
-	ErrorCodeNotFound    ErrorCode = "NOT_FOUND"
+	ErrorCodeTeamExists   ErrorCode = "TEAM_EXISTS"
+	ErrorCodePRExists     ErrorCode = "PR_EXISTS"
+	ErrorCodePRMerged     ErrorCode = "PR_MERGED"
+	ErrorCodeNotAssigned  ErrorCode = "NOT_ASSIGNED"
+	ErrorCodeNoCandidate  ErrorCode = "NO_CANDIDATE"
+	ErrorCodeNotFound     ErrorCode = "NOT_FOUND"
+	ErrorCodeMergeBlocked ErrorCode = "MERGE_BLOCKED"
+
+	ErrorCodeVersionConflict ErrorCode = "VERSION_CONFLICT"
+
+	ErrorCodeUnauthorized ErrorCode = "UNAUTHORIZED"
+	ErrorCodeForbidden    ErrorCode = "FORBIDDEN"
+
+	ErrorCodeValidationFailed ErrorCode = "VALIDATION_FAILED"
+)
+
+const (
+	RuleRequired    = "required"
+	RuleMaxLength   = "maxLength"
+	RuleMinimum     = "minimum"
+	RuleMaximum     = "maximum"
+	RuleRange       = "range"
+	RuleMinItems    = "minItems"
+	RuleMaxItems    = "maxItems"
+	RuleUniqueItems = "uniqueItems"
+	RuleEnum        = "enum"
+	RuleFormat      = "format"
+	RuleConsistent  = "consistent"
 )
 
 type DomainError struct {
-	Code    ErrorCode
+	Code       ErrorCode
+	Message    string
+	Key        string
+	Params     i18n.Params
+	Violations []FieldViolation
+}
+
+type FieldViolation struct {
+	Field   string
+	Rule    string
 	Message string
+	Key     string
+	Params  i18n.Params
+}
+
+func NewError(code ErrorCode, variant string, params i18n.Params) *DomainError {
+	key := MessageKey(code, variant)
+	return &DomainError{
+		Code:    code,
+		Message: i18n.Translate(i18n.DefaultLanguage, key, params),
+		Key:     key,
+		Params:  params,
+	}
+}
+
+func NewValidationError(field, rule, variant string, params i18n.Params) *DomainError {
+	withField := i18n.Params{"field": field}
+	for name, value := range params {
+		withField[name] = value
+	}
+	err := NewError(ErrorCodeValidationFailed, variant, withField)
+	err.Violations = []FieldViolation{{Field: field, Rule: rule, Message: err.Message, Key: err.Key, Params: err.Params}}
+	return err
+}
+
+func MessageKey(code ErrorCode, variant string) string {
+	if variant == "" {
+		return string(code)
+	}
+	return string(code) + "." + variant
 }
 
 func (e *DomainError) Error() string {
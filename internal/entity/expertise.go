@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"math"
+	"path"
+	"strings"
+	"time"
+)
+
+type ExpertiseScore struct {
+	UserID    string    `json:"user_id"`
+	Area      string    `json:"area"`
+	Score     float64   `json:"score"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func (s *ExpertiseScore) DecayedAt(at time.Time, halfLife time.Duration) float64 {
+	elapsed := at.Sub(s.UpdatedAt)
+	if elapsed <= 0 || halfLife <= 0 {
+		return s.Score
+	}
+	return s.Score * math.Pow(0.5, float64(elapsed)/float64(halfLife))
+}
+
+func ExpertiseAreas(pr *PullRequest, depth int) []string {
+	seen := make(map[string]bool)
+	areas := make([]string, 0, len(pr.Labels)+len(pr.ChangedFiles))
+	add := func(area string) {
+		if !seen[area] {
+			seen[area] = true
+			areas = append(areas, area)
+		}
+	}
+
+	for _, label := range pr.Labels {
+		add("label:" + strings.ToLower(label))
+	}
+
+	prefix := "path:"
+	if pr.Repository != "" {
+		prefix += pr.Repository + ":"
+	}
+	for _, file := range pr.ChangedFiles {
+		dir := path.Dir(strings.Trim(file, "/"))
+		if dir == "." {
+			continue
+		}
+		segments := strings.Split(dir, "/")
+		for i := 1; i <= len(segments) && i <= depth; i++ {
+			add(prefix + strings.Join(segments[:i], "/"))
+		}
+	}
+
+	return areas
+}
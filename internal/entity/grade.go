@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Grade string
+
+const (
+	GradeJunior Grade = "junior"
+	GradeMiddle Grade = "middle"
+	GradeSenior Grade = "senior"
+	GradeLead   Grade = "lead"
+)
+
+var gradeRanks = map[Grade]int{
+	GradeJunior: 1,
+	GradeMiddle: 2,
+	GradeSenior: 3,
+	GradeLead:   4,
+}
+
+func (g Grade) IsValid() bool {
+	_, ok := gradeRanks[g]
+	return ok
+}
+
+func (g Grade) OrDefault() Grade {
+	if g == "" {
+		return GradeMiddle
+	}
+	return g
+}
+
+func (g Grade) Rank() int {
+	return gradeRanks[g.OrDefault()]
+}
+
+type CompositionRule struct {
+	AuthorGrade Grade  `json:"author_grade,omitempty"`
+	MinGrade    Grade  `json:"min_grade,omitempty"`
+	MaxGrade    Grade  `json:"max_grade,omitempty"`
+	AtLeast     int    `json:"at_least"`
+	AtMost      *int   `json:"at_most,omitempty"`
+	Team        string `json:"-"`
+}
+
+func (r CompositionRule) AppliesTo(author *User) bool {
+	return r.AuthorGrade == "" || (author != nil && author.Grade.OrDefault() == r.AuthorGrade)
+}
+
+func (r CompositionRule) Matches(user *User) bool {
+	if r.Team != "" && user.Team != r.Team {
+		return false
+	}
+	rank := user.Grade.Rank()
+	if r.MinGrade != "" && rank < r.MinGrade.Rank() {
+		return false
+	}
+	if r.MaxGrade != "" && rank > r.MaxGrade.Rank() {
+		return false
+	}
+	return true
+}
+
+func (r CompositionRule) Count(reviewers []*User) int {
+	count := 0
+	for _, reviewer := range reviewers {
+		if r.Matches(reviewer) {
+			count++
+		}
+	}
+	return count
+}
+
+func (r CompositionRule) Describe() string {
+	var grades []string
+	if r.MinGrade != "" {
+		grades = append(grades, "grade >= "+string(r.MinGrade))
+	}
+	if r.MaxGrade != "" {
+		grades = append(grades, "grade <= "+string(r.MaxGrade))
+	}
+	subject := "reviewers"
+	if r.Team != "" {
+		subject += " from team " + r.Team
+	}
+	if len(grades) > 0 {
+		subject += " with " + strings.Join(grades, " and ")
+	}
+
+	var bounds []string
+	if r.AtLeast > 0 {
+		bounds = append(bounds, fmt.Sprintf("at least %d", r.AtLeast))
+	}
+	if r.AtMost != nil {
+		bounds = append(bounds, fmt.Sprintf("at most %d", *r.AtMost))
+	}
+
+	description := strings.Join(bounds, " and ") + " " + subject
+	if r.AuthorGrade != "" {
+		description += " for " + string(r.AuthorGrade) + " authors"
+	}
+	return description
+}
@@ -0,0 +1,6 @@
+package entity
+
+type Organization struct {
+	ID   string `json:"organization_id"`
+	Name string `json:"name"`
+}
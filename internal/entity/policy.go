@@ -0,0 +1,14 @@
+package entity
+
+type TeamPolicy struct {
+	TeamName               string            `json:"team_name"`
+	RequiredReviewers      int               `json:"required_reviewers"`
+	SelectionStrategy      SelectionStrategy `json:"selection_strategy"`
+	AllowSelfMerge         bool              `json:"allow_self_merge"`
+	RequiredApprovals      int               `json:"required_approvals"`
+	ReassignOnDeactivation bool              `json:"reassign_on_deactivation"`
+	CompositionRules       []CompositionRule `json:"composition_rules"`
+	SecurityTeam           string            `json:"security_team,omitempty"`
+	MaxOpenReviews         int               `json:"max_open_reviews"`
+	HierarchyFallback      bool              `json:"hierarchy_fallback"`
+}
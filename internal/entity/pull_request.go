@@ -1,6 +1,9 @@
 package entity
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 type Status string
 
@@ -9,21 +12,65 @@ const (
 	StatusMerged Status = "MERGED"
 )
 
+type Priority string
+
+const (
+	PriorityLow    Priority = "low"
+	PriorityNormal Priority = "normal"
+	PriorityHigh   Priority = "high"
+)
+
+func (p Priority) IsValid() bool {
+	switch p {
+	case PriorityLow, PriorityNormal, PriorityHigh:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p Priority) OrDefault() Priority {
+	if p == "" {
+		return PriorityNormal
+	}
+	return p
+}
+
+const (
+	LabelHotfix   = "hotfix"
+	LabelSecurity = "security"
+)
+
 type PullRequest struct {
 	ID                string     `json:"pull_request_id"`
 	Name              string     `json:"pull_request_name"`
 	AuthorID          string     `json:"author_id"`
 	Status            Status     `json:"status"`
 	AssignedReviewers []string   `json:"assigned_reviewers"`
+	Repository        string     `json:"repository,omitempty"`
+	ChangedFiles      []string   `json:"changed_files,omitempty"`
+	Labels            []string   `json:"labels,omitempty"`
+	Priority          Priority   `json:"priority,omitempty"`
+	Description       string     `json:"description,omitempty"`
+	Version           int        `json:"version"`
 	CreatedAt         *time.Time `json:"createdAt,omitempty"`
 	MergedAt          *time.Time `json:"mergedAt,omitempty"`
 }
 
+type PullRequestChanges struct {
+	Name        *string
+	Description *string
+	AuthorID    *string
+	Labels      *[]string
+}
+
 type PullRequestShort struct {
-	ID       string `json:"pull_request_id"`
-	Name     string `json:"pull_request_name"`
-	AuthorID string `json:"author_id"`
-	Status   Status `json:"status"`
+	ID       string   `json:"pull_request_id"`
+	Name     string   `json:"pull_request_name"`
+	AuthorID string   `json:"author_id"`
+	Status   Status   `json:"status"`
+	Priority Priority `json:"priority,omitempty"`
+	Labels   []string `json:"labels,omitempty"`
 }
 
 func (pr *PullRequest) ToShort() *PullRequestShort {
@@ -32,5 +79,44 @@ func (pr *PullRequest) ToShort() *PullRequestShort {
 		Name:     pr.Name,
 		AuthorID: pr.AuthorID,
 		Status:   pr.Status,
+		Priority: pr.Priority,
+		Labels:   pr.Labels,
+	}
+}
+
+func (pr *PullRequest) HasLabel(label string) bool {
+	for _, l := range pr.Labels {
+		if strings.EqualFold(l, label) {
+			return true
+		}
+	}
+	return false
+}
+
+type ReviewQueueFilter struct {
+	Labels   []string
+	Priority Priority
+}
+
+func (f ReviewQueueFilter) Matches(pr *PullRequest) bool {
+	if f.Priority != "" && pr.Priority.OrDefault() != f.Priority {
+		return false
 	}
+	for _, label := range f.Labels {
+		if !pr.HasLabel(label) {
+			return false
+		}
+	}
+	return true
+}
+
+type ReviewerReassignment struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id"`
+}
+
+type ReassignmentReport struct {
+	Reassignments []ReviewerReassignment `json:"reassignments"`
+	UnreplacedPRs []string               `json:"unreplaced_pull_requests"`
 }
@@ -0,0 +1,10 @@
+package entity
+
+import "time"
+
+type Repository struct {
+	Name       string     `json:"repository_name"`
+	TeamName   string     `json:"team_name,omitempty"`
+	CodeOwners string     `json:"codeowners"`
+	UpdatedAt  *time.Time `json:"updatedAt,omitempty"`
+}
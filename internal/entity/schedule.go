@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"math"
+	"time"
+)
+
+type SelectionStrategy string
+
+const (
+	SelectionStrategyRandom       SelectionStrategy = "random"
+	SelectionStrategyWorkingHours SelectionStrategy = "working_hours"
+	SelectionStrategyExpertise    SelectionStrategy = "expertise"
+)
+
+func (s SelectionStrategy) IsValid() bool {
+	switch s {
+	case SelectionStrategyRandom, SelectionStrategyWorkingHours, SelectionStrategyExpertise:
+		return true
+	}
+	return false
+}
+
+const ClockLayout = "15:04"
+
+type WorkSchedule struct {
+	TimeZone string         `json:"time_zone"`
+	Start    string         `json:"work_start"`
+	End      string         `json:"work_end"`
+	Days     []time.Weekday `json:"work_days"`
+}
+
+func (s *WorkSchedule) UntilWorkingHours(at time.Time) time.Duration {
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	start, errStart := time.Parse(ClockLayout, s.Start)
+	end, errEnd := time.Parse(ClockLayout, s.End)
+	if errStart != nil || errEnd != nil {
+		return time.Duration(math.MaxInt64)
+	}
+
+	overnight := !end.After(start)
+
+	local := at.In(loc)
+	for offset := -1; offset <= 7; offset++ {
+		day := local.AddDate(0, 0, offset)
+		if !s.worksOn(day.Weekday()) {
+			continue
+		}
+
+		dayStart := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, loc)
+		dayEnd := time.Date(day.Year(), day.Month(), day.Day(), end.Hour(), end.Minute(), 0, 0, loc)
+		if overnight {
+			dayEnd = dayEnd.AddDate(0, 0, 1)
+		}
+		if !local.Before(dayStart) && local.Before(dayEnd) {
+			return 0
+		}
+		if dayStart.After(local) {
+			return dayStart.Sub(local)
+		}
+	}
+
+	return time.Duration(math.MaxInt64)
+}
+
+func (s *WorkSchedule) worksOn(day time.Weekday) bool {
+	for _, d := range s.Days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+type SLABreachKind string
+
+const (
+	SLABreachFirstReview SLABreachKind = "FIRST_REVIEW"
+	SLABreachMerge       SLABreachKind = "MERGE"
+)
+
+type SLASettings struct {
+	TeamName           string `json:"team_name"`
+	FirstReviewMinutes int    `json:"time_to_first_review_minutes"`
+	MergeMinutes       int    `json:"time_to_merge_minutes"`
+	AutoReassign       bool   `json:"auto_reassign"`
+}
+
+type SLABreach struct {
+	ID            int64         `json:"id"`
+	Kind          SLABreachKind `json:"kind"`
+	PullRequestID string        `json:"pull_request_id"`
+	ReviewerID    string        `json:"reviewer_id,omitempty"`
+	TeamName      string        `json:"team_name"`
+	StartedAt     time.Time     `json:"started_at"`
+	Deadline      time.Time     `json:"deadline"`
+	DetectedAt    time.Time     `json:"detected_at"`
+	ReassignedTo  string        `json:"reassigned_to,omitempty"`
+	ResolvedAt    *time.Time    `json:"resolved_at,omitempty"`
+}
+
+type SLABreachFilter struct {
+	TeamName string
+	Kind     SLABreachKind
+	OpenOnly bool
+}
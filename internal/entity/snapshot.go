@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+type SnapshotTable struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Rows    int64    `json:"rows"`
+	SHA256  string   `json:"sha256"`
+	Data    []byte   `json:"-"`
+}
+
+type SnapshotManifest struct {
+	FormatVersion int              `json:"format_version"`
+	SchemaVersion int              `json:"schema_version"`
+	CreatedAt     time.Time        `json:"created_at"`
+	Tables        []*SnapshotTable `json:"tables"`
+}
@@ -1,6 +1,33 @@
 package entity
 
 type Team struct {
-	Name    string `json:"team_name"`
-	Members []User `json:"members"`
+	Name                   string            `json:"team_name"`
+	Members                []User            `json:"members"`
+	ReassignOnDeactivation bool              `json:"reassign_on_deactivation"`
+	SelectionStrategy      SelectionStrategy `json:"selection_strategy"`
+	FallbackTeams          []string          `json:"fallback_teams"`
+	SharedReviewers        []string          `json:"shared_reviewers"`
+	ParentTeam             string            `json:"parent_team,omitempty"`
+	SubTeams               []*Team           `json:"sub_teams,omitempty"`
+}
+
+type TeamNode struct {
+	Name     string      `json:"team_name"`
+	SubTeams []*TeamNode `json:"sub_teams"`
+}
+
+type TeamHierarchy struct {
+	TeamName  string      `json:"team_name"`
+	Ancestors []string    `json:"ancestors"`
+	SubTeams  []*TeamNode `json:"sub_teams"`
+}
+
+type TeamStats struct {
+	TeamName              string   `json:"team_name"`
+	Teams                 []string `json:"teams"`
+	Members               int      `json:"members"`
+	ActiveMembers         int      `json:"active_members"`
+	OpenPullRequests      int      `json:"open_pull_requests"`
+	MergedPullRequests    int      `json:"merged_pull_requests"`
+	OpenReviewAssignments int      `json:"open_review_assignments"`
 }
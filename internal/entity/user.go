@@ -5,4 +5,22 @@ type User struct {
 	Name     string `json:"username"`
 	Team     string `json:"team_name"`
 	IsActive bool   `json:"is_active"`
+	Grade    Grade  `json:"grade,omitempty"`
+}
+
+const DeletedUsername = "deleted user"
+
+type UserFilter struct {
+	TeamName       string
+	IsActive       *bool
+	UsernamePrefix string
+	Limit          int
+	Offset         int
+}
+
+type UserPage struct {
+	Users      []*User `json:"users"`
+	Limit      int     `json:"limit"`
+	Offset     int     `json:"offset"`
+	NextOffset *int    `json:"next_offset,omitempty"`
 }
@@ -0,0 +1,2404 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	BearerAuthScopes         = "BearerAuth.Scopes"
+	OrganizationHeaderScopes = "OrganizationHeader.Scopes"
+)
+
+// Defines values for BulkPullRequestStatus.
+const (
+	BulkPullRequestStatusMERGED BulkPullRequestStatus = "MERGED"
+	BulkPullRequestStatusOPEN   BulkPullRequestStatus = "OPEN"
+)
+
+// Defines values for BulkTeamSelectionStrategy.
+const (
+	BulkTeamSelectionStrategyExpertise    BulkTeamSelectionStrategy = "expertise"
+	BulkTeamSelectionStrategyRandom       BulkTeamSelectionStrategy = "random"
+	BulkTeamSelectionStrategyWorkingHours BulkTeamSelectionStrategy = "working_hours"
+)
+
+// Defines values for DigestPreferencesFrequency.
+const (
+	DigestPreferencesFrequencyDaily  DigestPreferencesFrequency = "daily"
+	DigestPreferencesFrequencyHourly DigestPreferencesFrequency = "hourly"
+	DigestPreferencesFrequencyWeekly DigestPreferencesFrequency = "weekly"
+)
+
+// Defines values for ErrorResponseErrorCode.
+const (
+	FORBIDDEN        ErrorResponseErrorCode = "FORBIDDEN"
+	INTERNALERROR    ErrorResponseErrorCode = "INTERNAL_ERROR"
+	INVALIDREQUEST   ErrorResponseErrorCode = "INVALID_REQUEST"
+	MERGEBLOCKED     ErrorResponseErrorCode = "MERGE_BLOCKED"
+	NOCANDIDATE      ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED      ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND         ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS         ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED         ErrorResponseErrorCode = "PR_MERGED"
+	TEAMEXISTS       ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED     ErrorResponseErrorCode = "UNAUTHORIZED"
+	VALIDATIONFAILED ErrorResponseErrorCode = "VALIDATION_FAILED"
+	VERSIONCONFLICT  ErrorResponseErrorCode = "VERSION_CONFLICT"
+)
+
+// Defines values for Grade.
+const (
+	Junior Grade = "junior"
+	Lead   Grade = "lead"
+	Middle Grade = "middle"
+	Senior Grade = "senior"
+)
+
+// Defines values for ImportReportErrorsResource.
+const (
+	ImportReportErrorsResourcePullRequests ImportReportErrorsResource = "pull_requests"
+	ImportReportErrorsResourceTeams        ImportReportErrorsResource = "teams"
+	ImportReportErrorsResourceUsers        ImportReportErrorsResource = "users"
+)
+
+// Defines values for PullRequestStatus.
+const (
+	PullRequestStatusMERGED PullRequestStatus = "MERGED"
+	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+)
+
+// Defines values for PullRequestPriority.
+const (
+	High   PullRequestPriority = "high"
+	Low    PullRequestPriority = "low"
+	Normal PullRequestPriority = "normal"
+)
+
+// Defines values for PullRequestShortStatus.
+const (
+	MERGED PullRequestShortStatus = "MERGED"
+	OPEN   PullRequestShortStatus = "OPEN"
+)
+
+// Defines values for SLABreachKind.
+const (
+	SLABreachKindFIRSTREVIEW SLABreachKind = "FIRST_REVIEW"
+	SLABreachKindMERGE       SLABreachKind = "MERGE"
+)
+
+// Defines values for TeamSelectionStrategy.
+const (
+	TeamSelectionStrategyExpertise    TeamSelectionStrategy = "expertise"
+	TeamSelectionStrategyRandom       TeamSelectionStrategy = "random"
+	TeamSelectionStrategyWorkingHours TeamSelectionStrategy = "working_hours"
+)
+
+// Defines values for TeamPolicySelectionStrategy.
+const (
+	TeamPolicySelectionStrategyExpertise    TeamPolicySelectionStrategy = "expertise"
+	TeamPolicySelectionStrategyRandom       TeamPolicySelectionStrategy = "random"
+	TeamPolicySelectionStrategyWorkingHours TeamPolicySelectionStrategy = "working_hours"
+)
+
+// Defines values for ExportDataParamsFormat.
+const (
+	Csv  ExportDataParamsFormat = "csv"
+	Json ExportDataParamsFormat = "json"
+)
+
+// Defines values for ExportDataParamsResource.
+const (
+	ExportDataParamsResourcePullRequests ExportDataParamsResource = "pull_requests"
+	ExportDataParamsResourceTeams        ExportDataParamsResource = "teams"
+	ExportDataParamsResourceUsers        ExportDataParamsResource = "users"
+)
+
+// Defines values for ImportDataParamsResource.
+const (
+	PullRequests ImportDataParamsResource = "pull_requests"
+	Teams        ImportDataParamsResource = "teams"
+	Users        ImportDataParamsResource = "users"
+)
+
+// Defines values for ListSLABreachesParamsKind.
+const (
+	ListSLABreachesParamsKindFIRSTREVIEW ListSLABreachesParamsKind = "FIRST_REVIEW"
+	ListSLABreachesParamsKindMERGE       ListSLABreachesParamsKind = "MERGE"
+)
+
+// Defines values for SetTeamPolicyJSONBodySelectionStrategy.
+const (
+	SetTeamPolicyJSONBodySelectionStrategyExpertise    SetTeamPolicyJSONBodySelectionStrategy = "expertise"
+	SetTeamPolicyJSONBodySelectionStrategyRandom       SetTeamPolicyJSONBodySelectionStrategy = "random"
+	SetTeamPolicyJSONBodySelectionStrategyWorkingHours SetTeamPolicyJSONBodySelectionStrategy = "working_hours"
+)
+
+// Defines values for SetDigestPreferencesJSONBodyFrequency.
+const (
+	SetDigestPreferencesJSONBodyFrequencyDaily  SetDigestPreferencesJSONBodyFrequency = "daily"
+	SetDigestPreferencesJSONBodyFrequencyHourly SetDigestPreferencesJSONBodyFrequency = "hourly"
+	SetDigestPreferencesJSONBodyFrequencyWeekly SetDigestPreferencesJSONBodyFrequency = "weekly"
+)
+
+// AwayPeriod defines model for AwayPeriod.
+type AwayPeriod struct {
+	From         time.Time  `json:"from"`
+	HandedOverAt *time.Time `json:"handed_over_at,omitempty"`
+
+	// Handover Передать открытые ревью другим участникам в начале периода
+	Handover   bool       `json:"handover"`
+	Id         int64      `json:"id"`
+	Reason     *string    `json:"reason,omitempty"`
+	ReturnedAt *time.Time `json:"returned_at,omitempty"`
+	Until      time.Time  `json:"until"`
+	UserId     string     `json:"user_id"`
+}
+
+// BulkData defines model for BulkData.
+type BulkData struct {
+	PullRequests *[]BulkPullRequest `json:"pull_requests,omitempty"`
+	Teams        *[]BulkTeam        `json:"teams,omitempty"`
+	Users        *[]User            `json:"users,omitempty"`
+}
+
+// BulkPullRequest defines model for BulkPullRequest.
+type BulkPullRequest struct {
+	AssignedReviewers *[]string              `json:"assigned_reviewers,omitempty"`
+	AuthorId          string                 `json:"author_id"`
+	CreatedAt         *time.Time             `json:"createdAt,omitempty"`
+	MergedAt          *time.Time             `json:"mergedAt,omitempty"`
+	PullRequestId     string                 `json:"pull_request_id"`
+	PullRequestName   string                 `json:"pull_request_name"`
+	Status            *BulkPullRequestStatus `json:"status,omitempty"`
+}
+
+// BulkPullRequestStatus defines model for BulkPullRequest.Status.
+type BulkPullRequestStatus string
+
+// BulkTeam Команда вместе с политикой (как в PUT /team/policy) и пулами из /team/setReviewerPools
+type BulkTeam struct {
+	AllowSelfMerge         *bool                      `json:"allow_self_merge,omitempty"`
+	CompositionRules       *[]CompositionRule         `json:"composition_rules,omitempty"`
+	FallbackTeams          *[]string                  `json:"fallback_teams,omitempty"`
+	HierarchyFallback      *bool                      `json:"hierarchy_fallback,omitempty"`
+	MaxOpenReviews         *int                       `json:"max_open_reviews,omitempty"`
+	ReassignOnDeactivation *bool                      `json:"reassign_on_deactivation,omitempty"`
+	RequiredApprovals      *int                       `json:"required_approvals,omitempty"`
+	RequiredReviewers      *int                       `json:"required_reviewers,omitempty"`
+	SecurityTeam           *string                    `json:"security_team,omitempty"`
+	SelectionStrategy      *BulkTeamSelectionStrategy `json:"selection_strategy,omitempty"`
+	SharedReviewers        *[]string                  `json:"shared_reviewers,omitempty"`
+	TeamName               string                     `json:"team_name"`
+}
+
+// BulkTeamSelectionStrategy defines model for BulkTeam.SelectionStrategy.
+type BulkTeamSelectionStrategy string
+
+// CompositionRule Ограничение на состав ревьюверов PR по грейдам
+type CompositionRule struct {
+	// AtLeast Минимальное число ревьюверов с грейдом в диапазоне [min_grade, max_grade]; не больше required_reviewers политики
+	AtLeast *int `json:"at_least,omitempty"`
+
+	// AtMost Максимальное число таких ревьюверов
+	AtMost *int `json:"at_most,omitempty"`
+
+	// AuthorGrade Уровень разработчика
+	AuthorGrade *Grade `json:"author_grade,omitempty"`
+
+	// MaxGrade Уровень разработчика
+	MaxGrade *Grade `json:"max_grade,omitempty"`
+
+	// MinGrade Уровень разработчика
+	MinGrade *Grade `json:"min_grade,omitempty"`
+}
+
+// DigestPreferences defines model for DigestPreferences.
+type DigestPreferences struct {
+	// Email Адрес для SMTP-уведомлений
+	Email *string `json:"email,omitempty"`
+
+	// Enabled Получать ли дайджест ожидающих ревью
+	Enabled bool `json:"enabled"`
+
+	// Frequency Как часто отправлять дайджест
+	Frequency  DigestPreferencesFrequency `json:"frequency"`
+	LastSentAt *time.Time                 `json:"last_sent_at,omitempty"`
+	UserId     string                     `json:"user_id"`
+}
+
+// DigestPreferencesFrequency Как часто отправлять дайджест
+type DigestPreferencesFrequency string
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error struct {
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Details Нарушения по отдельным полям запроса
+		Details *[]struct {
+			// Field Путь к полю (например, members[0].user_id) или имя параметра
+			Field   string `json:"field"`
+			Message string `json:"message"`
+
+			// Rule Нарушенное правило (required, maxLength, enum, range, format, consistent, ...)
+			Rule string `json:"rule"`
+		} `json:"details,omitempty"`
+
+		// DocumentationUrl Ссылка на описание кода ошибки
+		DocumentationUrl *string `json:"documentation_url,omitempty"`
+
+		// Message Сообщение на языке из заголовка Accept-Language (en или ru, по умолчанию en)
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+type ErrorResponseErrorCode string
+
+// ExpertiseScore defines model for ExpertiseScore.
+type ExpertiseScore struct {
+	// Area Область экспертизы: label:<метка> или path:[<репозиторий>:]<каталог> (до трёх уровней вложенности)
+	Area string `json:"area"`
+
+	// Score Оценка с учётом затухания (вдвое меньше каждые 30 дней)
+	Score float32 `json:"score"`
+
+	// UpdatedAt Время последнего ревью в этой области
+	UpdatedAt time.Time `json:"updatedAt"`
+	UserId    string    `json:"user_id"`
+}
+
+// Grade Уровень разработчика
+type Grade string
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	// Applied Данные записаны (только если ошибок нет и dry_run=false)
+	Applied bool `json:"applied"`
+	DryRun  bool `json:"dry_run"`
+	Errors  []struct {
+		Id       *string                    `json:"id,omitempty"`
+		Message  string                     `json:"message"`
+		Resource ImportReportErrorsResource `json:"resource"`
+
+		// Row Номер строки внутри ресурса, начиная с 1 (без заголовка CSV)
+		Row int `json:"row"`
+	} `json:"errors"`
+	PullRequests int `json:"pull_requests"`
+	Teams        int `json:"teams"`
+	Users        int `json:"users"`
+}
+
+// ImportReportErrorsResource defines model for ImportReport.Errors.Resource.
+type ImportReportErrorsResource string
+
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	// AssignedReviewers user_id назначенных ревьюверов (0..2)
+	AssignedReviewers []string `json:"assigned_reviewers"`
+	AuthorId          string   `json:"author_id"`
+
+	// ChangedFiles Изменённые файлы (пути относительно корня репозитория)
+	ChangedFiles *[]string  `json:"changed_files,omitempty"`
+	CreatedAt    *time.Time `json:"createdAt"`
+	Description  *string    `json:"description,omitempty"`
+
+	// Labels Метки PR
+	Labels          *[]string            `json:"labels,omitempty"`
+	MergedAt        *time.Time           `json:"mergedAt"`
+	Priority        *PullRequestPriority `json:"priority,omitempty"`
+	PullRequestId   string               `json:"pull_request_id"`
+	PullRequestName string               `json:"pull_request_name"`
+
+	// Repository Репозиторий, к которому относится PR
+	Repository *string           `json:"repository,omitempty"`
+	Status     PullRequestStatus `json:"status"`
+
+	// Version Версия PR; увеличивается при каждом изменении и совпадает с ETag
+	Version *int `json:"version,omitempty"`
+}
+
+// PullRequestStatus defines model for PullRequest.Status.
+type PullRequestStatus string
+
+// PullRequestPriority defines model for PullRequestPriority.
+type PullRequestPriority string
+
+// PullRequestShort defines model for PullRequestShort.
+type PullRequestShort struct {
+	AuthorId        string                 `json:"author_id"`
+	Labels          *[]string              `json:"labels,omitempty"`
+	Priority        *PullRequestPriority   `json:"priority,omitempty"`
+	PullRequestId   string                 `json:"pull_request_id"`
+	PullRequestName string                 `json:"pull_request_name"`
+	Status          PullRequestShortStatus `json:"status"`
+}
+
+// PullRequestShortStatus defines model for PullRequestShort.Status.
+type PullRequestShortStatus string
+
+// ReassignmentReport defines model for ReassignmentReport.
+type ReassignmentReport struct {
+	Reassignments []ReviewerReassignment `json:"reassignments"`
+
+	// UnreplacedPullRequests OPEN PR, для которых не нашлось замены
+	UnreplacedPullRequests []string `json:"unreplaced_pull_requests"`
+}
+
+// Repository defines model for Repository.
+type Repository struct {
+	// Codeowners Содержимое CODEOWNERS (синтаксис GitHub)
+	Codeowners     string `json:"codeowners"`
+	RepositoryName string `json:"repository_name"`
+
+	// TeamName Команда-владелец репозитория
+	TeamName  *string    `json:"team_name,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// ReviewerAffinity Правило для пары автор → ревьювер. blocked исключает ревьювера из кандидатов
+// для PR этого автора; weight > 0 повышает шанс его выбора.
+// Правило направленное: для взаимного запрета нужны две записи.
+type ReviewerAffinity struct {
+	AuthorId   string  `json:"author_id"`
+	Blocked    bool    `json:"blocked"`
+	Reason     *string `json:"reason,omitempty"`
+	ReviewerId string  `json:"reviewer_id"`
+
+	// Weight Вес предпочтения (1-10); для заблокированной пары 0
+	Weight int `json:"weight"`
+}
+
+// ReviewerReassignment defines model for ReviewerReassignment.
+type ReviewerReassignment struct {
+	NewReviewerId string `json:"new_reviewer_id"`
+	OldReviewerId string `json:"old_reviewer_id"`
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// SLABreach defines model for SLABreach.
+type SLABreach struct {
+	Deadline      time.Time     `json:"deadline"`
+	DetectedAt    time.Time     `json:"detected_at"`
+	Id            int64         `json:"id"`
+	Kind          SLABreachKind `json:"kind"`
+	PullRequestId string        `json:"pull_request_id"`
+	ReassignedTo  *string       `json:"reassigned_to,omitempty"`
+	ResolvedAt    *time.Time    `json:"resolved_at,omitempty"`
+
+	// ReviewerId Ревьювер, нарушивший SLA (только для FIRST_REVIEW)
+	ReviewerId *string   `json:"reviewer_id,omitempty"`
+	StartedAt  time.Time `json:"started_at"`
+	TeamName   string    `json:"team_name"`
+}
+
+// SLABreachKind defines model for SLABreach.Kind.
+type SLABreachKind string
+
+// SLASettings defines model for SLASettings.
+type SLASettings struct {
+	// AutoReassign Автоматически переназначать ревьювера, нарушившего SLA
+	AutoReassign bool   `json:"auto_reassign"`
+	TeamName     string `json:"team_name"`
+
+	// TimeToFirstReviewMinutes Время от назначения ревьювера до начала ревью (0 — не отслеживается)
+	TimeToFirstReviewMinutes int `json:"time_to_first_review_minutes"`
+
+	// TimeToMergeMinutes Время от создания PR до merge (0 — не отслеживается)
+	TimeToMergeMinutes int `json:"time_to_merge_minutes"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	// FallbackTeams Команды (по порядку), из которых добираются ревьюверы, если в команде автора не хватает активных участников
+	FallbackTeams *[]string    `json:"fallback_teams,omitempty"`
+	Members       []TeamMember `json:"members"`
+
+	// ParentTeam Родительская команда (например, отдел), если команда входит в иерархию
+	ParentTeam *string `json:"parent_team,omitempty"`
+
+	// ReassignOnDeactivation Переназначать открытые ревью при деактивации участника (по умолчанию для команды)
+	ReassignOnDeactivation *bool `json:"reassign_on_deactivation,omitempty"`
+
+	// SelectionStrategy Способ выбора ревьюверов. working_hours предпочитает тех, у кого сейчас рабочее время, затем пользователей без расписания, затем тех, у кого рабочее время наступит раньше других. expertise ранжирует кандидатов по опыту ревью тех же меток и каталогов с небольшой случайностью, чтобы нагрузка не ложилась на одного эксперта.
+	SelectionStrategy *TeamSelectionStrategy `json:"selection_strategy,omitempty"`
+
+	// SharedReviewers Общий пул ревьюверов (user_id), используется после fallback-команд
+	SharedReviewers *[]string `json:"shared_reviewers,omitempty"`
+
+	// SubTeams Дочерние команды со всеми уровнями вложенности (только при include_sub_teams=true)
+	SubTeams *[]Team `json:"sub_teams,omitempty"`
+	TeamName string  `json:"team_name"`
+}
+
+// TeamSelectionStrategy Способ выбора ревьюверов. working_hours предпочитает тех, у кого сейчас рабочее время, затем пользователей без расписания, затем тех, у кого рабочее время наступит раньше других. expertise ранжирует кандидатов по опыту ревью тех же меток и каталогов с небольшой случайностью, чтобы нагрузка не ложилась на одного эксперта.
+type TeamSelectionStrategy string
+
+// TeamHierarchy defines model for TeamHierarchy.
+type TeamHierarchy struct {
+	// Ancestors Цепочка родительских команд от ближайшей к корню
+	Ancestors []string   `json:"ancestors"`
+	SubTeams  []TeamNode `json:"sub_teams"`
+	TeamName  string     `json:"team_name"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	// Grade Уровень разработчика
+	Grade    *Grade `json:"grade,omitempty"`
+	IsActive bool   `json:"is_active"`
+
+	// TeamName Команда пользователя (заполняется сервером)
+	TeamName *string `json:"team_name,omitempty"`
+	UserId   string  `json:"user_id"`
+	Username string  `json:"username"`
+}
+
+// TeamNode defines model for TeamNode.
+type TeamNode struct {
+	SubTeams []TeamNode `json:"sub_teams"`
+	TeamName string     `json:"team_name"`
+}
+
+// TeamPolicy defines model for TeamPolicy.
+type TeamPolicy struct {
+	// AllowSelfMerge Может ли автор сам мержить свой PR
+	AllowSelfMerge bool `json:"allow_self_merge"`
+
+	// CompositionRules Правила состава ревьюверов; правило с author_grade применяется только к PR авторов этого грейда
+	CompositionRules *[]CompositionRule `json:"composition_rules,omitempty"`
+
+	// HierarchyFallback Если кандидатов не хватает, добирать ревьюверов вверх по иерархии: сначала из соседних команд под ближайшим родителем, затем уровнем выше и т.д. Срабатывает после fallback-команд и общего пула.
+	HierarchyFallback *bool `json:"hierarchy_fallback,omitempty"`
+
+	// MaxOpenReviews Максимум открытых ревью на одного ревьювера (0 — без ограничения). PR с меткой hotfix назначаются без учёта лимита.
+	MaxOpenReviews         *int `json:"max_open_reviews,omitempty"`
+	ReassignOnDeactivation bool `json:"reassign_on_deactivation"`
+
+	// RequiredApprovals Сколько одобрений назначенных ревьюверов нужно для merge (не больше required_reviewers)
+	RequiredApprovals int `json:"required_approvals"`
+
+	// RequiredReviewers Сколько ревьюверов назначать при создании PR
+	RequiredReviewers int `json:"required_reviewers"`
+
+	// SecurityTeam Команда, из которой PR с меткой security обязательно получает хотя бы одного ревьювера
+	SecurityTeam      *string                     `json:"security_team,omitempty"`
+	SelectionStrategy TeamPolicySelectionStrategy `json:"selection_strategy"`
+	TeamName          string                      `json:"team_name"`
+}
+
+// TeamPolicySelectionStrategy defines model for TeamPolicy.SelectionStrategy.
+type TeamPolicySelectionStrategy string
+
+// TeamStats defines model for TeamStats.
+type TeamStats struct {
+	ActiveMembers      int `json:"active_members"`
+	Members            int `json:"members"`
+	MergedPullRequests int `json:"merged_pull_requests"`
+
+	// OpenPullRequests Открытые PR, авторы которых входят в команды
+	OpenPullRequests int `json:"open_pull_requests"`
+
+	// OpenReviewAssignments Назначения на ревью открытых PR у участников команд
+	OpenReviewAssignments int    `json:"open_review_assignments"`
+	TeamName              string `json:"team_name"`
+
+	// Teams Команды, по которым посчитана статистика
+	Teams []string `json:"teams"`
+}
+
+// User defines model for User.
+type User struct {
+	// Grade Уровень разработчика
+	Grade    *Grade `json:"grade,omitempty"`
+	IsActive bool   `json:"is_active"`
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// UserPage defines model for UserPage.
+type UserPage struct {
+	Limit int `json:"limit"`
+
+	// NextOffset Смещение следующей страницы; отсутствует на последней странице
+	NextOffset *int   `json:"next_offset,omitempty"`
+	Offset     int    `json:"offset"`
+	Users      []User `json:"users"`
+}
+
+// WorkSchedule defines model for WorkSchedule.
+type WorkSchedule struct {
+	// TimeZone Часовой пояс IANA
+	TimeZone string `json:"time_zone"`
+
+	// WorkDays Рабочие дни недели (для ночной смены — день её начала), 0 — воскресенье, 6 — суббота
+	WorkDays []int `json:"work_days"`
+
+	// WorkEnd Конец рабочего дня (HH:MM, локальное время). Если он раньше work_start, смена ночная и заканчивается на следующий день
+	WorkEnd string `json:"work_end"`
+
+	// WorkStart Начало рабочего дня (HH:MM, локальное время)
+	WorkStart string `json:"work_start"`
+}
+
+// IncludeSubTeamsQuery defines model for IncludeSubTeamsQuery.
+type IncludeSubTeamsQuery = bool
+
+// TeamNameQuery defines model for TeamNameQuery.
+type TeamNameQuery = string
+
+// UserIdQuery defines model for UserIdQuery.
+type UserIdQuery = string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ErrorResponse
+
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ErrorResponse
+
+// ValidationFailed defines model for ValidationFailed.
+type ValidationFailed = ErrorResponse
+
+// ExportDataParams defines parameters for ExportData.
+type ExportDataParams struct {
+	Format *ExportDataParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Resource Экспортируемый ресурс (обязателен для csv; для json остальные списки будут пустыми)
+	Resource *ExportDataParamsResource `form:"resource,omitempty" json:"resource,omitempty"`
+}
+
+// ExportDataParamsFormat defines parameters for ExportData.
+type ExportDataParamsFormat string
+
+// ExportDataParamsResource defines parameters for ExportData.
+type ExportDataParamsResource string
+
+// ImportDataParams defines parameters for ImportData.
+type ImportDataParams struct {
+	// DryRun Только проверить данные и вернуть отчёт
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// Resource Ресурс CSV-файла (обязателен для text/csv)
+	Resource *ImportDataParamsResource `form:"resource,omitempty" json:"resource,omitempty"`
+}
+
+// ImportDataParamsResource defines parameters for ImportData.
+type ImportDataParamsResource string
+
+// DeleteAffinityJSONBody defines parameters for DeleteAffinity.
+type DeleteAffinityJSONBody struct {
+	AuthorId   string `json:"author_id"`
+	ReviewerId string `json:"reviewer_id"`
+}
+
+// ListAffinitiesParams defines parameters for ListAffinities.
+type ListAffinitiesParams struct {
+	AuthorId string `form:"author_id" json:"author_id"`
+}
+
+// SetAffinityJSONBody defines parameters for SetAffinity.
+type SetAffinityJSONBody struct {
+	AuthorId   string  `json:"author_id"`
+	Blocked    *bool   `json:"blocked,omitempty"`
+	Reason     *string `json:"reason,omitempty"`
+	ReviewerId string  `json:"reviewer_id"`
+	Weight     *int    `json:"weight,omitempty"`
+}
+
+// ApprovePullRequestJSONBody defines parameters for ApprovePullRequest.
+type ApprovePullRequestJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// CreatePullRequestJSONBody defines parameters for CreatePullRequest.
+type CreatePullRequestJSONBody struct {
+	AuthorId string `json:"author_id"`
+
+	// ChangedFiles Изменённые файлы. Владельцы путей из CODEOWNERS репозитория назначаются первыми,
+	// остальные места добираются кандидатами из команды автора.
+	ChangedFiles *[]string `json:"changed_files,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+
+	// Labels Метки PR; вместе с каталогами файлов используются стратегией expertise.
+	// security требует ревьювера из security_team политики, hotfix игнорирует max_open_reviews.
+	Labels          *[]string            `json:"labels,omitempty"`
+	Priority        *PullRequestPriority `json:"priority,omitempty"`
+	PullRequestId   string               `json:"pull_request_id"`
+	PullRequestName string               `json:"pull_request_name"`
+
+	// Repository Имя зарегистрированного репозитория
+	Repository *string `json:"repository,omitempty"`
+}
+
+// MergePullRequestJSONBody defines parameters for MergePullRequest.
+type MergePullRequestJSONBody struct {
+	// MergedBy Кто мержит PR; обязателен, если политика команды запрещает self-merge
+	MergedBy      *string `json:"merged_by,omitempty"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// ReassignReviewerJSONBody defines parameters for ReassignReviewer.
+type ReassignReviewerJSONBody struct {
+	OldUserId     string `json:"old_user_id"`
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// UpdatePullRequestJSONBody defines parameters for UpdatePullRequest.
+type UpdatePullRequestJSONBody struct {
+	AuthorId        *string   `json:"author_id,omitempty"`
+	Description     *string   `json:"description,omitempty"`
+	Labels          *[]string `json:"labels,omitempty"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName *string   `json:"pull_request_name,omitempty"`
+	Version         *int      `json:"version,omitempty"`
+}
+
+// UpdatePullRequestParams defines parameters for UpdatePullRequest.
+type UpdatePullRequestParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// AddRepositoryJSONBody defines parameters for AddRepository.
+type AddRepositoryJSONBody struct {
+	RepositoryName string  `json:"repository_name"`
+	TeamName       *string `json:"team_name,omitempty"`
+}
+
+// GetRepositoryParams defines parameters for GetRepository.
+type GetRepositoryParams struct {
+	RepositoryName string `form:"repository_name" json:"repository_name"`
+}
+
+// UploadCodeOwnersJSONBody defines parameters for UploadCodeOwners.
+type UploadCodeOwnersJSONBody struct {
+	Content        string `json:"content"`
+	RepositoryName string `json:"repository_name"`
+}
+
+// ListSLABreachesParams defines parameters for ListSLABreaches.
+type ListSLABreachesParams struct {
+	TeamName *string                    `form:"team_name,omitempty" json:"team_name,omitempty"`
+	Kind     *ListSLABreachesParamsKind `form:"kind,omitempty" json:"kind,omitempty"`
+	OpenOnly *bool                      `form:"open_only,omitempty" json:"open_only,omitempty"`
+}
+
+// ListSLABreachesParamsKind defines parameters for ListSLABreaches.
+type ListSLABreachesParamsKind string
+
+// GetSLASettingsParams defines parameters for GetSLASettings.
+type GetSLASettingsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamParams defines parameters for GetTeam.
+type GetTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// IncludeSubTeams Включить все дочерние команды
+	IncludeSubTeams *IncludeSubTeamsQuery `form:"include_sub_teams,omitempty" json:"include_sub_teams,omitempty"`
+}
+
+// GetTeamHierarchyParams defines parameters for GetTeamHierarchy.
+type GetTeamHierarchyParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamPolicyParams defines parameters for GetTeamPolicy.
+type GetTeamPolicyParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// SetTeamPolicyJSONBody defines parameters for SetTeamPolicy.
+type SetTeamPolicyJSONBody struct {
+	AllowSelfMerge         *bool                                   `json:"allow_self_merge,omitempty"`
+	CompositionRules       *[]CompositionRule                      `json:"composition_rules,omitempty"`
+	HierarchyFallback      *bool                                   `json:"hierarchy_fallback,omitempty"`
+	MaxOpenReviews         *int                                    `json:"max_open_reviews,omitempty"`
+	ReassignOnDeactivation *bool                                   `json:"reassign_on_deactivation,omitempty"`
+	RequiredApprovals      *int                                    `json:"required_approvals,omitempty"`
+	RequiredReviewers      *int                                    `json:"required_reviewers,omitempty"`
+	SecurityTeam           *string                                 `json:"security_team,omitempty"`
+	SelectionStrategy      *SetTeamPolicyJSONBodySelectionStrategy `json:"selection_strategy,omitempty"`
+	TeamName               string                                  `json:"team_name"`
+}
+
+// SetTeamPolicyJSONBodySelectionStrategy defines parameters for SetTeamPolicy.
+type SetTeamPolicyJSONBodySelectionStrategy string
+
+// SetParentTeamJSONBody defines parameters for SetParentTeam.
+type SetParentTeamJSONBody struct {
+	ParentTeam *string `json:"parent_team,omitempty"`
+	TeamName   string  `json:"team_name"`
+}
+
+// SetReviewerPoolsJSONBody defines parameters for SetReviewerPools.
+type SetReviewerPoolsJSONBody struct {
+	FallbackTeams   *[]string `json:"fallback_teams,omitempty"`
+	SharedReviewers *[]string `json:"shared_reviewers,omitempty"`
+	TeamName        string    `json:"team_name"`
+}
+
+// GetTeamStatsParams defines parameters for GetTeamStats.
+type GetTeamStatsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// IncludeSubTeams Включить все дочерние команды
+	IncludeSubTeams *IncludeSubTeamsQuery `form:"include_sub_teams,omitempty" json:"include_sub_teams,omitempty"`
+}
+
+// DeleteUserParams defines parameters for DeleteUser.
+type DeleteUserParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetDigestPreferencesParams defines parameters for GetDigestPreferences.
+type GetDigestPreferencesParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// SetDigestPreferencesJSONBody defines parameters for SetDigestPreferences.
+type SetDigestPreferencesJSONBody struct {
+	Email     *string                                `json:"email,omitempty"`
+	Enabled   *bool                                  `json:"enabled,omitempty"`
+	Frequency *SetDigestPreferencesJSONBodyFrequency `json:"frequency,omitempty"`
+	UserId    string                                 `json:"user_id"`
+}
+
+// SetDigestPreferencesJSONBodyFrequency defines parameters for SetDigestPreferences.
+type SetDigestPreferencesJSONBodyFrequency string
+
+// GetUserExpertiseParams defines parameters for GetUserExpertise.
+type GetUserExpertiseParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetUserParams defines parameters for GetUser.
+type GetUserParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetUserReviewParams defines parameters for GetUserReview.
+type GetUserReviewParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Label Только PR с этой меткой (можно повторять — нужны все метки)
+	Label    *[]string            `form:"label,omitempty" json:"label,omitempty"`
+	Priority *PullRequestPriority `form:"priority,omitempty" json:"priority,omitempty"`
+}
+
+// ListUsersParams defines parameters for ListUsers.
+type ListUsersParams struct {
+	// TeamName Только участники команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// IsActive Только активные или только неактивные пользователи
+	IsActive *bool `form:"is_active,omitempty" json:"is_active,omitempty"`
+
+	// UsernamePrefix Начало имени пользователя (без учёта регистра)
+	UsernamePrefix *string `form:"username_prefix,omitempty" json:"username_prefix,omitempty"`
+	Limit          *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset         *int    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// SetUserAwayJSONBody defines parameters for SetUserAway.
+type SetUserAwayJSONBody struct {
+	From     time.Time `json:"from"`
+	Handover *bool     `json:"handover,omitempty"`
+	Reason   *string   `json:"reason,omitempty"`
+	Until    time.Time `json:"until"`
+	UserId   string    `json:"user_id"`
+}
+
+// SetUserIsActiveJSONBody defines parameters for SetUserIsActive.
+type SetUserIsActiveJSONBody struct {
+	IsActive bool `json:"is_active"`
+
+	// ReassignReviews Переназначить открытые ревью пользователя при деактивации. Если не указан, используется reassign_on_deactivation команды.
+	ReassignReviews *bool  `json:"reassign_reviews,omitempty"`
+	UserId          string `json:"user_id"`
+}
+
+// SetUserScheduleJSONBody defines parameters for SetUserSchedule.
+type SetUserScheduleJSONBody struct {
+	TimeZone  *string `json:"time_zone,omitempty"`
+	UserId    string  `json:"user_id"`
+	WorkDays  []int   `json:"work_days"`
+	WorkEnd   string  `json:"work_end"`
+	WorkStart string  `json:"work_start"`
+}
+
+// UpdateUsernameJSONBody defines parameters for UpdateUsername.
+type UpdateUsernameJSONBody struct {
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// ImportDataJSONRequestBody defines body for ImportData for application/json ContentType.
+type ImportDataJSONRequestBody = BulkData
+
+// DeleteAffinityJSONRequestBody defines body for DeleteAffinity for application/json ContentType.
+type DeleteAffinityJSONRequestBody DeleteAffinityJSONBody
+
+// SetAffinityJSONRequestBody defines body for SetAffinity for application/json ContentType.
+type SetAffinityJSONRequestBody SetAffinityJSONBody
+
+// ApprovePullRequestJSONRequestBody defines body for ApprovePullRequest for application/json ContentType.
+type ApprovePullRequestJSONRequestBody ApprovePullRequestJSONBody
+
+// CreatePullRequestJSONRequestBody defines body for CreatePullRequest for application/json ContentType.
+type CreatePullRequestJSONRequestBody CreatePullRequestJSONBody
+
+// MergePullRequestJSONRequestBody defines body for MergePullRequest for application/json ContentType.
+type MergePullRequestJSONRequestBody MergePullRequestJSONBody
+
+// ReassignReviewerJSONRequestBody defines body for ReassignReviewer for application/json ContentType.
+type ReassignReviewerJSONRequestBody ReassignReviewerJSONBody
+
+// UpdatePullRequestJSONRequestBody defines body for UpdatePullRequest for application/json ContentType.
+type UpdatePullRequestJSONRequestBody UpdatePullRequestJSONBody
+
+// AddRepositoryJSONRequestBody defines body for AddRepository for application/json ContentType.
+type AddRepositoryJSONRequestBody AddRepositoryJSONBody
+
+// UploadCodeOwnersJSONRequestBody defines body for UploadCodeOwners for application/json ContentType.
+type UploadCodeOwnersJSONRequestBody UploadCodeOwnersJSONBody
+
+// SetSLASettingsJSONRequestBody defines body for SetSLASettings for application/json ContentType.
+type SetSLASettingsJSONRequestBody = SLASettings
+
+// AddTeamJSONRequestBody defines body for AddTeam for application/json ContentType.
+type AddTeamJSONRequestBody = Team
+
+// SetTeamPolicyJSONRequestBody defines body for SetTeamPolicy for application/json ContentType.
+type SetTeamPolicyJSONRequestBody SetTeamPolicyJSONBody
+
+// SetParentTeamJSONRequestBody defines body for SetParentTeam for application/json ContentType.
+type SetParentTeamJSONRequestBody SetParentTeamJSONBody
+
+// SetReviewerPoolsJSONRequestBody defines body for SetReviewerPools for application/json ContentType.
+type SetReviewerPoolsJSONRequestBody SetReviewerPoolsJSONBody
+
+// SetDigestPreferencesJSONRequestBody defines body for SetDigestPreferences for application/json ContentType.
+type SetDigestPreferencesJSONRequestBody SetDigestPreferencesJSONBody
+
+// SetUserAwayJSONRequestBody defines body for SetUserAway for application/json ContentType.
+type SetUserAwayJSONRequestBody SetUserAwayJSONBody
+
+// SetUserIsActiveJSONRequestBody defines body for SetUserIsActive for application/json ContentType.
+type SetUserIsActiveJSONRequestBody SetUserIsActiveJSONBody
+
+// SetUserScheduleJSONRequestBody defines body for SetUserSchedule for application/json ContentType.
+type SetUserScheduleJSONRequestBody SetUserScheduleJSONBody
+
+// UpdateUsernameJSONRequestBody defines body for UpdateUsername for application/json ContentType.
+type UpdateUsernameJSONRequestBody UpdateUsernameJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Массовый экспорт команд, пользователей и PR
+	// (GET /admin/export)
+	ExportData(c *gin.Context, params ExportDataParams)
+	// Массовый импорт команд, пользователей и PR
+	// (POST /admin/import)
+	ImportData(c *gin.Context, params ImportDataParams)
+	// Удалить правило для пары автор → ревьювер
+	// (POST /affinity/delete)
+	DeleteAffinity(c *gin.Context)
+	// Правила исключения и предпочтений ревьюверов для автора
+	// (GET /affinity/list)
+	ListAffinities(c *gin.Context, params ListAffinitiesParams)
+	// Создать или обновить правило для пары автор → ревьювер
+	// (POST /affinity/set)
+	SetAffinity(c *gin.Context)
+	// Одобрить PR назначенным ревьювером
+	// (POST /pullRequest/approve)
+	ApprovePullRequest(c *gin.Context)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	CreatePullRequest(c *gin.Context)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	MergePullRequest(c *gin.Context)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	ReassignReviewer(c *gin.Context)
+	// Изменить название, описание, метки или автора открытого PR
+	// (PATCH /pullRequest/update)
+	UpdatePullRequest(c *gin.Context, params UpdatePullRequestParams)
+	// Зарегистрировать репозиторий (или обновить команду-владельца)
+	// (POST /repository/add)
+	AddRepository(c *gin.Context)
+	// Получить репозиторий и его CODEOWNERS
+	// (GET /repository/get)
+	GetRepository(c *gin.Context, params GetRepositoryParams)
+	// Загрузить файл CODEOWNERS репозитория
+	// (POST /repository/uploadCodeOwners)
+	UploadCodeOwners(c *gin.Context)
+	// Список нарушений SLA (для дашбордов)
+	// (GET /sla/breaches)
+	ListSLABreaches(c *gin.Context, params ListSLABreachesParams)
+	// Получить SLA-настройки команды
+	// (GET /sla/settings)
+	GetSLASettings(c *gin.Context, params GetSLASettingsParams)
+	// Установить SLA-настройки команды
+	// (POST /sla/settings)
+	SetSLASettings(c *gin.Context)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	AddTeam(c *gin.Context)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeam(c *gin.Context, params GetTeamParams)
+	// Получить положение команды в иерархии
+	// (GET /team/hierarchy)
+	GetTeamHierarchy(c *gin.Context, params GetTeamHierarchyParams)
+	// Получить политику назначения ревьюверов команды
+	// (GET /team/policy)
+	GetTeamPolicy(c *gin.Context, params GetTeamPolicyParams)
+	// Задать политику назначения ревьюверов команды
+	// (PUT /team/policy)
+	SetTeamPolicy(c *gin.Context)
+	// Задать родительскую команду
+	// (POST /team/setParent)
+	SetParentTeam(c *gin.Context)
+	// Задать fallback-команды и общий пул ревьюверов
+	// (POST /team/setReviewerPools)
+	SetReviewerPools(c *gin.Context)
+	// Статистика команды
+	// (GET /team/stats)
+	GetTeamStats(c *gin.Context, params GetTeamStatsParams)
+	// Удалить пользователя
+	// (DELETE /users/delete)
+	DeleteUser(c *gin.Context, params DeleteUserParams)
+	// Получить настройки дайджеста напоминаний о ревью
+	// (GET /users/digestPreferences)
+	GetDigestPreferences(c *gin.Context, params GetDigestPreferencesParams)
+	// Отписаться от дайджеста или изменить его частоту
+	// (POST /users/digestPreferences)
+	SetDigestPreferences(c *gin.Context)
+	// Получить оценки экспертизы пользователя
+	// (GET /users/expertise)
+	GetUserExpertise(c *gin.Context, params GetUserExpertiseParams)
+	// Получить пользователя
+	// (GET /users/get)
+	GetUser(c *gin.Context, params GetUserParams)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUserReview(c *gin.Context, params GetUserReviewParams)
+	// Список пользователей
+	// (GET /users/list)
+	ListUsers(c *gin.Context, params ListUsersParams)
+	// Запланировать период отсутствия пользователя (is_active не меняется)
+	// (POST /users/setAway)
+	SetUserAway(c *gin.Context)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	SetUserIsActive(c *gin.Context)
+	// Установить часовой пояс и рабочие часы пользователя
+	// (POST /users/setSchedule)
+	SetUserSchedule(c *gin.Context)
+	// Изменить имя пользователя
+	// (PATCH /users/update)
+	UpdateUsername(c *gin.Context)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// ExportData operation middleware
+func (siw *ServerInterfaceWrapper) ExportData(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportDataParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "resource" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource", c.Request.URL.Query(), &params.Resource)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resource: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ExportData(c, params)
+}
+
+// ImportData operation middleware
+func (siw *ServerInterfaceWrapper) ImportData(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportDataParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "resource" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource", c.Request.URL.Query(), &params.Resource)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resource: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ImportData(c, params)
+}
+
+// DeleteAffinity operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAffinity(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteAffinity(c)
+}
+
+// ListAffinities operation middleware
+func (siw *ServerInterfaceWrapper) ListAffinities(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAffinitiesParams
+
+	// ------------- Required query parameter "author_id" -------------
+
+	if paramValue := c.Query("author_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument author_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "author_id", c.Request.URL.Query(), &params.AuthorId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter author_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListAffinities(c, params)
+}
+
+// SetAffinity operation middleware
+func (siw *ServerInterfaceWrapper) SetAffinity(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetAffinity(c)
+}
+
+// ApprovePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) ApprovePullRequest(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ApprovePullRequest(c)
+}
+
+// CreatePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreatePullRequest(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreatePullRequest(c)
+}
+
+// MergePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) MergePullRequest(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MergePullRequest(c)
+}
+
+// ReassignReviewer operation middleware
+func (siw *ServerInterfaceWrapper) ReassignReviewer(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReassignReviewer(c)
+}
+
+// UpdatePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePullRequest(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdatePullRequestParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdatePullRequest(c, params)
+}
+
+// AddRepository operation middleware
+func (siw *ServerInterfaceWrapper) AddRepository(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AddRepository(c)
+}
+
+// GetRepository operation middleware
+func (siw *ServerInterfaceWrapper) GetRepository(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRepositoryParams
+
+	// ------------- Required query parameter "repository_name" -------------
+
+	if paramValue := c.Query("repository_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument repository_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "repository_name", c.Request.URL.Query(), &params.RepositoryName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter repository_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRepository(c, params)
+}
+
+// UploadCodeOwners operation middleware
+func (siw *ServerInterfaceWrapper) UploadCodeOwners(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UploadCodeOwners(c)
+}
+
+// ListSLABreaches operation middleware
+func (siw *ServerInterfaceWrapper) ListSLABreaches(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSLABreachesParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", c.Request.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kind: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "open_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "open_only", c.Request.URL.Query(), &params.OpenOnly)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter open_only: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListSLABreaches(c, params)
+}
+
+// GetSLASettings operation middleware
+func (siw *ServerInterfaceWrapper) GetSLASettings(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSLASettingsParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := c.Query("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSLASettings(c, params)
+}
+
+// SetSLASettings operation middleware
+func (siw *ServerInterfaceWrapper) SetSLASettings(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetSLASettings(c)
+}
+
+// AddTeam operation middleware
+func (siw *ServerInterfaceWrapper) AddTeam(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AddTeam(c)
+}
+
+// GetTeam operation middleware
+func (siw *ServerInterfaceWrapper) GetTeam(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := c.Query("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_sub_teams" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_sub_teams", c.Request.URL.Query(), &params.IncludeSubTeams)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_sub_teams: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeam(c, params)
+}
+
+// GetTeamHierarchy operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamHierarchy(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamHierarchyParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := c.Query("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamHierarchy(c, params)
+}
+
+// GetTeamPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamPolicy(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamPolicyParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := c.Query("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamPolicy(c, params)
+}
+
+// SetTeamPolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetTeamPolicy(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetTeamPolicy(c)
+}
+
+// SetParentTeam operation middleware
+func (siw *ServerInterfaceWrapper) SetParentTeam(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetParentTeam(c)
+}
+
+// SetReviewerPools operation middleware
+func (siw *ServerInterfaceWrapper) SetReviewerPools(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetReviewerPools(c)
+}
+
+// GetTeamStats operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamStats(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamStatsParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := c.Query("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_sub_teams" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_sub_teams", c.Request.URL.Query(), &params.IncludeSubTeams)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_sub_teams: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamStats(c, params)
+}
+
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteUserParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteUser(c, params)
+}
+
+// GetDigestPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetDigestPreferences(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDigestPreferencesParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetDigestPreferences(c, params)
+}
+
+// SetDigestPreferences operation middleware
+func (siw *ServerInterfaceWrapper) SetDigestPreferences(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetDigestPreferences(c)
+}
+
+// GetUserExpertise operation middleware
+func (siw *ServerInterfaceWrapper) GetUserExpertise(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserExpertiseParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserExpertise(c, params)
+}
+
+// GetUser operation middleware
+func (siw *ServerInterfaceWrapper) GetUser(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUser(c, params)
+}
+
+// GetUserReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUserReview(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", c.Request.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter label: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", c.Request.URL.Query(), &params.Priority)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter priority: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserReview(c, params)
+}
+
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUsersParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "is_active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_active", c.Request.URL.Query(), &params.IsActive)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter is_active: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "username_prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username_prefix", c.Request.URL.Query(), &params.UsernamePrefix)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username_prefix: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListUsers(c, params)
+}
+
+// SetUserAway operation middleware
+func (siw *ServerInterfaceWrapper) SetUserAway(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetUserAway(c)
+}
+
+// SetUserIsActive operation middleware
+func (siw *ServerInterfaceWrapper) SetUserIsActive(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetUserIsActive(c)
+}
+
+// SetUserSchedule operation middleware
+func (siw *ServerInterfaceWrapper) SetUserSchedule(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetUserSchedule(c)
+}
+
+// UpdateUsername operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUsername(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateUsername(c)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/admin/export", wrapper.ExportData)
+	router.POST(options.BaseURL+"/admin/import", wrapper.ImportData)
+	router.POST(options.BaseURL+"/affinity/delete", wrapper.DeleteAffinity)
+	router.GET(options.BaseURL+"/affinity/list", wrapper.ListAffinities)
+	router.POST(options.BaseURL+"/affinity/set", wrapper.SetAffinity)
+	router.POST(options.BaseURL+"/pullRequest/approve", wrapper.ApprovePullRequest)
+	router.POST(options.BaseURL+"/pullRequest/create", wrapper.CreatePullRequest)
+	router.POST(options.BaseURL+"/pullRequest/merge", wrapper.MergePullRequest)
+	router.POST(options.BaseURL+"/pullRequest/reassign", wrapper.ReassignReviewer)
+	router.PATCH(options.BaseURL+"/pullRequest/update", wrapper.UpdatePullRequest)
+	router.POST(options.BaseURL+"/repository/add", wrapper.AddRepository)
+	router.GET(options.BaseURL+"/repository/get", wrapper.GetRepository)
+	router.POST(options.BaseURL+"/repository/uploadCodeOwners", wrapper.UploadCodeOwners)
+	router.GET(options.BaseURL+"/sla/breaches", wrapper.ListSLABreaches)
+	router.GET(options.BaseURL+"/sla/settings", wrapper.GetSLASettings)
+	router.POST(options.BaseURL+"/sla/settings", wrapper.SetSLASettings)
+	router.POST(options.BaseURL+"/team/add", wrapper.AddTeam)
+	router.GET(options.BaseURL+"/team/get", wrapper.GetTeam)
+	router.GET(options.BaseURL+"/team/hierarchy", wrapper.GetTeamHierarchy)
+	router.GET(options.BaseURL+"/team/policy", wrapper.GetTeamPolicy)
+	router.PUT(options.BaseURL+"/team/policy", wrapper.SetTeamPolicy)
+	router.POST(options.BaseURL+"/team/setParent", wrapper.SetParentTeam)
+	router.POST(options.BaseURL+"/team/setReviewerPools", wrapper.SetReviewerPools)
+	router.GET(options.BaseURL+"/team/stats", wrapper.GetTeamStats)
+	router.DELETE(options.BaseURL+"/users/delete", wrapper.DeleteUser)
+	router.GET(options.BaseURL+"/users/digestPreferences", wrapper.GetDigestPreferences)
+	router.POST(options.BaseURL+"/users/digestPreferences", wrapper.SetDigestPreferences)
+	router.GET(options.BaseURL+"/users/expertise", wrapper.GetUserExpertise)
+	router.GET(options.BaseURL+"/users/get", wrapper.GetUser)
+	router.GET(options.BaseURL+"/users/getReview", wrapper.GetUserReview)
+	router.GET(options.BaseURL+"/users/list", wrapper.ListUsers)
+	router.POST(options.BaseURL+"/users/setAway", wrapper.SetUserAway)
+	router.POST(options.BaseURL+"/users/setIsActive", wrapper.SetUserIsActive)
+	router.POST(options.BaseURL+"/users/setSchedule", wrapper.SetUserSchedule)
+	router.PATCH(options.BaseURL+"/users/update", wrapper.UpdateUsername)
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+x9/W4b2ZXnq1zULDDSoCRRst2TyAjQalvdrR23rZHszmxsgSiRV1KlyaJSVbStGAIs",
+	"K+7urJzWdDCLCQYzyWSy2P1jsQAtizZtSTQwT3DrFfIki3PuR92qulUsSpTtzuqfboss3rof557v8zuP",
+	"rFqrudnyqBcG1uwja9PxnSYNqY9/LXi1RrtOl9urt6nTDP6+Tf0t+LxOg5rvboZuy7NmLfZb9oYdRd9F",
+	"37Be9CR6RthBtMO6hB2yfvQN60aP2QnrwQdvWJ8dsw47YYfRnmVbLvz8FziqbXlOk1qzlsvfWQ3aq9UQ",
+	"3mrZVlDboE2Hv3nNaTdCa3bNaQTUtsKtTfjRaqvVoI5nbW/bFkz1ptOkebP9E87mDeuwo+gZO2F9mFmP",
+	"HUf75SYIk6riv23Lp79ouz6tW7Oh36b6RJvOwxvUWw83rNmZK1dsq+l68u9pNe0g9F1vHWd9J6D+Qj1v",
+	"zr9jh6zLTqInrBf9is8+esL60WPC3rI+LuQV67MD/LjLjqL9nMm3A+pX3foIp74NQwWbLS+gSDOfOPUl",
+	"+os2DUL4q9byQurhP53NzYZbc2BJUz8PYF2PLPrQaW42KP7T91s+/0kdXrBw88u5GwvXq0vzf39nfvm2",
+	"ZVtNGgTOeuIICC6PKKolbkDUwra39VX9F5+uWbPWX03FBD/Fvw2m5uHdS2IVfE2pA/g31gXiiB5Hj+Ff",
+	"0RN2Eu2x14S9Yh32NnrM+tEOGeObz/o2YX32PNqHb8WBPMMfIKUdsR5hJ6zLDvjd4J+/ZZ3oMeuwY9aN",
+	"nkSPo71xa9u2Pm35q269Tr0Smzmqtf4H67M3QG44S4LL67ET1mGH7Ih12Uu45vrKv4WH8Sa9JrBH7AVe",
+	"oR4u/2vWYz1Yyh3PaYcbLd/9Ja2/t9XgPXod7URP2AHr8bPhX4pzMUw/2ucbEe0i13iF33ZgSV86DbeO",
+	"0/7UcRu0fjaKR3qfu71w62b107mFG/PXLVhM6LiNwJq9+8hac2mjbs1aD1r+V1Xq1RNXQn5Imu0gJHV3",
+	"bY36ZM1vNQl+E4SOH8Ktb8O7YZKBG+A8t1dsq96qtZvUC3Gq1bbfsGatqXqrFkzhHIO/uq8WWl3jKx36",
+	"3aO8jf+sXbpoh/VZH88TLg6ebLQL/ybRTvSUdeFG2XCCfY2e+9G30ffsSPwhbuKbaJew53jwJ6wb7Uzg",
+	"tx2gFHakLQD53NwDZ2uR+m4LT33Tb21SP3Q5D4S14/9bftMJrVmr7oR0InRRZqQYqG1tOF6d1qut+9Sv",
+	"OuFwv4MfGeTFH3A9QO0dLpFhg94AV4meILvBbw+iZ9F3hB1Gj6Nd9gJkIIl2o29YB3dRSsljwg5g5zrw",
+	"DTvivKqLPKEPL7CyUti23HpiHa4XfnQ5ftD1QrpOfQtlhyNuRmZ9Pg3bvkfrQ21K2wvdxhCPC3mYff+2",
+	"LiLvWigzY+mJJyzfph3FinpHa/XntBbCOz5pN7667oROlk42241G1efSEj9wQ9oMBt0PGG+x3WhIMbut",
+	"3un4vrOFf6PiNMyAoDWZRoIllx8JtJjsKNs5m6IvIrM3ThC463D6Pr3v0gfpWWROsuk8XOBfzmRXwSWP",
+	"+aBtq+ZTJ6T1uSHIrEn99eF+oZ913kQSz3CNzfBUEDphO0how9atxfmblm1Rr90EahV/fjG/9Nn8dY0o",
+	"c4g7PTfTTPRNzKNyJKIsN/qXWKtmHcIOUMcBFtMl0Y7QYFEY91ABf03GkPG8AcazeOc2mQJyntpsNdza",
+	"1jgBKf022mVHqCz1QHK/Eo8ENFwS1LLYajUCy04TVaPRelANaGOtiieY2ESuB2d5GdJ54KL0A/lZ/jpc",
+	"i3+5BII3QabTlSydrjmNxqpT+6qavcAFBG8aacOlvuPXNraqcswy5hOOWm1tUk9cuySZVfB7twlENl2p",
+	"oGnA/6rk8Xa4xNWWV61Tpxa69x1OE2VmIgm06mxu+q37TiM7lwFvF79PMBD1+5nEWgYNFtBa23fDLTwY",
+	"862kDVpDGglC3wnp+lbidZYPIqKp3VH1AShJrrde3Wi1fSBZ+hApNqCGi2tbwYbjn4Yr4nmZ5EQeo0nx",
+	"iPhR0+1PU3qWCfyevUBl6oT1wCkgXQInrIMqHPKDDjvQNBOhlfWBCywhmyA4Rpe9BkbCji1bV6edsNqg",
+	"DoiSacWr1n0HVeuftz235Vt4yurDgOKH2xkmoQZKkltqQf+KRlEPOVvsSUAnyA4YgeaVRDv6IvpCuzpk",
+	"PVBp0broo5Z6V83UJnAn8Z8rV4UK+5wb/WB5kSydp1lqzxqK1p2w2mzJ5aeW3GFvop3CRcMpvmG96Klx",
+	"/dbAlycOrpi9foYPCaY15C9cb6hfmFSY6+46DcJFn65Rn3o1odIlSIk2Hbdh2Mh/BKUbhCAc/VG0T5a/",
+	"uL04Ee3CRnGy4GYp67HXJmWCes6qsDczmj9QBtfiUfNHqxauC9DbSy54CeuDFQ8fR99Fv06dllGhX0Nl",
+	"wKttGeU7CGtlOPS5uSFtp6Non88kNQmNFQLna2xZtlV3XPz/A0q/amwZ+V/DCcJqQL1wOLOgrJ4fq/hy",
+	"j/W1m1hf0lwtNvFv3rpd/fTWnZvXE/azT4NW269R4rVCstZqe9x3lSIlOVTyYz7wI7WVt+fnvqjO/8PC",
+	"8u1ly7YWlxL/FvqgjfOYW15e+Oym+LN6be7mdfA+zFt2Ypb4k+onN25d+zt89Mv5pWXwUFy7dfPTGwvX",
+	"wC935+bcnduf31pa+Bk+8emtpU8Wrl9HBTTrxDM5ORZu3p5fujl3ozq/tHRryXjqyg/yKOuZ64D9KpxQ",
+	"6Kx5K0nwUHO9HUtX6T78U3PboQWrJGjKkOcul+wti3Y5Tb+Ro35HxtiJGLSHCu5jmzRpc5X6wd3KyqSg",
+	"q3HlaBIe55TbTzendWtDkIrJSjaL2+S2CC+35s5gfTIm6R4lDHfx2gQIySa+461Tm/DLZZPYX2STycnJ",
+	"cWuQRcE3TswuXoDp/qR1EoMzKrO6P0Y70R47AjOBqxCsz96iDOrosQY0N8A/2WPPhRxU7KLtuxOKdQ/Y",
+	"9PTbWR/9u79OqTH77FW0B85GbpMgkb1ASQweJpjrXK1GN8OJG4633nbWKRmjniQIv21zyo122TGS1DdC",
+	"W/qOUG/wjiMjKNrp1POcnxgZmtQ/l2stnxosc586RgXvOdhkwNqjZyT6DWoK6ClCNeRVtDdLGs4qbcze",
+	"a1cql2qc5GFb8G8qN2LTCTdm7/JnUCjBBXuFykwfL9dr/vzsihiHB0M6uM0vxFhjIEUJOtK/B+G2K9x8",
+	"4AJ+DSboEYpAcTdgzqw3fs8z0UEgdyG93uhr/P0b1GDRcxZ9D5MU/CV6Eu1GT8UZ7pMxdsAO2QG/iMfw",
+	"S6G/wQDsJQScWJdcqoCcxFlqR+61gY+gHNusx66KdBgOd0twlT7qY10x2IuEOgoqZ/Sb6Ilw2Mfnlrwh",
+	"oxaoSDdyP/WlmIjwM6mcxRZU063XkZukA3rShQtbSpDFvcL/PgchALopbLGmbmjWgBhRmAK21aBO3SiA",
+	"FpqbLT9covBfw5UAT79RH/snJAAR40GpI9lUtIcBIx69ewMiqxvtyCAEZ1l9kC8n6MtmPVL3t6p+2/sJ",
+	"WszjRi1NPKKdivYl9+Un7MXkKnK8UoXSR6guugoio7bcdWinvJymzfVbD4wirM8FKUGeAof8BnbngJ2g",
+	"+H3MeoRr0XC9YVNt6afGcFW0D/dymoyx56xr5sfXlr8cN7ilUySsFslnOpw4y/h4szaPcvZkv1Lu1wFT",
+	"lAdvK1K0B5yDogfTIk7hmU0enrj1eB7slTiVLr8JOaYhGatMTs6M68pYhlCG9OtugBZTr665DWqYI/sd",
+	"eyU48ffqjka/QivlCG4nuBmjJ/xCPuFyQsYMuelLeEyYnUT7fEkpURXtD7ecwY5or91ogFmS8lbqirK2",
+	"xEcm82mVGvXofxXSuEcWl4aa9EBX+MA5b/puC5xrg2xxjSoX5U9G6ln3KTqxWsYcjH83qSI2mgBvWF98",
+	"1GfHEERM0Eu0E+3zTS1w5Zf13NvWfeoHyomaysPpIh/s4fuuEuFPOEKPW48dsI4Ike6LoL7SPbjS0pP3",
+	"Qei1YKXw+OoB+qYO+QDAVedvO+u6L2d6IBMdNsagNsc2MZwBTGtRo6hYgfCAOhuaItBAbq4+3nDXN4xb",
+	"ro28vGFWAAoZUXzpyl+rD+ZSDE+jozp50yEviZgCWIh52pivPVM+WCMjR/objBFRz6ebDadG69WMbE9e",
+	"SNgnsrhkSydfzCdQCHJXLojGb0EliXaiZ1xH4TJpbwgunFFY9A0omLJ5h3UmmPU2tR54RpGPZvEhSvOX",
+	"6AIBS+farevzt356c35pmYyh4/iEO4jh39EO+cwNP2+vjlt2ES/OJ81E+KIo6DiBFl+HO4RYN/o6R1wb",
+	"LR3d5CpjHGUOI7kQW99F8wFwQpxbW3M9xcRS/ifdkSPJC31J0R6Br/iayJ+//m1G2Zokq41W7StaJ3AG",
+	"KndT8PbUw6wjnBlvxF5yrzHYLQf3PPHmxSVpT4KhGb+eda6SB9Rd3wiJsMsr3F92EO1F38o3wj9OwBXO",
+	"7VT4Ek23x6wzec9Lr1U62Tp4pMq5Nat24QDvEBDgiZyQ9PaBhgMOo2iXvUQDDC3yhGHWm0QvwDDcXeym",
+	"2eiK81vSaZUGiufnnvcevpM5cn+Hi3Qw+MH4/wbVVOF5mJ6YroxfVRv0Ck3jI25OCdu5EyfwSSqqDBM1",
+	"StG8zsz1ZalFxLtWdAMSrDjDjDz6oDpoz1qN+sBnBovKgQIt/Ro7MznTMpdvzH3iU6e2kV1bnTr1huvR",
+	"8hGOOg1pLRwyW6p0mtZXrlfXVYBPF5aWb1eX5r9cmP+pVAWMqlMZPUSKK1qvhq1cN0Pj/pCLS527QZ3X",
+	"+JxwHXCXeY8d4H9fk+Ubc2kvDb9F+gaM56j2/rDHMUQ4HokMT8U20GM8UGIidkxXSYLJoc5lGoautx4Y",
+	"9d1WVZ6bMcB5wF2hKCsw4o+ipieTB7sJzwCPVWZlj+FUuJBYvjFndIAldnCoRHbbglOphq3qmusHobi8",
+	"1abrtUMaFLtb++CgS3s6etG+YUVYFaGnU3a0h8hYhfz58T8JzRCclzsy41q33sYHRtHlUtA2L78GtPVe",
+	"oZ13IqxIPl8cZ0Szy80rGXACeauyU8RoomWZmJaK7mWyrfJ1SO4PgqN7iwr8PjuEfOFxW6lHCdX+EN3q",
+	"IF0hws5t7jQxRHu25vM9SFSfsG5CjeJ7Hj0VFR5ccUI9+gluvvCqpZN3UUez7JL5QSbXDsYvS5tQsM1f",
+	"UBmrGJB7tOn4EMUPzTmD/47GhPK2IfPopEp0WMcUdI0jv+P6/r5JJSFGT+UbcO97/HpGj6OnEHIzy5Py",
+	"6Ww5+dhZnleYmS2cNLCY+KxFSUX2tDtkLC+AqBmgMUGbQwil89gy1h8POvXZ84QKb/T1TpJE0ltKd2U9",
+	"ReNw/tFTm0S7fPIYyNrBGg5cPFGBHmC6XXiz4Gg2UdU3xzlVUjwWyIMDePg7ehA5NYRxJnkv5wweoha7",
+	"OOQT/qgK+amU++jpJFH5fuIhLLB5LGoYTHaXzHBgb5FqdnWa4dMk7KUIM+Iv3hDh64vjpCoZDZx9cToZ",
+	"WABwYzhxsdcyOgpj2wTNCoh87+ECIZEt2mWvZBi+S0RYtScCis9UcP5QGWOpwHCH21vnkBSZDU9DphN7",
+	"LbKHc2IQMk/D5saxoht+HMJ3KqOrREqQCf1mlWa4BqYYVz2aQolF5ZQovEXpJeZFxzFvSHnhgTND1Dut",
+	"3nKek6nC/An47hPRjEGCIK8u4XTqWYHWIKVUnuD/XKZCG9RYSNsLW0aC+V/cQRR9wyP8jw0CCfLm9FMQ",
+	"miAY1z32Eu4PKqyvVZAAQkXfDRVdSRBE6b2/2apT02inyve1tW3SJ5S330IDyGz2cBmablBFEUvNfpXS",
+	"rr/cClkyJtw+EBCGOyLvN9yg6LFiCsfj6MRw6re8xlZuDEvLhxjS7IBfjuJKxIkWakR9F/NO66ZIIEye",
+	"1QdBdoNJbRFrQgz3enCdRyb8iZwRri+qi7ELFfWBYy5MH/PiV7BVdzCX53UisDeoaKTAi5vMgs9Rm66m",
+	"U/iiHaInTJNYE05SdILDv0GzTi2QawKa+1bLsS/L74cudClXnpLar/8R6/IGnShrIdlJO8zoYsCfHvA/",
+	"oqdCs0oYA6w3C2ejW+xo8vHzEilWWUkAfOUwLQuwxDIhSUBYJ/VMPVXtmAhXeRdDsU8m2eEkYX8UemcH",
+	"TYYDaQ4OUEsIJjKItMUXrK+qqCbveUYSHlQKlF8cABZIyrJJJH2Y1MKsp0S6G0T2Tj9bQBLtj09i8GGH",
+	"qGxCuJMbrXDNfUhS5pa0w8WAKmGvg1ceVKRYH/2QypwyhtYb/Tb3OY0L8xL12yFybmQsJHZsCldPmTqT",
+	"wX6owTVYhYvLmXLWiOZKa9J9JTNZzlLlVaRYZDw/QhxkqFGOagSFEB4lZXGh0fsUhkRKjfb4CedfE6Pv",
+	"+R3Vop1SrhuowjhnOyvHjfel4ArmKQ7LoROa3NrwS1rV/F5ZIhnwpb+eibIbn0TOOiCBgP0+5RzCZAIl",
+	"uaM9jfrwjku3VrQv3FppQJuceQhXayptIltSkHFw88R3jbGnmT7ch12jZ5KkbGZzXmRx8H+g01bk1Ovb",
+	"JNxB0Y5yNPEaRPgnxip2RNlc5/QJGAmntkjDlHRjp6nMSAs5pJR/XiZKx3L8d2iHDZElnrR7TmHZ6Ftc",
+	"bOXALiyKBObkTjTcphuar6dHH4bV1tpaQENjqgsw+Lj+QybbR7tYT9dFLxqv58GL8nW0d1WETDB1WUcp",
+	"4bpQOmM/PQDrmm+vmmFB7vDZoBvSJwFEyDdOvd606z9t+V8t1zZovd0w7DyGcn7Z8kzm+/9GT2xf2Fe4",
+	"M/vRDlmYuzmnV/xa820YcuqLVlDDPL5swgSgz9SdrcAYX5Ce2x6HKjuRqEw8R6hHxoRChK6yb0R2RLQj",
+	"s7O4bnooqg5YN/o+EdUbt4lQXw/wZN/wTHX+OOva5CP8FuiBPeeVCkmGo9SWjwYG+1K2lcIHMvPGE5n/",
+	"FLuuX3D1D9KYxz7/fPaLL2wi8kMSIGnKvT0+SWJjrM9Okt7tGPTHVvvFHcR8HzGM0+N5KGjJZbNTOUdO",
+	"3CnUbMV23/MShDD9o9lKJZcAcCY5FXL8sPpn3I3EZCo/Nk4mLSAU/SdmaevgTjH5Gou5pFoJl6wpsNeo",
+	"41N/rh1uGNarAWEdsr4KTnRyILuu8gDDY3kjuDvB+DCEBnjlPuQuQpjhaVotxohQ1tWm12FKnD8UMLiQ",
+	"eBc3wnATDvSWv+547i9Rt/ucOnXqDweWZ1xpIjFKTIYrKMJQfCJ3DvLZFBwaL4IXK7GJuZRH+RIh21G8",
+	"YJcLDrAT5m7cuPXT6q2lz+ZuLvyMV8V+Pj93fX4J3e0F8byDaE8l7sHE+nAlf5sEVtHmjendh3iguzyU",
+	"muMb6g25d3bmoM1ocfFcJgn7PrOtih8ky3PyQzA5MG052zV2ff7TuTs3bid2mpf7IUjiBiclhZL4DxM6",
+	"oU0sXI8p0dl0/45ucVQy11vj6UpuiFd/cYnIzDUyp1Qzskz9+26NkrHbNAjJbSf4yiafOo0GmanMXBm3",
+	"tIx+a3qyMlmRWrmz6Vqz1qXJyuQlC8Ll4QZe8imn3nS9KfpQJj+vG1WUP6EdfKSVtuQEQXvCdSbjcn1e",
+	"sIlJN99J6paAPCkjW2EaHqO1Dd9zV1i5ZBj4GdfQhf8RiyeAtlVkET44Rt/sCafPY32KciEYSEcnSIdX",
+	"XkxyTdnHA1yog7aA+4U4XHYC5vTuIyNSpsjYMoKPWojmpxUU8j9rwX1jNnzmbP5vdqtRlACipF7RRsbS",
+	"LgPJv4Ff1YL7KqkTZkCU/ziGm1QhbUy9eh7tojx9QjgjQKvymPXGc9BCtcK3eBdOW+a3vZJCCp2pVEaG",
+	"A6kw1rbRJnkYTsFhJH5uAC7NZEPt6SFtuIeXRzjH0aCM8llN571M7fBUAm8Tf3Rp8I9ivFFUMdrNpuNv",
+	"Ce9utMPlIifTBLtI2PJ2YbqF8MqFDqQV3rXmgJlZK/A2wdjcpqrqMKPQ/CEtgMl/Xb51k4xJGshGwhNF",
+	"okjwstj82vKXZOwaP92J21ublEjisbnjrcdOEr+3kzCg4nwOskAOXSJvz9XULRSejyPUxbm2lK1wskky",
+	"Ow02Lp3lIGqdpX6mHNz/+X+u/ucRgDakQlBx7iWETb4XT8PmTbBjcb49NLuOQZ34F1EbxgtpVQVuJwGa",
+	"qStzbzn+D4YtXqbxJjrsWCaQwFo4YJtTr19FGwhUImkVCxP6BevnEFK0nxAEEtZTrO0gkdclMm1g1KQ3",
+	"bJfbXxyuKLNEzTuk6RwSSKLPXk8S9jt9Cjn67UHsvn1NlD2Plg9XoGZVltdR9B17Lkry1ZvAzkjMjAvX",
+	"XmyonCTqF+J4ELyfxzKScpAXspvloMFiSOSFiDOXMN9JHYAoPONdldnGIyw50iWuWR4G39uUyK3k5bXl",
+	"LydkBS/rFItPedHfofDDrz9p1beGAwpODg4HZSrBvmu1p6fh1Vq1itWerhgSxGetTX9iplKZNtbizVpz",
+	"9Tpp0Dr6F1aUp/XuI93bZ206W9zzuL0i9wEe0fyFIlXC9BvNNSjmGLsDrbmGW6PWtn26waaTg33SWrW2",
+	"V0qDD+cqEuo85Mts+RJbTcpW87XRyXrPa09XbFyOLWdr40I44AR8P21/0lpNfXsveSmMkOs6evv2GTWr",
+	"dPmkFMBFG5UAxDDVvPkmv6BB6fmntCWR0e85VoaQ2AlGxD1xEjWQlx12JVDG+IepwEnVI/oVPMgrJEjM",
+	"tN6Zfmdbl2dmhmNEMWUowBOZqSGBR8TfEmvk7iNLu5Yx5BmPfRB5hUjN8QD8bJUS2twMtywdWUQxWYQJ",
+	"mdleSTKtgLtFOY+aVqxoJgk43vLorTWcz7sjdnsocloxEdTvY/AqgyYwZlAFcm4Q6yudV3j1RGIJfJUA",
+	"cFSvGGQCJHWwkRgAouJ1qk4bNKS6DZBUYq7j96pAdljZmsf5EnWeQ6bxparNzpLJl1c9aWan5ykKigtf",
+	"+SnlFr4WVV2WrheV7yglSpKlwuDs4Ij1QOP/n1vz8IvL73DxmaptDnWAaYXxeczM5L0pXkSmyUaKLQlH",
+	"pzRK3p6lNF7nS5K5pFhTww10r2uSL91wg1D80KVB1sAy2RlJ2h9NU56V0XKBeEXDgmmoXRwSvKmAP2iz",
+	"GZopdBJVdRcs4Z2yhH9UVy/DDtJ3OnVmOkyFyjzq5SAeCB96Nm9QsAKdAAZfd5FdYVZDlml4ah1EqNV4",
+	"kxALAf5x32m0aUrwWu1pDS5BMAYJKwFvWWu4tZC01ojrhdSnQZgSorNWe0YACgPeafF7Uj+8FEM2zF7Z",
+	"TqrUo9OdNASNMgmzp0XUGHJWOt6GsQHCSCAx3oNSp+HKDMfD00tSd+YUyhlkzz8V/tALBe09KGhGz/qz",
+	"LG9OmI1JlS69eyPU6v4oo8xcq+vJXKPn8Bbeu+0ctb3NGFRtiucZFxijc/wBHTNzRM5e5aud5r5azc05",
+	"YxXw4s0s6MopitJGYMlmkUnkwO+e7W36Q0DpmTo+mNwzqeKLbjFfK74SWufMv1Cms7iUZS98Ej9+p5PA",
+	"mpeuSE7Eor4EnxvIGbXMFpOueTwyLhjTF+d3i0uZCYjmBsZpxPxNI+3AwOM48Gw+i7uG34+Iw2V0zhRO",
+	"713UoXzPaUwFFIoEp1yvTh9Orrew3midzyuYqkzPVPkDk8EvGtZKjLN51+KfW7ZVX7VWdDBNDvJpF7LZ",
+	"vJCYGlTHibUgRk+9unVOuvHZQIwxK1AhH0bPIBOdCFxj7o1lr3SQRjMsYm4lHY+5H/AsIvueZ8hAUjmJ",
+	"HTMqTqaYU+vqlkI40E23ySSwjbap05WZy/YA4AVDRWoKOFkb8HLlxx/Zp0RSvpptd5eE4eCrlQfGDdVU",
+	"1qPaKlkRgGcH/TrhBFWF1uQ9T1Wa4XNdzPcqAnVM1LtlukbZqo6yx16wE0EMEpgkXR2afyAfFR/HTOVd",
+	"gN8OeeuM0LhDRwQK0KR/x2FiXmHWEJ6mON00IqOq+jOBlQ6wgEfe5rGMjjY9pL7r52HK3wUt1wYfRCap",
+	"4awMXGIacyjjIrfGOWiNWCIaJ9Fe6IcGF+FUGq8rrTZGe+UVR93VpiXFJRxh2U5diZZYerMukeGtjUR4",
+	"el0cxA6c0A3WXFqfJR6ldeKEBFsKkmkSZ+89cMMNwnEbODruTwhPCyFjG859SirjfI/oQ1dUh+bOVu/z",
+	"FU91cYm4deI0fOrUt4gYZnt7hJ24dV06mcQnmpZkPQelgC5Ef/w4pw5RBfbOybsgEtc7+ZiVuvqjkt/6",
+	"ZCZn8gN0l/J6uQIvMavlX8DXI9LKRbnp6hb3LhQx2AJ2qY2SLT7juZ8ajgpXkAw5ejpwX1Ir6WQ2NgZ3",
+	"/rVIB4Z68QlZLz7QSXxWZWGAkD0/R8cIhGjcu8OCYpSJ6crEzOXb0zOzly7PXvnoZyMTs6JVwLsXtOwg",
+	"Zjf9aF/gQsjpXAjekThmChpepttHJoSTuAQBmSEKzMEmG05Apq0RCinkkgk+Aaayqe94irWMxYxEiTJe",
+	"IdxXcAGiNNiAw8LTD6dnzraBhn6biT184ASk2aq7oGxAm8Za2/epFza2Zon4JxHlZcQNyGVrtMIfAErY",
+	"kdY5Ru4sr4U4Qm4uamZHJrz/IPqCPdGcYrw8jd9rTBU9xNqPt1ibyAsaReFxXzgtRM3geHlhrMNdm+Wx",
+	"xKuXkbOzyGOAlE/4+08pkRPjnM0OPo94gD699y8qIdzdvnLu9qZtqX4sXN+6Yo1OMqYGL+jMxiEWysIK",
+	"pY/St5JvKhcENgAhqyaqur5/ETrJMtvS8YnTSnBNhcepxzz33/g72CtgoQJeUERgFeqc6i5dZKaqh2Ip",
+	"JsxmyV5Jy+MgZHVIFMat8FrXHK8OQoFm5wV2pg5owNHOTBiBln0Ke99rEV7SQQSxY013Tc6HuB5BtDAx",
+	"0XBOcJbURIvD7UKMlootFS4i0dbb4LRwA2wyLtkfCVsk3HADsdOj0w3Sbbk5xkqfk448Is0tYG4LIXK9",
+	"LhSp81KkslsuvBtveHGq6JlUANLIEL1B4pnzx7j/QxQlZBDIyilbvOsWij8nrG0UBr7iytcUlHVc7ZoG",
+	"QdifJOz3WDAEX4Kq2OG9o/QOiuZ6WYG/jixPUMQ9T/LmgyyMRZcsrE18AYsgY9A2UWyPyF2M9rAiXxW8",
+	"gr56gJveGb+qgA0RnB6h3V5y1o5mLIrvVwhE05HOD5zi5ekZ6JylIHpO4pqNOCNmGKZjc5gfrTudVuOq",
+	"wOzZsYntHt/zkrVb3BjnmKopEBW9XYMZ4jV6Mm6qar2D5JJ0haVyrxVvsO5Zl+5Zedgf8rAKK/BWRhX+",
+	"vnRKzXKt3WhMQHlirGMqFJFLp41ED2omO0xMdFSBwHcTyStqsnqGHqc/hCSnlE6/UtKvFmfiIcSMZYuL",
+	"hLMANpfT0PvAxGiLq10v8kLfj51hlhrn4iYsNAy4KpCwCezzTga7UDlHpXLCD3/0bi9vWvNDqPEiXS+t",
+	"GCvVUqrDXEc6EIBjXZu7EVWrIPzkWKX9yAuUaOClAeMKNTlRBmtQh+P8EcBJKcg/rte1nr1n0EwyLXdV",
+	"blsO8EKBouFn2/cO246vqFfMcC13zy+RZfDyB1dZqCfzl1HOuWbsUJ/ISEZRfSFP36E8TTejSYtP1jkD",
+	"b01yrX/OzyWLe19k6WMst7giAZeUaKENiaSso8dOFBljXWaaf4liWWPN7Gc0TLCvEiWz2ZbaH2bh7IfD",
+	"BS4u/Tu99GZOPLjsVTWC6BXdWLis3F0UZ26XvortzUbLqV9r1ekt1cg/D2gPg9pxS38dojm3mb82p6tZ",
+	"WHNeIMMOQM0U7Xy7mSqut8iKuhx8Dt4Izqzfphr4fywDWQiynRMTscnHLX99SqkS/OFE31SYUro7QS/l",
+	"trx6z6NNx21keGC0lxSv+xpi6kncTc8Q7uplsqrlT83urdShnUHLU09af0M+blCnfs+bUgUXUrWbIh87",
+	"AFtFPl5trd7z/gYqLMjHTq1Jp+qrDs4wX1ssUAu1aeqOoStXLn1UmEE9kqaBWbEhp/PuHTXvQzTodR4X",
+	"SuEPQD5oUGHw2ZFeqWOov5V+ixEpkwoLVwgjwyRyCxMKZFHQcKZWferUNmiQqxQCkMryjblP5HOl1MJE",
+	"78BcBdAAz2waTPTWz0I/6p3/LZtnuFkrpcfFmpkWtJI0OD1jnMvRKqL6fpfCb1F7P7AliBq6lHKaCgtH",
+	"+9DJ3459jd1UTdkFBnMia537nLCh80lyJ9lr2Mm4aQlEzb4VPbgh8H6gG2vLN+a0yxjQMHS99aDIQlu+",
+	"MbcsH8vcRdNy4kd4Z1CnSf8eL8KIKVuf/ACCVgtIk7AaowwJL9+Ym1DdvbHngUFhvEhcGsoBUmwFldvx",
+	"NHXb+Zg9SWI+SyC3VY2TQrMAtZr3FNq9hK3qmusHoeze1XS9dghUPHO5Ej+CYQ7tux/9qFLa7Z8k8nPW",
+	"YN/5zWP/lqGBNNpC4uZdKLJ/Sd7NP4mSMd1DeTrOAHJPos8XRlVAcJ2tpKq5qvTVNJK1DlldGv46lRCe",
+	"gbkuE6cZ3Lf/HKp8ZUPV970lykVR4KOQcy2xUWW4VvKKJNrnDIFAWBBmvj0/94Wp/DP2emVKQO1zYgAF",
+	"xaDvHtd6pABUydYR0F0r7TTkSA5j8QFDxuBUIlFmXzXqNoMj6zr6bd74PuZWA2IoglmdSTW3B/5gwas1",
+	"2nW63F7F+Z1Jpf+AOKXRc1mOUWYQoZ5H/53rARc2wfnZBGXvY9GF2nCpD7mTW4Ou1efqwfdi+mr3JDFl",
+	"x6vRIOQw+xb11l2PUvQ9rcDWrVbjth36X7k6wikkZWI6g65LvItpKyAepmSAUzT4ke3rsIOjav7Dq0G+",
+	"wQ9OeDc6jVgubuEob+Fbkef+Mq7ySFXnIxoCr7d8HD1lveIrudlquLWB93GRP/VB+aHimQ+6B2L2mdxb",
+	"/nHJKrpCSISxbJt2c2NMPeFe9ZESqun4xU0Z/U0RRxbt8p8P7JDZN/bwT90eyHHP65PXj/vNpgpgUo1s",
+	"IfpMytJNtrvmcupmnt6p1mi0HlSh8L4qwE8EEHSmmx1eeSesIrAORn+brlcVze0tjqSDuqETVpst+Yjz",
+	"UD3y8zZ/ZIV/rIOZWbNXbEu69qotr1qnqF46fG8lBje/vVUFXmDNzmgfa6XFl+J2zVVuXsI+BLRmwRcN",
+	"WsNlBaHvhHQdCAh6P7veenWj1cZo/7Dpl9lt1FCs+fSzyNqGLS4ZLroW/3Kp3aBpvL1sXYcS+VXZ5rAc",
+	"+Hf2oHLRuSuD+qbnn285GPLs6afmMuDtWTLRfj8zBNJ4hrhKFLiYqE57veU7Xr3V1Hrcqg/SpKkACA1B",
+	"0AFZvMMkb8QDvYeqmvcp3DOIwhd9Kz4w4Z+szNeOzoiRPtKE39hBdY4KhlLPAxouOr7Y8pykwX9J6v+i",
+	"K7fEMU1Zai9ASQJIWNEhVVTD41IgM3C6AnY9ThO+Yq+vEmybCs1AUtBfGP2ZJOwPvLE1DreJkxXoplhZ",
+	"C0BjcY/UtBsBniHRY92+NOfjLcuNOGuIQJuhNZsw4oeX+YmxSoiAvwjWPGJnfcK3ED1LMN+LPLkPj/Xa",
+	"ih+YXA25HQ87SdZzPiw5xUieAZikoQ/1AIYrsaQWW61GUbK2KvZPMnTYsUQdGuxh9FTEHRQXzBTb22mo",
+	"M2jKjQ0OUiIEnTzRXhbXG1AOpHI/oU8Jpphsog7fEdFKcp8dsjdYUCcRIY/5WChK4sbg0S6kYk8S9h+w",
+	"xGy7cZQ07LXWVPyJSsfOxRoRzeKTGBc5AiB5MGeQAclG75hTpxJHNhtOuNbym+jTTbV/5y2fK9bK8KIi",
+	"/UrNzCsETs8actlJlR3LMNiFRDK1TdpFZeci1eVDkkW2mbWlbYEyKF3R3rlIH+P8gFn3JCfFlHPko0a7",
+	"oFAuhU4YDHLTL+NDP6iQdG6um1zvoEvN15xJc8NPS1n/fxS4oljCKc3ItykgJdaFqk2QuztcPiYiXuxY",
+	"CjqXb01Vxf9+Auxu/IJxfNjBAyMNlDHSsfW51jxb/1e55m9vBbbqN3qpn+ijrBrMHM8m4Ax4DZ3iIGb1",
+	"KlYMZb1xjP+kFc2pcFSMNLUncZ9sAl8B3i6+MQHrBYMewwgcKisDzsCn1AOFHAbs4wt38b9PMJ2QV+xl",
+	"MgkPxTm9lE1sbBL9BisecUQ8nlfwKetEv4p+xeMp8Ol4vG2qKHCSsN9FO3GdDOGLhR2MnsYo9G/hRkS7",
+	"iO51kKov5O1cdqIdwO5Qfh4isl0mCftT6qxeF0ii5/wLsIkEWeYBis0tLhAVJDzmAV/Qp5/j+T8TFgCf",
+	"pBFAzKRD8ybudwLqDy0k4EcL9ZFlVYBr+z7vjCqDAlyFhql49EE11RX1sgCuzXRZLcToXbGttqcQU/UH",
+	"Aw6+BM/NYECvbKM/febFNYTxqqAkyw9TTf6K1Wn5oB2/sLwr20B5Oke5EEYfdttPmdKZOLLiDuzGV+hV",
+	"gXB9UzLLXcceTtCgmEJeU5FyeT3z8HvgH/kIbIk1FJ1Wdh1ZQLb4u1OWSGRFGBnLCesTdiDbbMMxc2EK",
+	"npcDIkyVZxe64wd6XQcmoJRRbviIXAdEv6ioLExAvxrucUGtlemuntpThiAMKBQ/Fp9O1kSQ2lltxOgv",
+	"a/gGr4aZFJR+hcW2ZWWqeEmJAIp6a4ncCm1K2tNW3XEbW1qUHYLq+IH8Qkx/5fx69L7PjrwfFqu8cLH9",
+	"0JQTE1c7Me3g6DryRk+kcQl8lYc6+jywneGmYpK9NJwgj2GofHWwRncL1aM4xyZWi9KFB9HX+Io3vKUa",
+	"35bvoyfcQJxGAuKRnpdgA3NgOGF9stdkccmWoSNhoMqYTI7tiOCROVjRPRG8iXajpzLGdMAO0bzs6pPo",
+	"kksVIqCBXk8SpH6FRA4d3+DxvlpZj4xhp7waqUxWpsfNRmsRjs5nNITNnVfb+X4Nz6DW8mUio08dDN6E",
+	"G7Mi/DObaoOMgBEtn1qzM5N/a1scFlVr3lW5NFGZhuZdlcpspfKzrMhTb0GY5tn6ajxiZfJHphGnJ2Yq",
+	"tys/nr1kHnGlvFSVSy2ZUKhOaBnnt52NFZ3CeBVzKCUdfq8RnVSXn4vMEVSWL0TDD1QR1tlJ1peH7bFP",
+	"YcAOqM77ALxcME9r1lAqZ4YsGFBAV3DV5YuKDhz3w3RTz+RYuriTP9A7ecobx1MwBt078dSZbp+d0bf+",
+	"Q+s0gh2dSfQbkXaooKD7CK/K/eUnEtlQ5OKI/kkISHiCTrYTnkqzw7rxCL1xnmHdaNXVfTWhSqFETyBK",
+	"lUnCMHVcCMIt4BcWZp3kgVip/uxl62WNjdrPztPSTvRH2b5tsiXFXYvrO1yVWtF7zFsb7vrGiFu8DaEa",
+	"pRZRUkPSdnR5Qzj0R6AjJSdTLmStwVItLv01zynOu9EfDIfGYB1Ev55Ej98TnleaDS4u/XW0Z0Nq4iHr",
+	"5m3hs9JtwvJZZ8MNwnxL0sjWeSQgTtHr83cLdYlgIBct3KuSh+kbLIK46VbP0Fwg2stWkwH6H591hmkX",
+	"cOGBMK6WPVrYwIK5ZDBmZTQl0aAKtiTz4Fvz9udMPtbmCuEE7Ucm99c3GLbRTy/34pIxhOR9hbsMfgXZ",
+	"uT1GG++M58xR6o5V8NG5D0eBzthwm26YGEj5VK/kl4EZO/iYX9BaWwtozhsGlGOdXazx1UENmEcfhlUx",
+	"F/hb/rPCmXsOYsZAjb48jsapjIOV0jgacMsXnXWalwslnLE9gJsvAE65gInMg4nM37IiARHQcO6BszUA",
+	"G1zkpqnMGp3rgVwaW/NbTfLnX/9Pgnlqrzl7JsKF1/ZCtzFuD8qU1HLL44wkU4YJYX8kMj0B89yI3lNv",
+	"UK7SoZ66nuhod0BkChNsXGLBmH/+W839iN7WbrQvuPnrWb0/Ki44f7mYD4Uf6ZJMoCfhNPhWgG+2m1PJ",
+	"xVk074YN0BcTIDngN4lseIjpXQX53JdvjPY54R7yhabEFOyflhSWOvNXmHMPDpRv4wwxMubTsO1Dh2Un",
+	"HIeyLEMvRv0cRJ0YbzY5vGN3mZt6SLRnScX3W03p/Jyenqhcuo2+VOH8jLNtZAG4g0NY9x0+JLBCOGRt",
+	"iOm/TQxR1ibgE3mEVpjDA4UhnQhdVFIyFp2eBlSmctkJWl4J4atWU3YeI45I2nwb5DTefRcfR/DAIlEB",
+	"JLdIfbdVT5/EsAlZqV3Alw/RVFtcR0NqYwJ07sJH9qH6yEZYBPCWHQm9SW8AxN4OIpR87V8phioJNNEF",
+	"dnyQOrEQzAm98lF+sgi63OSDZ2DjmhYruKDCelC4EQaYuAJ+rI34KIelJod/VKbzvYpIFOkn5gORpWyH",
+	"CROyg9WQvUmi9/7tJqrtIDwb7cTjRrvyDEkeIEbKjObyN7sJI+f+8aafX27KB58GbKbnUcdszp5IXDri",
+	"UyL2muwtOyCP/QKn6n1KGxMsc/QrFD8vEl4wUUjcO12wJ6Dhcm2D1tuNwRJEPXgGCYJo7L9seegdCVxn",
+	"6r9Rn953vOwlAzicat3ZgolP2zP2JfuyfWVFfA7Xctaa/vFspSIfDULHD+FDMAmKRI42BT1j8M7ta5Y9",
+	"sKv2aXlxYj3ZIJIGOdR0PVnKmw08xIt/lPMGsQ3lAxPar7Tx9fm++wTGQCPJoov705b/laLK0+auiJ+X",
+	"hMjMFEFlcYT6F6bAB156YahlM2MK9c+Xn6MzCj/oC0Ec7Uc7REwRfVLfcBrDJ0+bQ8Pzz/CaOWFtoxhc",
+	"CPMrTylqkjaM8isZ2u7BhO5INeoM8iRfM1tqrVKuRRXl05yOl8evGZVKrkZ898z2HaQVnVrxvGCff4m6",
+	"baYNPNYYD8XbNGxGtCI/oY5P/bk2XLu7KxBxu+WvO577S5zG59SpU198s72ixnsko5K82HvbVh/wF2kf",
+	"JPrIa59D6xPtz7m1NdeDSWmfJXr16c/Wm66nf/A5dRrhBoT5/t8ALpWJHz8eAQA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
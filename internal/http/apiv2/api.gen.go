@@ -0,0 +1,986 @@
+// Package apiv2 provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package apiv2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	BearerAuthScopes         = "BearerAuth.Scopes"
+	OrganizationHeaderScopes = "OrganizationHeader.Scopes"
+)
+
+// Defines values for ErrorResponseErrorCode.
+const (
+	FORBIDDEN        ErrorResponseErrorCode = "FORBIDDEN"
+	INTERNALERROR    ErrorResponseErrorCode = "INTERNAL_ERROR"
+	INVALIDREQUEST   ErrorResponseErrorCode = "INVALID_REQUEST"
+	MERGEBLOCKED     ErrorResponseErrorCode = "MERGE_BLOCKED"
+	NOCANDIDATE      ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED      ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND         ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS         ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED         ErrorResponseErrorCode = "PR_MERGED"
+	TEAMEXISTS       ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED     ErrorResponseErrorCode = "UNAUTHORIZED"
+	VALIDATIONFAILED ErrorResponseErrorCode = "VALIDATION_FAILED"
+	VERSIONCONFLICT  ErrorResponseErrorCode = "VERSION_CONFLICT"
+)
+
+// Defines values for Grade.
+const (
+	Junior Grade = "junior"
+	Lead   Grade = "lead"
+	Middle Grade = "middle"
+	Senior Grade = "senior"
+)
+
+// Defines values for PullRequestStatus.
+const (
+	MERGED PullRequestStatus = "MERGED"
+	OPEN   PullRequestStatus = "OPEN"
+)
+
+// Defines values for PullRequestPriority.
+const (
+	High   PullRequestPriority = "high"
+	Low    PullRequestPriority = "low"
+	Normal PullRequestPriority = "normal"
+)
+
+// Defines values for TeamSelectionStrategy.
+const (
+	Expertise    TeamSelectionStrategy = "expertise"
+	Random       TeamSelectionStrategy = "random"
+	WorkingHours TeamSelectionStrategy = "working_hours"
+)
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error struct {
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Details Нарушения по отдельным полям запроса
+		Details *[]struct {
+			Field   string `json:"field"`
+			Message string `json:"message"`
+			Rule    string `json:"rule"`
+		} `json:"details,omitempty"`
+
+		// DocumentationUrl Ссылка на описание кода ошибки
+		DocumentationUrl *string `json:"documentation_url,omitempty"`
+
+		// Message Сообщение на языке из заголовка Accept-Language (en или ru, по умолчанию en)
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+type ErrorResponseErrorCode string
+
+// Grade Уровень разработчика
+type Grade string
+
+// Merge defines model for Merge.
+type Merge struct {
+	// MergedBy Кто мержит PR; обязателен, если политика команды запрещает self-merge
+	MergedBy *string `json:"merged_by,omitempty"`
+}
+
+// NewPullRequest defines model for NewPullRequest.
+type NewPullRequest struct {
+	AuthorId        string               `json:"author_id"`
+	ChangedFiles    *[]string            `json:"changed_files,omitempty"`
+	Description     *string              `json:"description,omitempty"`
+	Labels          *[]string            `json:"labels,omitempty"`
+	Priority        *PullRequestPriority `json:"priority,omitempty"`
+	PullRequestId   string               `json:"pull_request_id"`
+	PullRequestName string               `json:"pull_request_name"`
+
+	// Repository Имя зарегистрированного репозитория
+	Repository *string `json:"repository,omitempty"`
+}
+
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	AssignedReviewers []string             `json:"assigned_reviewers"`
+	AuthorId          string               `json:"author_id"`
+	ChangedFiles      *[]string            `json:"changed_files,omitempty"`
+	CreatedAt         *time.Time           `json:"createdAt"`
+	Description       *string              `json:"description,omitempty"`
+	Labels            *[]string            `json:"labels,omitempty"`
+	MergedAt          *time.Time           `json:"mergedAt"`
+	Priority          *PullRequestPriority `json:"priority,omitempty"`
+	PullRequestId     string               `json:"pull_request_id"`
+	PullRequestName   string               `json:"pull_request_name"`
+	Repository        *string              `json:"repository,omitempty"`
+	Status            PullRequestStatus    `json:"status"`
+
+	// Version Версия PR; совпадает с ETag
+	Version int `json:"version"`
+}
+
+// PullRequestStatus defines model for PullRequest.Status.
+type PullRequestStatus string
+
+// PullRequestPatch defines model for PullRequestPatch.
+type PullRequestPatch struct {
+	AuthorId        *string   `json:"author_id,omitempty"`
+	Description     *string   `json:"description,omitempty"`
+	Labels          *[]string `json:"labels,omitempty"`
+	PullRequestName *string   `json:"pull_request_name,omitempty"`
+}
+
+// PullRequestPriority defines model for PullRequestPriority.
+type PullRequestPriority string
+
+// Reviewers defines model for Reviewers.
+type Reviewers struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// ReplacedBy Ревьювер, назначенный вместо снятого (только в ответе на DELETE)
+	ReplacedBy *string `json:"replaced_by,omitempty"`
+
+	// Reviewers user_id назначенных ревьюверов
+	Reviewers []string `json:"reviewers"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	FallbackTeams          *[]string              `json:"fallback_teams,omitempty"`
+	Members                []TeamMember           `json:"members"`
+	ParentTeam             *string                `json:"parent_team,omitempty"`
+	ReassignOnDeactivation *bool                  `json:"reassign_on_deactivation,omitempty"`
+	SelectionStrategy      *TeamSelectionStrategy `json:"selection_strategy,omitempty"`
+	SharedReviewers        *[]string              `json:"shared_reviewers,omitempty"`
+
+	// SubTeams Дочерние команды со всеми уровнями вложенности (только при include_sub_teams=true)
+	SubTeams *[]Team `json:"sub_teams,omitempty"`
+	TeamName string  `json:"team_name"`
+}
+
+// TeamSelectionStrategy defines model for Team.SelectionStrategy.
+type TeamSelectionStrategy string
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	// Grade Уровень разработчика
+	Grade    *Grade `json:"grade,omitempty"`
+	IsActive bool   `json:"is_active"`
+
+	// TeamName Команда пользователя (заполняется сервером)
+	TeamName *string `json:"team_name,omitempty"`
+	UserId   string  `json:"user_id"`
+	Username string  `json:"username"`
+}
+
+// User defines model for User.
+type User struct {
+	// Grade Уровень разработчика
+	Grade    *Grade `json:"grade,omitempty"`
+	IsActive bool   `json:"is_active"`
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// UserPatch defines model for UserPatch.
+type UserPatch struct {
+	IsActive *bool `json:"is_active,omitempty"`
+
+	// ReassignReviews Переназначить открытые ревью при деактивации; по умолчанию используется настройка команды reassign_on_deactivation
+	ReassignReviews *bool   `json:"reassign_reviews,omitempty"`
+	Username        *string `json:"username,omitempty"`
+}
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// PullRequestId defines model for PullRequestId.
+type PullRequestId = string
+
+// ReviewerId defines model for ReviewerId.
+type ReviewerId = string
+
+// TeamName defines model for TeamName.
+type TeamName = string
+
+// UserId defines model for UserId.
+type UserId = string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ErrorResponse
+
+// Conflict defines model for Conflict.
+type Conflict = ErrorResponse
+
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
+
+// NotFound defines model for NotFound.
+type NotFound = ErrorResponse
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ErrorResponse
+
+// ValidationFailed defines model for ValidationFailed.
+type ValidationFailed = ErrorResponse
+
+// GetPullRequestParams defines parameters for GetPullRequest.
+type GetPullRequestParams struct {
+	// IfNoneMatch ETag из предыдущего ответа; если ресурс не изменился, возвращается 304
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// UpdatePullRequestParams defines parameters for UpdatePullRequest.
+type UpdatePullRequestParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// ListReviewersParams defines parameters for ListReviewers.
+type ListReviewersParams struct {
+	// IfNoneMatch ETag из предыдущего ответа; если ресурс не изменился, возвращается 304
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// GetTeamParams defines parameters for GetTeam.
+type GetTeamParams struct {
+	// IncludeSubTeams Включить все дочерние команды
+	IncludeSubTeams *bool `form:"include_sub_teams,omitempty" json:"include_sub_teams,omitempty"`
+
+	// IfNoneMatch ETag из предыдущего ответа; если ресурс не изменился, возвращается 304
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// GetUserParams defines parameters for GetUser.
+type GetUserParams struct {
+	// IfNoneMatch ETag из предыдущего ответа; если ресурс не изменился, возвращается 304
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// CreatePullRequestJSONRequestBody defines body for CreatePullRequest for application/json ContentType.
+type CreatePullRequestJSONRequestBody = NewPullRequest
+
+// UpdatePullRequestJSONRequestBody defines body for UpdatePullRequest for application/json ContentType.
+type UpdatePullRequestJSONRequestBody = PullRequestPatch
+
+// MergePullRequestJSONRequestBody defines body for MergePullRequest for application/json ContentType.
+type MergePullRequestJSONRequestBody = Merge
+
+// CreateTeamJSONRequestBody defines body for CreateTeam for application/json ContentType.
+type CreateTeamJSONRequestBody = Team
+
+// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
+type UpdateUserJSONRequestBody = UserPatch
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Создать PR и автоматически назначить ревьюверов
+	// (POST /v2/pull-requests)
+	CreatePullRequest(c *gin.Context)
+	// Получить PR
+	// (GET /v2/pull-requests/{id})
+	GetPullRequest(c *gin.Context, id PullRequestId, params GetPullRequestParams)
+	// Изменить название, описание, метки или автора открытого PR
+	// (PATCH /v2/pull-requests/{id})
+	UpdatePullRequest(c *gin.Context, id PullRequestId, params UpdatePullRequestParams)
+	// Одобрить PR от имени назначенного ревьювера (идемпотентная операция)
+	// (PUT /v2/pull-requests/{id}/approvals/{user_id})
+	ApprovePullRequest(c *gin.Context, id PullRequestId, userId ReviewerId)
+	// Смёржить PR (идемпотентная операция)
+	// (PUT /v2/pull-requests/{id}/merge)
+	MergePullRequest(c *gin.Context, id PullRequestId)
+	// Получить назначенных ревьюверов PR
+	// (GET /v2/pull-requests/{id}/reviewers)
+	ListReviewers(c *gin.Context, id PullRequestId, params ListReviewersParams)
+	// Снять ревьювера и назначить замену из команды заменяемого
+	// (DELETE /v2/pull-requests/{id}/reviewers/{user_id})
+	RemoveReviewer(c *gin.Context, id PullRequestId, userId ReviewerId)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /v2/teams)
+	CreateTeam(c *gin.Context)
+	// Получить команду с участниками
+	// (GET /v2/teams/{name})
+	GetTeam(c *gin.Context, name TeamName, params GetTeamParams)
+	// Удалить пользователя
+	// (DELETE /v2/users/{id})
+	DeleteUser(c *gin.Context, id UserId)
+	// Получить пользователя
+	// (GET /v2/users/{id})
+	GetUser(c *gin.Context, id UserId, params GetUserParams)
+	// Изменить имя или активность пользователя
+	// (PATCH /v2/users/{id})
+	UpdateUser(c *gin.Context, id UserId)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// CreatePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreatePullRequest(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreatePullRequest(c)
+}
+
+// GetPullRequest operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequest(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PullRequestId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPullRequest(c, id, params)
+}
+
+// UpdatePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePullRequest(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PullRequestId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdatePullRequestParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdatePullRequest(c, id, params)
+}
+
+// ApprovePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) ApprovePullRequest(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PullRequestId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId ReviewerId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ApprovePullRequest(c, id, userId)
+}
+
+// MergePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) MergePullRequest(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PullRequestId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MergePullRequest(c, id)
+}
+
+// ListReviewers operation middleware
+func (siw *ServerInterfaceWrapper) ListReviewers(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PullRequestId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListReviewersParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListReviewers(c, id, params)
+}
+
+// RemoveReviewer operation middleware
+func (siw *ServerInterfaceWrapper) RemoveReviewer(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PullRequestId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId ReviewerId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RemoveReviewer(c, id, userId)
+}
+
+// CreateTeam operation middleware
+func (siw *ServerInterfaceWrapper) CreateTeam(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateTeam(c)
+}
+
+// GetTeam operation middleware
+func (siw *ServerInterfaceWrapper) GetTeam(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name TeamName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamParams
+
+	// ------------- Optional query parameter "include_sub_teams" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_sub_teams", c.Request.URL.Query(), &params.IncludeSubTeams)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_sub_teams: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeam(c, name, params)
+}
+
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteUser(c, id)
+}
+
+// GetUser operation middleware
+func (siw *ServerInterfaceWrapper) GetUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUser(c, id, params)
+}
+
+// UpdateUser operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(OrganizationHeaderScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateUser(c, id)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.POST(options.BaseURL+"/v2/pull-requests", wrapper.CreatePullRequest)
+	router.GET(options.BaseURL+"/v2/pull-requests/:id", wrapper.GetPullRequest)
+	router.PATCH(options.BaseURL+"/v2/pull-requests/:id", wrapper.UpdatePullRequest)
+	router.PUT(options.BaseURL+"/v2/pull-requests/:id/approvals/:user_id", wrapper.ApprovePullRequest)
+	router.PUT(options.BaseURL+"/v2/pull-requests/:id/merge", wrapper.MergePullRequest)
+	router.GET(options.BaseURL+"/v2/pull-requests/:id/reviewers", wrapper.ListReviewers)
+	router.DELETE(options.BaseURL+"/v2/pull-requests/:id/reviewers/:user_id", wrapper.RemoveReviewer)
+	router.POST(options.BaseURL+"/v2/teams", wrapper.CreateTeam)
+	router.GET(options.BaseURL+"/v2/teams/:name", wrapper.GetTeam)
+	router.DELETE(options.BaseURL+"/v2/users/:id", wrapper.DeleteUser)
+	router.GET(options.BaseURL+"/v2/users/:id", wrapper.GetUser)
+	router.PATCH(options.BaseURL+"/v2/users/:id", wrapper.UpdateUser)
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+xcW28bR5b+K43afbCB1tWewUTBPjAW7RAjS1paDgZrGUyLLEmdaXYz3U3HGoOAJG7G",
+	"GchrwYsBJljsxJmdx32hGcmmdaGB/IKqv7C/ZHFOVXdXs4s3SZYDj15sqa91Tp3zndvXekLKXrXmudQN",
+	"AzL3hGxSq0J9/DG/Ym3A/xUalH27FtqeS+YI+092yLf5DuvwfYO949vskB3wHb7LWqzNjtkhO8VTcILv",
+	"8CZe2yImCcqbtGrBA8OtGiVzJAh9290gjYZJFryyJZ6fed1L3uS7/JnBjgy+w7rsDTtgLXbKTlmXnfBm",
+	"6j28mXrPuudXrZDMkbpvT/h0nfrULVNiZhbQMEnN8q0qDaXohfVFz6V3rbC8mV0S6MVgHfYmEX+PHfAm",
+	"/xM7ZD+xrsG6fJe12SHo5FMDlseOWSe1UoOdskN8CDsRKmPHfIfvmwZro5Btvs1a/E+sBY+BM8aN6ZvE",
+	"JDasQGwSMYlrVUGQwvoErHdCLHiwqpfrjlOkX9dpEBYqGn1/zw5gQXyXdfi/sw47Yi2+y7p821guRu+v",
+	"WeFm8na7Qkzi06/rtk8rZC7061RdQtV6vEDdjXCTzM3+6lcmqdpu9PuMqVlgkT6y6TfUH3N1uBNt/ow/",
+	"R9Vvs5Z+tfWA+qULXvIKtaqL+PjMgv+Oe3vEWuyYP0OjxW0/Aec5AhNGYz7ge/rV4n8XudT7wdiaZe9Y",
+	"F1f/hnVZGw8fsmO+fwnW0IBHBTXPDSj65WdWRdou/Fb23JC6+KNVqzm2wJCprwIBJMlL/9mn62SO/NNU",
+	"gnVT4mwwlfd9zy/Kl4hX9mjmr+wQtopvo4kd8V12yvfYW4O9YS1EgC7fMa4JrbCuabAue8X34azU1DO8",
+	"AfcdcABcX9qoPP6OtcBgAQr4Lt/me9dJwyS3PHfdscuXKeoP7J3wHf7HBN8BzToASwhgT1mH7xoo2ZHE",
+	"PIThHVADWsw+RoDnvRGgYZLbnr9mVyrUvUSR/od12RFYtoRckKjDTlmLHWC0eo3yKHv5HVyMnvrWQJl/",
+	"Qhft4Ib+kXVYB0RZ9MLbXt2tXKIkP2bCB0jxVvitXNNdr2Kv21Tn4D8Ojz4GnDpBVBJQ0Iqcv8mfxpE9",
+	"iX+9cUeTPOhElpdN4TUo6X3Xqoebnm//gVY+mG2gIt+iEbdZR/iuOCn9VmMMfF/okjcR49/gWbT1LyzH",
+	"ruCyb1u2c6li/UXBJcyZ4nxECMeb8LPBd/i34L3s0AQhuoqDdPl3/AU7lr9IsDriTYO9QtlPwZYm8Cxk",
+	"fR12jMFFLhH3P7XKuSek5ns16oe2AHIKp7OHy14FL6ZuvUrmHpCVfO5uKf+7wr2Ve8Qky8XUz3fzxTv5",
+	"eWKSxaWVUu7evcKdRflr6VZucb4wn1vJy7O3l+4vwim8pfTZwtKt3+KlX+SL9wpLi6VbS4u3Fwq3VohJ",
+	"7i/m7q98vlQs/BtecXup+Flhfj6/SExSWPwit1CYLxXz/3o/fw8uxt9zK/CI27nCAt5RWFzJFxdzC6V8",
+	"sbhUJA8zUQ32K7RsJ9A46V8hEvCmBCEBwVFGeaAEk5PIMffhRyUQibQnpNUgq911mzoVTVZokioNAmuD",
+	"as/5dYfqU8kkyj+Qz5ZXJw9MpPfWvqLlkDTiA5bvW1uoDa9cr1I3RGco1X1Ho5e/8R2+x47ByRD2IMi+",
+	"Yx2QF93xUGRTB3iGf8c67BU7Yh1ijlwEpJTQ+3bwIfYKg518G6wBgjzfAwSR5QDsw0+wLegzsNZcuUxr",
+	"4cSC5W7UrQ1qXKNuBCd+3RSby5uIucf8qRCGPzeoe52YQzSOvjJI0z3XC5fTbcgd36pIsdetugPKqtqV",
+	"Cm5kbzob4QE75c8MdP83+O8rMFFIDkBsYsYe/FXdtT2fmMkTAyqPONSqaN3jLvU3NJhRhcOV0tqWZof+",
+	"CzIPAxOobRnSl4ufZlIxWLeZVGTCh+Bqse6elDxxrMOoEjMC6qxP4EqImUlmsxuW0fUi/UapwLJCijgI",
+	"Bcq4ybJJypuWCxpatx3xsBgHlAfNTM/e1Bm/9bggrp6Znp7WOKmq79QDb05/8mvNAx1rjTp9V/HrwWuY",
+	"1ayg5tueb4dbw+Kjot7l6Ba4ve44JV8cP5t6U09wZbk35jN8WvMCO/T8LW0JhmXhG6wGoJXQgXgN+ar0",
+	"uqjvAU0GvOQd9go6olCDcDGSUaqo0KsWnZimYpU6/Bhs0EFgb7i0UvJlXZ+2iYyGerc95RBjmPzQB5d9",
+	"aoW0kgtTraKKFdKJ0Eap3brjWGsOjapYTRxPOcUoPjB0WQLlzrOqi3eU0VxhiLFnTgehFdYDNd1bWs4v",
+	"RlnavDY0PKJ+oO8Tqm1JgH5MfNtQWrMDid18B2sXDEauXa1XVR+13ZBuUP/cDhLLZepMP5FgiCMtR73H",
+	"qu0uKw41Y15gwPgFgPq5MbUxRI+KMyTpjQuO5ShpiuN9Q8zk8Ka9sam1v6IKYumNGMVvfFpzrHK/JObH",
+	"dAfTFAU+VFstWX7LxlMbEx3stoCdn/J9vivDwjX8CcqEI0iI2mozWqat8/mF/Er+OtGGJ0W89Opk31S7",
+	"Jv5ttv3aZW21EhmCe0OdLlmZznGgAaupdyzHWbPKvy+F1KoOgWE1/9GBcnWtN3INglZYz128J5NbaXzA",
+	"8qkb4iLhuT61Kkuus9UX330qgKXkuaUKtcqh/UgZnkgTX7ecILl5zfMcarlwd0AdWsZKKwh9K6QbPa7h",
+	"W27FqyquER/4xvN/b7sbpU2vjkhGH6OuA6r1lGDT8keP+kNUFNTXkk3s8Zo/sy5/KrqpSSGY5PAQBwzW",
+	"5jvY6ugYvCmME7wGD+DUqsteR20/9KtOryNh29Cw3bJTr9BSvJ5/gT26TszRzULuoG6LY3Hh0WdGQ9WP",
+	"kgclNtzPf6S9ZrxoI6oOB0kmSsiGSeyghBapZgSK9aUk663hkn1jrb5TB+OaqMrgLO5hNB+DHebbMfqc",
+	"XCfmcF+KpkHjx0648yK2KJlHxU9UtajbLZjhXOY+DdLaQL2cQXLVYodrYeQsaYi4MaQKuNLBzEu0q8NU",
+	"+OuIyTRE2CMY2/BdnOcksTDCDezdtdiRnKTIOcKnffs/2NmKHYA3YyPHF4uisMve6noW/aLDqkt04eA8",
+	"ZpztNwW0XIds6x6YmxzZUcunfq4eaibpagP+QCAvb0LK3mfwgg0dOXMXaIB5ve5i/hxG6YYcq8Ew81vU",
+	"lCyVcbjT5nsaHFG7qdEsHZWGgiRK3AzDGmhwyd+wXPsPqOTPxWB+rOmqVlLQx3HPYgAHoQF/yN7A/E1q",
+	"Lplz97ICfjehLm2iMJ+s3arZv6VbYnhgu+ve4HERGt2+wdpKiZVbLkwaKmTzPbMPZoM0HWO5aEApFg8F",
+	"n0fqfocEjxfsxLj25dSj2SmMq1NPQIjGl+aqiwfBTIOpJ3al8aVp4BFIESdkiijPXDdFWzieYGJ053vw",
+	"K3vNWvE7P19ZWZ4Q01bRNIZEYHLVZT/EgxJIHNITzC47EUXlgWwytvhub8u3y46wxJyL9m+5aPD/EL1J",
+	"yQ1RlGjGu9yVBBrZ3uff9r66bfzf9p9XXRjY8O8G0G4mjTv5FVh6ajSnJZUAocQcj50CKvqbysGJ4S66",
+	"k+9l3hVrne8IrDpAVc5Oz4BZZHrmcC6iA02uurnlgvFoJhpEHeCFr6N6Pm48s5ZA4nfRsAo0uMsOwelf",
+	"s8MkN+gIbU4KNLRDaKOQ5aIR1XVGDsETxhHGPeo/ssvUwCXMKqX7HJmdnJ6cBuf3atS1ajaZIzcmpydv",
+	"EBPZEAh8GSOFgzVP9MggNqGIwMUgt7AVpbbRRKSkQfiZV9kaYXRIH1vVmkN7GgKkPkM0DR1S8ydmpqdn",
+	"tL2MOZKrVIyAWn55kzTMEYeSPW3tRjrWQ/LVS+WYnZ65sJFo5tVpLAMnVKhjZx1Up2lqg66Pr8PF3Jye",
+	"7ndDrJEphdmCt8wMvyU1NMebbgy/KaFg4B03h98RMx3whk8ub44N29aU7iuYJqnxdTSVV4bQrJXwUOBQ",
+	"S9uWkAScJPgjH2sP5ZudHa6QzGS/gRVqtWr5W9G0EE0NMQniXgdCXxuDzQkcZh2sWHdgRGloMso+zZTQ",
+	"2gggcVbMPSAP4e36gIhVAdXgzR0apsFGZT8+0MufXDKVphA2zKE3qHTKxsMMEExfHhCch6RyY0RviTk4",
+	"H5Pvp038ZUwK6kgj72+eGBJ1PFr2fZRf8P0kSUh3XWTRpaYbEfFh0mB/SeUOkHwV1mXGI3v9I/N0V13d",
+	"rHguyrfZaXRPP3ruzdnfmFG5hyeBXQNtpQOZQXWU3I+9hXzOuDkzKzKRtHver1Uy6cB5PTROEMgqubFK",
+	"BjCJh5OIH54jO+mXcKwDeoX0cTh26pEZmoyUfFwi5iALAee2bXbMX5w9Afk4k4nBN8QkWLhhZvZSsw/2",
+	"CjhHSh0Em5ewdY8RIySt+xzZA9z4m8vlM/cgq66MjZGgB/q/T4pCUXOJ9KUdEbHMDDXLNGSpjdmOzNpk",
+	"PgSKTDXQ5BhruXiGfGfKqtV875HlBFNPZHOxITBHkwPl8Fp6qXmQ8mmDJg26qQmRP2DJ+0p2HnG8Ibrf",
+	"kX675KrCOFeFscNO+Avs56hUX/3w4ZnCu1ZGoLp8/eTCyonEBDpxQdEV9Y/0Q81MViUJpT6KMa6xDqYk",
+	"JyAj35VdSdnh66b5/9fP4oTVmL2nczvk9l2g050nF1EIhaQ+O3rGgTKAPX3gtKKd+eqCdYyYFX2VYlwy",
+	"mrD/Fo3hFG00ShlUnikOH9JTm2sJrzT1mdBB1M3APX4qaPrdnqDw9vpHnB319lYitI7B8L0DWorCoO2n",
+	"LNhBmDCDPp52SiJTn++R1NjC94yr/sp76q+MQbo6Y+ocG3k6da5Qh4Y0a/FFWvUe0cg+PnTm/IEsXgB9",
+	"jH1XMfcXkMGb4+buSrSFpPpIEpA6ooEff26U7HTzHyjYIrNUN5BoGfrJhaon2XvVfFIjm74YtLFQGYpY",
+	"Mflv0AAV6XXnqgcky/NBijAkqGMx50kMVRPeDMk5dpkiLCmsKVKztmCKHIxeVuDyL3lymrxzIBkv9Zc3",
+	"WlcD1AQcPxnPxOLvXsWXrj0fuMZfICaWZFgOkBi3DPrYBo9oNMyLgpXePe4/a31Po9EUNDSRcAMpDxLc",
+	"4j+bcYJc3Nj++Au+O5VqqEsOmJ56dAjFUQIuKwgjaVSRVKNBw1IJLOPlOPEfBRk3ozc137YcsWP+PEFZ",
+	"pDMLzlx/6nM04/m6Tv0t5Q909HKYU7OeYQTy95p9jYZGVzXG+6kxRvTG/u6UkPTSpUNvT3sQXbYP6Vud",
+	"VyTUwQP4Vh6+k4SP4DOZGzsxZY9MyQ9l5bRcXHX7J4pRz+VF9CJ49pHx8/8KmSoGSPrzsW52O49XIEN7",
+	"XMCQf5pnxMnAyz6L500M02LQ+PFa79+FlJHt9v8TRZGtgnYFHaEfyp9r035BXRuUQ4eifUzmCk/fD56O",
+	"Y5PvjSLzcsD3B0O+XNAvf9WNX6mWfwoq4zcNcPWJIN+mGXqAyvypoEQDJcaQYSOgYSHIYcnVnxNzflw9",
+	"Y2molIMyMcp+MiJrtdGd9IPQVcZFhyv2ykWxVy6igMqwL+SfEoxZFZGLyw8Zx8Mh9RMe9Cz1450HDyHI",
+	"6b52wTONh/HznkSlhsgPG2Z8QLxIOZDq9jQeNv5/AJ0qRk6PVAAA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,3 @@
+package apiv2
+
+//go:generate go tool oapi-codegen -config oapi-codegen.yaml ../../../api/openapi-v2.yaml
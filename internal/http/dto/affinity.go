@@ -0,0 +1,41 @@
+package dto
+
+import "pr-review/internal/entity"
+
+type SetAffinityRequest struct {
+	AuthorID   string `json:"author_id" binding:"required"`
+	ReviewerID string `json:"reviewer_id" binding:"required"`
+	Weight     int    `json:"weight"`
+	Blocked    bool   `json:"blocked"`
+	Reason     string `json:"reason"`
+}
+
+func (r *SetAffinityRequest) ToEntity() *entity.ReviewerAffinity {
+	return &entity.ReviewerAffinity{
+		AuthorID:   r.AuthorID,
+		ReviewerID: r.ReviewerID,
+		Weight:     r.Weight,
+		Blocked:    r.Blocked,
+		Reason:     r.Reason,
+	}
+}
+
+type DeleteAffinityRequest struct {
+	AuthorID   string `json:"author_id" binding:"required"`
+	ReviewerID string `json:"reviewer_id" binding:"required"`
+}
+
+type AffinityResponse struct {
+	Affinity *entity.ReviewerAffinity `json:"affinity"`
+}
+
+type AffinityListResponse struct {
+	AuthorID   string                     `json:"author_id"`
+	Affinities []*entity.ReviewerAffinity `json:"affinities"`
+}
+
+type DeleteAffinityResponse struct {
+	AuthorID   string `json:"author_id"`
+	ReviewerID string `json:"reviewer_id"`
+	Deleted    bool   `json:"deleted"`
+}
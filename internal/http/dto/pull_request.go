@@ -11,6 +11,12 @@ type PullRequestDTO struct {
 	AuthorID          string     `json:"author_id"`
 	Status            string     `json:"status"`
 	AssignedReviewers []string   `json:"assigned_reviewers"`
+	Repository        string     `json:"repository,omitempty"`
+	ChangedFiles      []string   `json:"changed_files,omitempty"`
+	Labels            []string   `json:"labels,omitempty"`
+	Priority          string     `json:"priority,omitempty"`
+	Description       string     `json:"description,omitempty"`
+	Version           int        `json:"version"`
 	CreatedAt         *time.Time `json:"createdAt,omitempty"`
 	MergedAt          *time.Time `json:"mergedAt,omitempty"`
 }
@@ -26,6 +32,12 @@ func FromEntity(pr *entity.PullRequest) *PullRequestDTO {
 		AuthorID:          pr.AuthorID,
 		Status:            string(pr.Status),
 		AssignedReviewers: pr.AssignedReviewers,
+		Repository:        pr.Repository,
+		ChangedFiles:      pr.ChangedFiles,
+		Labels:            pr.Labels,
+		Priority:          string(pr.Priority),
+		Description:       pr.Description,
+		Version:           pr.Version,
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
 	}
@@ -39,3 +51,17 @@ type ReassignResponse struct {
 	PR         *PullRequestDTO `json:"pr"`
 	ReplacedBy string          `json:"replaced_by"`
 }
+
+type ReviewersResponse struct {
+	PullRequestID string   `json:"pull_request_id"`
+	Reviewers     []string `json:"reviewers"`
+	ReplacedBy    string   `json:"replaced_by,omitempty"`
+}
+
+func ReviewersFromEntity(pr *entity.PullRequest) *ReviewersResponse {
+	reviewers := pr.AssignedReviewers
+	if reviewers == nil {
+		reviewers = make([]string, 0)
+	}
+	return &ReviewersResponse{PullRequestID: pr.ID, Reviewers: reviewers}
+}
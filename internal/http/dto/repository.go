@@ -0,0 +1,24 @@
+package dto
+
+import "pr-review/internal/entity"
+
+type RepositoryRequest struct {
+	RepositoryName string `json:"repository_name" binding:"required"`
+	TeamName       string `json:"team_name"`
+}
+
+func (r *RepositoryRequest) ToEntity() *entity.Repository {
+	return &entity.Repository{
+		Name:     r.RepositoryName,
+		TeamName: r.TeamName,
+	}
+}
+
+type UploadCodeOwnersRequest struct {
+	RepositoryName string `json:"repository_name" binding:"required"`
+	Content        string `json:"content"`
+}
+
+type RepositoryResponse struct {
+	Repository *entity.Repository `json:"repository"`
+}
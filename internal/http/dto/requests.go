@@ -1,66 +1,137 @@
 package dto
 
 import (
-	"errors"
-	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/http/api"
+	"pr-review/internal/i18n"
 	"strings"
+	"time"
 )
 
 type SetIsActiveRequest struct {
+	UserID          string `json:"user_id" binding:"required"`
+	IsActive        bool   `json:"is_active"`
+	ReassignReviews *bool  `json:"reassign_reviews,omitempty"`
+}
+
+func UserFilterFromParams(params api.ListUsersParams) entity.UserFilter {
+	filter := entity.UserFilter{IsActive: params.IsActive}
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+	if params.UsernamePrefix != nil {
+		filter.UsernamePrefix = *params.UsernamePrefix
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+	return filter
+}
+
+type UpdateUserRequest struct {
 	UserID   string `json:"user_id" binding:"required"`
-	IsActive bool   `json:"is_active"`
+	Username string `json:"username" binding:"required"`
+}
+
+type SetAwayRequest struct {
+	UserID   string    `json:"user_id" binding:"required"`
+	From     time.Time `json:"from" binding:"required"`
+	Until    time.Time `json:"until" binding:"required"`
+	Reason   string    `json:"reason"`
+	Handover bool      `json:"handover"`
+}
+
+type SetScheduleRequest struct {
+	UserID   string         `json:"user_id" binding:"required"`
+	TimeZone string         `json:"time_zone"`
+	Start    string         `json:"work_start" binding:"required"`
+	End      string         `json:"work_end" binding:"required"`
+	Days     []time.Weekday `json:"work_days" binding:"required"`
 }
 
-func (r *SetIsActiveRequest) Validate() error {
-	if strings.TrimSpace(r.UserID) == "" {
-		return errors.New("user_id cannot be empty")
+func (r *SetScheduleRequest) ToEntity() *entity.WorkSchedule {
+	return &entity.WorkSchedule{
+		TimeZone: r.TimeZone,
+		Start:    r.Start,
+		End:      r.End,
+		Days:     r.Days,
 	}
-	if len(r.UserID) > config.MaxStringLength {
-		return errors.New("user_id cannot exceed 255 characters")
+}
+
+type SetDigestPreferencesRequest struct {
+	UserID    string `json:"user_id" binding:"required"`
+	Enabled   *bool  `json:"enabled"`
+	Frequency string `json:"frequency"`
+	Email     string `json:"email"`
+}
+
+func (r *SetDigestPreferencesRequest) ToEntity() *entity.DigestPreferences {
+	enabled := true
+	if r.Enabled != nil {
+		enabled = *r.Enabled
+	}
+	return &entity.DigestPreferences{
+		UserID:    r.UserID,
+		Enabled:   enabled,
+		Frequency: entity.DigestFrequency(r.Frequency),
+		Email:     strings.TrimSpace(r.Email),
 	}
-	return nil
 }
 
 type CreatePRRequest struct {
 	PullRequestID   string `json:"pull_request_id" binding:"required"`
 	PullRequestName string `json:"pull_request_name" binding:"required"`
 	AuthorID        string `json:"author_id" binding:"required"`
+
+	Repository   string   `json:"repository"`
+	ChangedFiles []string `json:"changed_files"`
+	Labels       []string `json:"labels"`
+	Priority     string   `json:"priority"`
+	Description  string   `json:"description"`
 }
 
-func (r *CreatePRRequest) Validate() error {
-	if strings.TrimSpace(r.PullRequestID) == "" {
-		return errors.New("pull_request_id cannot be empty")
-	}
-	if len(r.PullRequestID) > config.MaxStringLength {
-		return errors.New("pull_request_id cannot exceed 255 characters")
-	}
-	if strings.TrimSpace(r.PullRequestName) == "" {
-		return errors.New("pull_request_name cannot be empty")
-	}
-	if len(r.PullRequestName) > config.MaxStringLength {
-		return errors.New("pull_request_name cannot exceed 255 characters")
-	}
-	if strings.TrimSpace(r.AuthorID) == "" {
-		return errors.New("author_id cannot be empty")
+func (r *CreatePRRequest) ToEntity() *entity.PullRequest {
+	return &entity.PullRequest{
+		ID:           r.PullRequestID,
+		Name:         r.PullRequestName,
+		AuthorID:     r.AuthorID,
+		Repository:   r.Repository,
+		ChangedFiles: r.ChangedFiles,
+		Labels:       r.Labels,
+		Priority:     entity.Priority(r.Priority),
+		Description:  r.Description,
 	}
-	if len(r.AuthorID) > config.MaxStringLength {
-		return errors.New("author_id cannot exceed 255 characters")
+}
+
+type UpdatePRRequest struct {
+	PullRequestID   string    `json:"pull_request_id" binding:"required"`
+	PullRequestName *string   `json:"pull_request_name"`
+	Description     *string   `json:"description"`
+	AuthorID        *string   `json:"author_id"`
+	Labels          *[]string `json:"labels"`
+	Version         *int      `json:"version"`
+}
+
+func (r *UpdatePRRequest) ToChanges() entity.PullRequestChanges {
+	return entity.PullRequestChanges{
+		Name:        r.PullRequestName,
+		Description: r.Description,
+		AuthorID:    r.AuthorID,
+		Labels:      r.Labels,
 	}
-	return nil
 }
 
 type MergePRRequest struct {
 	PullRequestID string `json:"pull_request_id" binding:"required"`
+	MergedBy      string `json:"merged_by"`
 }
 
-func (r *MergePRRequest) Validate() error {
-	if strings.TrimSpace(r.PullRequestID) == "" {
-		return errors.New("pull_request_id cannot be empty")
-	}
-	if len(r.PullRequestID) > config.MaxStringLength {
-		return errors.New("pull_request_id cannot exceed 255 characters")
-	}
-	return nil
+type ApprovePRRequest struct {
+	PullRequestID string `json:"pull_request_id" binding:"required"`
+	UserID        string `json:"user_id" binding:"required"`
 }
 
 type ReassignReviewerRequest struct {
@@ -68,18 +139,42 @@ type ReassignReviewerRequest struct {
 	OldUserID     string `json:"old_user_id" binding:"required"`
 }
 
-func (r *ReassignReviewerRequest) Validate() error {
-	if strings.TrimSpace(r.PullRequestID) == "" {
-		return errors.New("pull_request_id cannot be empty")
-	}
-	if len(r.PullRequestID) > config.MaxStringLength {
-		return errors.New("pull_request_id cannot exceed 255 characters")
-	}
-	if strings.TrimSpace(r.OldUserID) == "" {
-		return errors.New("old_user_id cannot be empty")
+type PatchUserRequest struct {
+	Username        *string `json:"username"`
+	IsActive        *bool   `json:"is_active"`
+	ReassignReviews *bool   `json:"reassign_reviews"`
+}
+
+func (r *PatchUserRequest) Validate() error {
+	if r.Username == nil && r.IsActive == nil {
+		return entity.NewValidationError("body", entity.RuleRequired, "any_required", i18n.Params{"fields": "username, is_active"})
 	}
-	if len(r.OldUserID) > config.MaxStringLength {
-		return errors.New("old_user_id cannot exceed 255 characters")
+	return nil
+}
+
+type PatchPRRequest struct {
+	PullRequestName *string   `json:"pull_request_name"`
+	Description     *string   `json:"description"`
+	AuthorID        *string   `json:"author_id"`
+	Labels          *[]string `json:"labels"`
+}
+
+func (r *PatchPRRequest) Validate() error {
+	if r.PullRequestName == nil && r.Description == nil && r.AuthorID == nil && r.Labels == nil {
+		return entity.NewValidationError("body", entity.RuleRequired, "any_required", i18n.Params{"fields": "pull_request_name, description, author_id, labels"})
 	}
 	return nil
 }
+
+func (r *PatchPRRequest) ToChanges() entity.PullRequestChanges {
+	return entity.PullRequestChanges{
+		Name:        r.PullRequestName,
+		Description: r.Description,
+		AuthorID:    r.AuthorID,
+		Labels:      r.Labels,
+	}
+}
+
+type MergeRequest struct {
+	MergedBy string `json:"merged_by"`
+}
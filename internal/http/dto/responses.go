@@ -1,12 +1,50 @@
 package dto
 
-import "pr-review/internal/entity"
+import (
+	"encoding/json"
+	"strings"
+
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/i18n"
+)
 
 type ErrorDetail struct {
-	Code    string `json:"code"`
+	Code             string           `json:"code"`
+	Message          string           `json:"message"`
+	Details          []FieldViolation `json:"details,omitempty"`
+	DocumentationURL string           `json:"documentation_url,omitempty"`
+}
+
+func (d ErrorDetail) MarshalJSON() ([]byte, error) {
+	type errorDetail ErrorDetail
+	if d.DocumentationURL == "" && d.Code != "" {
+		d.DocumentationURL = config.ErrorDocsPath + "#" + strings.ToLower(d.Code)
+	}
+	return json.Marshal(errorDetail(d))
+}
+
+type FieldViolation struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
 	Message string `json:"message"`
 }
 
+func FromViolations(lang i18n.Language, violations []entity.FieldViolation) []FieldViolation {
+	if len(violations) == 0 {
+		return nil
+	}
+	result := make([]FieldViolation, len(violations))
+	for i, violation := range violations {
+		message := violation.Message
+		if violation.Key != "" {
+			message = i18n.Translate(lang, violation.Key, violation.Params)
+		}
+		result[i] = FieldViolation{Field: violation.Field, Rule: violation.Rule, Message: message}
+	}
+	return result
+}
+
 type ErrorResponse struct {
 	Error ErrorDetail `json:"error"`
 }
@@ -19,6 +57,39 @@ type UserResponse struct {
 	User *entity.User `json:"user,omitempty"`
 }
 
+type SetIsActiveResponse struct {
+	User     *entity.User               `json:"user,omitempty"`
+	Handover *entity.ReassignmentReport `json:"handover,omitempty"`
+}
+
+type DeleteUserResponse struct {
+	UserID   string                     `json:"user_id"`
+	Handover *entity.ReassignmentReport `json:"handover"`
+}
+
+type ImportResponse struct {
+	Report *entity.ImportReport `json:"report"`
+}
+
+type SetAwayResponse struct {
+	Away     *entity.AwayPeriod         `json:"away"`
+	Handover *entity.ReassignmentReport `json:"handover,omitempty"`
+}
+
+type ScheduleResponse struct {
+	UserID   string               `json:"user_id"`
+	Schedule *entity.WorkSchedule `json:"schedule"`
+}
+
+type DigestPreferencesResponse struct {
+	Preferences *entity.DigestPreferences `json:"preferences"`
+}
+
+type ExpertiseResponse struct {
+	UserID string                   `json:"user_id"`
+	Scores []*entity.ExpertiseScore `json:"scores"`
+}
+
 type GetReviewResponse struct {
 	UserID       string                     `json:"user_id"`
 	PullRequests []*entity.PullRequestShort `json:"pull_requests"`
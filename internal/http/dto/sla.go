@@ -0,0 +1,27 @@
+package dto
+
+import "pr-review/internal/entity"
+
+type SLASettingsRequest struct {
+	TeamName           string `json:"team_name" binding:"required"`
+	FirstReviewMinutes int    `json:"time_to_first_review_minutes"`
+	MergeMinutes       int    `json:"time_to_merge_minutes"`
+	AutoReassign       bool   `json:"auto_reassign"`
+}
+
+func (r *SLASettingsRequest) ToEntity() *entity.SLASettings {
+	return &entity.SLASettings{
+		TeamName:           r.TeamName,
+		FirstReviewMinutes: r.FirstReviewMinutes,
+		MergeMinutes:       r.MergeMinutes,
+		AutoReassign:       r.AutoReassign,
+	}
+}
+
+type SLASettingsResponse struct {
+	Settings *entity.SLASettings `json:"settings"`
+}
+
+type SLABreachesResponse struct {
+	Breaches []*entity.SLABreach `json:"breaches"`
+}
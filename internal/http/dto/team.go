@@ -1,61 +1,24 @@
 package dto
 
 import (
-	"errors"
 	"pr-review/internal/config"
 	"pr-review/internal/entity"
-	"strconv"
-	"strings"
 )
 
 type TeamRequest struct {
-	TeamName string          `json:"team_name" binding:"required"`
-	Members  []MemberRequest `json:"members" binding:"required"`
-}
-
-func (t *TeamRequest) Validate() error {
-	if strings.TrimSpace(t.TeamName) == "" {
-		return errors.New("team_name cannot be empty")
-	}
-	if len(t.TeamName) > config.MaxStringLength {
-		return errors.New("team_name cannot exceed 255 characters")
-	}
-	if len(t.Members) == 0 {
-		return errors.New("members cannot be empty")
-	}
-	if len(t.Members) > config.MaxTeamMembers {
-		return errors.New("members cannot exceed 100")
-	}
-
-	for i, member := range t.Members {
-		if err := member.Validate(); err != nil {
-			return errors.New("member[" + strconv.Itoa(i) + "]: " + err.Error())
-		}
-	}
-
-	return nil
+	TeamName               string          `json:"team_name" binding:"required"`
+	Members                []MemberRequest `json:"members" binding:"required"`
+	ReassignOnDeactivation bool            `json:"reassign_on_deactivation"`
+	SelectionStrategy      string          `json:"selection_strategy"`
+	FallbackTeams          []string        `json:"fallback_teams"`
+	SharedReviewers        []string        `json:"shared_reviewers"`
 }
 
 type MemberRequest struct {
 	UserID   string `json:"user_id" binding:"required"`
 	Username string `json:"username" binding:"required"`
 	IsActive bool   `json:"is_active" binding:"required"`
-}
-
-func (m *MemberRequest) Validate() error {
-	if strings.TrimSpace(m.UserID) == "" {
-		return errors.New("user_id cannot be empty")
-	}
-	if len(m.UserID) > config.MaxStringLength {
-		return errors.New("user_id cannot exceed 255 characters")
-	}
-	if strings.TrimSpace(m.Username) == "" {
-		return errors.New("username cannot be empty")
-	}
-	if len(m.Username) > config.MaxStringLength {
-		return errors.New("username cannot exceed 255 characters")
-	}
-	return nil
+	Grade    string `json:"grade"`
 }
 
 func (t *TeamRequest) ToEntity() *entity.Team {
@@ -66,11 +29,87 @@ func (t *TeamRequest) ToEntity() *entity.Team {
 			Name:     m.Username,
 			Team:     t.TeamName,
 			IsActive: m.IsActive,
+			Grade:    entity.Grade(m.Grade),
 		}
 	}
 
+	fallbackTeams := t.FallbackTeams
+	if fallbackTeams == nil {
+		fallbackTeams = make([]string, 0)
+	}
+	sharedReviewers := t.SharedReviewers
+	if sharedReviewers == nil {
+		sharedReviewers = make([]string, 0)
+	}
+
 	return &entity.Team{
-		Name:    t.TeamName,
-		Members: members,
+		Name:                   t.TeamName,
+		Members:                members,
+		ReassignOnDeactivation: t.ReassignOnDeactivation,
+		SelectionStrategy:      entity.SelectionStrategy(t.SelectionStrategy),
+		FallbackTeams:          fallbackTeams,
+		SharedReviewers:        sharedReviewers,
 	}
 }
+
+type SetReviewerPoolsRequest struct {
+	TeamName        string   `json:"team_name" binding:"required"`
+	FallbackTeams   []string `json:"fallback_teams"`
+	SharedReviewers []string `json:"shared_reviewers"`
+}
+
+type SetTeamPolicyRequest struct {
+	TeamName               string `json:"team_name" binding:"required"`
+	RequiredReviewers      *int   `json:"required_reviewers"`
+	SelectionStrategy      string `json:"selection_strategy"`
+	AllowSelfMerge         *bool  `json:"allow_self_merge"`
+	RequiredApprovals      int    `json:"required_approvals"`
+	ReassignOnDeactivation bool   `json:"reassign_on_deactivation"`
+
+	CompositionRules []entity.CompositionRule `json:"composition_rules"`
+	SecurityTeam     string                   `json:"security_team"`
+	MaxOpenReviews   int                      `json:"max_open_reviews"`
+
+	HierarchyFallback bool `json:"hierarchy_fallback"`
+}
+
+func (r *SetTeamPolicyRequest) ToEntity() *entity.TeamPolicy {
+	requiredReviewers := config.DefaultReviewers
+	if r.RequiredReviewers != nil {
+		requiredReviewers = *r.RequiredReviewers
+	}
+	allowSelfMerge := true
+	if r.AllowSelfMerge != nil {
+		allowSelfMerge = *r.AllowSelfMerge
+	}
+
+	return &entity.TeamPolicy{
+		TeamName:               r.TeamName,
+		RequiredReviewers:      requiredReviewers,
+		SelectionStrategy:      entity.SelectionStrategy(r.SelectionStrategy),
+		AllowSelfMerge:         allowSelfMerge,
+		RequiredApprovals:      r.RequiredApprovals,
+		ReassignOnDeactivation: r.ReassignOnDeactivation,
+		CompositionRules:       r.CompositionRules,
+		SecurityTeam:           r.SecurityTeam,
+		MaxOpenReviews:         r.MaxOpenReviews,
+		HierarchyFallback:      r.HierarchyFallback,
+	}
+}
+
+type TeamPolicyResponse struct {
+	Policy *entity.TeamPolicy `json:"policy"`
+}
+
+type SetParentTeamRequest struct {
+	TeamName   string `json:"team_name" binding:"required"`
+	ParentTeam string `json:"parent_team"`
+}
+
+type TeamHierarchyResponse struct {
+	Hierarchy *entity.TeamHierarchy `json:"hierarchy"`
+}
+
+type TeamStatsResponse struct {
+	Stats *entity.TeamStats `json:"stats"`
+}
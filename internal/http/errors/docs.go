@@ -0,0 +1,15 @@
+package errors
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//go:embed codes.md
+var codes []byte
+
+func Docs(c *gin.Context) {
+	c.Data(http.StatusOK, "text/markdown; charset=utf-8", codes)
+}
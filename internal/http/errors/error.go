@@ -6,41 +6,78 @@ import (
 
 	"pr-review/internal/entity"
 	"pr-review/internal/http/dto"
+	"pr-review/internal/i18n"
 	"pr-review/internal/logging"
 
 	"github.com/gin-gonic/gin"
 )
 
 func HandleError(c *gin.Context, err error) {
+	handle(c, err, statusCode)
+}
+
+func HandleErrorV2(c *gin.Context, err error) {
+	handle(c, err, statusCodeV2)
+}
+
+func handle(c *gin.Context, err error, status func(entity.ErrorCode) int) {
+	lang := i18n.FromAcceptLanguage(c.GetHeader("Accept-Language"))
+	c.Header("Content-Language", string(lang))
+
 	var domainErr *entity.DomainError
 	if !stderrors.As(err, &domainErr) {
 		logging.Printf("ERROR: [%s %s] Internal server error: %v", c.Request.Method, c.Request.URL.Path, err)
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
 			Error: dto.ErrorDetail{
 				Code:    "INTERNAL_ERROR",
-				Message: "internal server error",
+				Message: i18n.Translate(lang, "INTERNAL_ERROR", nil),
 			},
 		})
 		return
 	}
 
-	var statusCode int
-	switch domainErr.Code {
-	case entity.ErrorCodeTeamExists:
-		statusCode = http.StatusBadRequest
-	case entity.ErrorCodePRExists, entity.ErrorCodePRMerged, entity.ErrorCodeNotAssigned, entity.ErrorCodeNoCandidate:
-		statusCode = http.StatusConflict
-	case entity.ErrorCodeNotFound:
-		statusCode = http.StatusNotFound
-	default:
-		statusCode = http.StatusInternalServerError
+	statusCode := status(domainErr.Code)
+	if statusCode == http.StatusInternalServerError {
 		logging.Printf("ERROR: [%s %s] Domain error: %s - %s", c.Request.Method, c.Request.URL.Path, domainErr.Code, domainErr.Message)
 	}
 
+	message := domainErr.Message
+	if domainErr.Key != "" {
+		message = i18n.Translate(lang, domainErr.Key, domainErr.Params)
+	}
 	c.JSON(statusCode, dto.ErrorResponse{
 		Error: dto.ErrorDetail{
 			Code:    string(domainErr.Code),
-			Message: domainErr.Message,
+			Message: message,
+			Details: dto.FromViolations(lang, domainErr.Violations),
 		},
 	})
 }
+
+func statusCode(code entity.ErrorCode) int {
+	switch code {
+	case entity.ErrorCodeTeamExists:
+		return http.StatusBadRequest
+	case entity.ErrorCodeValidationFailed:
+		return http.StatusUnprocessableEntity
+	case entity.ErrorCodePRExists, entity.ErrorCodePRMerged, entity.ErrorCodeNotAssigned, entity.ErrorCodeNoCandidate, entity.ErrorCodeMergeBlocked:
+		return http.StatusConflict
+	case entity.ErrorCodeVersionConflict:
+		return http.StatusPreconditionFailed
+	case entity.ErrorCodeNotFound:
+		return http.StatusNotFound
+	case entity.ErrorCodeUnauthorized:
+		return http.StatusUnauthorized
+	case entity.ErrorCodeForbidden:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func statusCodeV2(code entity.ErrorCode) int {
+	if code == entity.ErrorCodeTeamExists {
+		return http.StatusConflict
+	}
+	return statusCode(code)
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+
+	"pr-review/internal/bulk"
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/http/api"
+	"pr-review/internal/http/dto"
+	"pr-review/internal/http/errors"
+	"pr-review/internal/logging"
+	"pr-review/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	formatJSON = "json"
+	formatCSV  = "csv"
+
+	contentTypeCSV = "text/csv"
+)
+
+type AdminHandler struct {
+	bulkService *service.BulkService
+}
+
+func NewAdminHandler(bulkService *service.BulkService) *AdminHandler {
+	return &AdminHandler{
+		bulkService: bulkService,
+	}
+}
+
+func (h *AdminHandler) Import(c *gin.Context, params api.ImportDataParams) {
+	dryRun := params.DryRun != nil && *params.DryRun
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, config.MaxImportSize)
+
+	var data entity.BulkData
+	if c.ContentType() == contentTypeCSV {
+		resource, ok := bulkResource(c, (*entity.BulkResource)(params.Resource), true)
+		if !ok {
+			return
+		}
+		parsed, err := bulk.ReadCSV(resource, c.Request.Body, config.MaxImportRows)
+		if err != nil {
+			logging.Printf("ERROR: [%s %s] Invalid CSV: %v", c.Request.Method, c.Request.URL.Path, err)
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: dto.ErrorDetail{
+					Code:    "INVALID_REQUEST",
+					Message: "invalid CSV: " + err.Error(),
+				},
+			})
+			return
+		}
+		data = *parsed
+	} else if err := c.ShouldBindJSON(&data); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	report, err := h.bulkService.Import(&data, dryRun)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	status := http.StatusOK
+	if len(report.Errors) > 0 && !dryRun {
+		logging.Printf("ERROR: [%s %s] Import rejected with %d row errors", c.Request.Method, c.Request.URL.Path, len(report.Errors))
+		status = http.StatusUnprocessableEntity
+	}
+	c.JSON(status, dto.ImportResponse{Report: report})
+}
+
+func (h *AdminHandler) Export(c *gin.Context, params api.ExportDataParams) {
+	format := formatJSON
+	if params.Format != nil {
+		format = string(*params.Format)
+	}
+
+	resource, ok := bulkResource(c, (*entity.BulkResource)(params.Resource), format == formatCSV)
+	if !ok {
+		return
+	}
+
+	data, err := h.bulkService.Export()
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	if format == formatJSON {
+		if resource != entity.BulkResourceTeams && resource != "" {
+			data.Teams = []entity.BulkTeam{}
+		}
+		if resource != entity.BulkResourceUsers && resource != "" {
+			data.Users = []entity.User{}
+		}
+		if resource != entity.BulkResourcePullRequests && resource != "" {
+			data.PullRequests = []entity.BulkPullRequest{}
+		}
+		c.JSON(http.StatusOK, data)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := bulk.WriteCSV(resource, data, &buf); err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", `attachment; filename="`+string(resource)+`.csv"`)
+	c.Data(http.StatusOK, contentTypeCSV+"; charset=utf-8", buf.Bytes())
+}
+
+func bulkResource(c *gin.Context, resource *entity.BulkResource, required bool) (entity.BulkResource, bool) {
+	if resource == nil && !required {
+		return "", true
+	}
+	if resource == nil || !resource.IsValid() {
+		logging.Printf("ERROR: [%s %s] Missing resource query parameter", c.Request.Method, c.Request.URL.Path)
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "resource must be one of: teams, users, pull_requests",
+			},
+		})
+		return "", false
+	}
+	return *resource, true
+}
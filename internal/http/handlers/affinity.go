@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"net/http"
+
+	"pr-review/internal/http/api"
+	"pr-review/internal/http/dto"
+	"pr-review/internal/http/errors"
+	"pr-review/internal/logging"
+	"pr-review/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AffinityHandler struct {
+	affinityService *service.AffinityService
+}
+
+func NewAffinityHandler(affinityService *service.AffinityService) *AffinityHandler {
+	return &AffinityHandler{
+		affinityService: affinityService,
+	}
+}
+
+func (h *AffinityHandler) List(c *gin.Context, params api.ListAffinitiesParams) {
+	authorID := params.AuthorId
+	affinities, err := h.affinityService.ListAffinities(authorID)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.AffinityListResponse{AuthorID: authorID, Affinities: affinities})
+}
+
+func (h *AffinityHandler) Set(c *gin.Context) {
+	var req dto.SetAffinityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	affinity, err := h.affinityService.SetAffinity(req.ToEntity())
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.AffinityResponse{Affinity: affinity})
+}
+
+func (h *AffinityHandler) Delete(c *gin.Context) {
+	var req dto.DeleteAffinityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	if err := h.affinityService.DeleteAffinity(req.AuthorID, req.ReviewerID); err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DeleteAffinityResponse{AuthorID: req.AuthorID, ReviewerID: req.ReviewerID, Deleted: true})
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+func versionETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+func contentETag(body any) (string, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return strconv.Quote(hex.EncodeToString(sum[:16])), nil
+}
+
+func notModified(ifNoneMatch *string, tag string) bool {
+	if ifNoneMatch == nil {
+		return false
+	}
+	for _, candidate := range strings.Split(*ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func respondWithETag(c *gin.Context, status int, tag string, ifNoneMatch *string, body any) {
+	c.Header("ETag", tag)
+	if notModified(ifNoneMatch, tag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.JSON(status, body)
+}
@@ -1,8 +1,15 @@
 package handlers
 
 import (
+	stderrors "errors"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
+	"pr-review/internal/http/api"
+	"pr-review/internal/http/apiv2"
 	"pr-review/internal/http/dto"
 	"pr-review/internal/http/errors"
 	"pr-review/internal/logging"
@@ -11,6 +18,8 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+var errVersionRequired = stderrors.New("version or If-Match header is required")
+
 type PullRequestHandler struct {
 	prService *service.PullRequestService
 }
@@ -34,9 +43,45 @@ func (h *PullRequestHandler) Create(c *gin.Context) {
 		return
 	}
 
-	if err := req.Validate(); err != nil {
-		logging.Printf("ERROR: [%s %s] Validation failed: %v", c.Request.Method, c.Request.URL.Path, err)
+	pr, err := h.prService.CreatePullRequest(req.ToEntity())
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	response := dto.PullRequestResponse{
+		PR: dto.FromEntity(pr),
+	}
+
+	c.Header("Content-Type", "application/json")
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *PullRequestHandler) Update(c *gin.Context, params api.UpdatePullRequestParams) {
+	var req dto.UpdatePRRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	ifMatch := ""
+	if params.IfMatch != nil {
+		ifMatch = *params.IfMatch
+	}
+	version, err := expectedVersion(req.Version, ifMatch)
+	if err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid version: %v", c.Request.Method, c.Request.URL.Path, err)
+		status := http.StatusBadRequest
+		if stderrors.Is(err, errVersionRequired) {
+			status = http.StatusPreconditionRequired
+		}
+		c.JSON(status, dto.ErrorResponse{
 			Error: dto.ErrorDetail{
 				Code:    "INVALID_REQUEST",
 				Message: err.Error(),
@@ -45,7 +90,53 @@ func (h *PullRequestHandler) Create(c *gin.Context) {
 		return
 	}
 
-	pr, err := h.prService.CreatePR(req.PullRequestID, req.PullRequestName, req.AuthorID)
+	pr, err := h.prService.UpdatePullRequest(req.PullRequestID, req.ToChanges(), version)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.Header("ETag", versionETag(pr.Version))
+	c.JSON(http.StatusOK, dto.PullRequestResponse{PR: dto.FromEntity(pr)})
+}
+
+func expectedVersion(bodyVersion *int, ifMatch string) (int, error) {
+	headerVersion := 0
+	if ifMatch != "" {
+		tag := strings.Trim(strings.TrimPrefix(strings.TrimSpace(ifMatch), "W/"), `"`)
+		parsed, err := strconv.Atoi(tag)
+		if err != nil || parsed <= 0 {
+			return 0, stderrors.New("If-Match must contain a PR version ETag")
+		}
+		headerVersion = parsed
+	}
+
+	switch {
+	case bodyVersion != nil && headerVersion != 0 && *bodyVersion != headerVersion:
+		return 0, stderrors.New("version and If-Match header do not match")
+	case bodyVersion != nil:
+		return *bodyVersion, nil
+	case headerVersion != 0:
+		return headerVersion, nil
+	default:
+		return 0, errVersionRequired
+	}
+}
+
+func (h *PullRequestHandler) Merge(c *gin.Context) {
+	var req dto.MergePRRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	pr, err := h.prService.MergePR(req.PullRequestID, req.MergedBy)
 	if err != nil {
 		errors.HandleError(c, err)
 		return
@@ -56,11 +147,11 @@ func (h *PullRequestHandler) Create(c *gin.Context) {
 	}
 
 	c.Header("Content-Type", "application/json")
-	c.JSON(http.StatusCreated, response)
+	c.JSON(http.StatusOK, response)
 }
 
-func (h *PullRequestHandler) Merge(c *gin.Context) {
-	var req dto.MergePRRequest
+func (h *PullRequestHandler) Reassign(c *gin.Context) {
+	var req dto.ReassignReviewerRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
@@ -72,18 +163,35 @@ func (h *PullRequestHandler) Merge(c *gin.Context) {
 		return
 	}
 
-	if err := req.Validate(); err != nil {
-		logging.Printf("ERROR: [%s %s] Validation failed: %v", c.Request.Method, c.Request.URL.Path, err)
+	pr, replacedBy, err := h.prService.ReassignReviewer(req.PullRequestID, req.OldUserID)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	response := dto.ReassignResponse{
+		PR:         dto.FromEntity(pr),
+		ReplacedBy: replacedBy,
+	}
+
+	c.Header("Content-Type", "application/json")
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *PullRequestHandler) Approve(c *gin.Context) {
+	var req dto.ApprovePRRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
 			Error: dto.ErrorDetail{
 				Code:    "INVALID_REQUEST",
-				Message: err.Error(),
+				Message: "invalid request body: " + err.Error(),
 			},
 		})
 		return
 	}
 
-	pr, err := h.prService.MergePR(req.PullRequestID)
+	pr, err := h.prService.ApprovePR(req.PullRequestID, req.UserID)
 	if err != nil {
 		errors.HandleError(c, err)
 		return
@@ -93,12 +201,11 @@ func (h *PullRequestHandler) Merge(c *gin.Context) {
 		PR: dto.FromEntity(pr),
 	}
 
-	c.Header("Content-Type", "application/json")
 	c.JSON(http.StatusOK, response)
 }
 
-func (h *PullRequestHandler) Reassign(c *gin.Context) {
-	var req dto.ReassignReviewerRequest
+func (h *PullRequestHandler) CreateV2(c *gin.Context) {
+	var req dto.CreatePRRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
@@ -110,9 +217,56 @@ func (h *PullRequestHandler) Reassign(c *gin.Context) {
 		return
 	}
 
-	if err := req.Validate(); err != nil {
-		logging.Printf("ERROR: [%s %s] Validation failed: %v", c.Request.Method, c.Request.URL.Path, err)
+	pr, err := h.prService.CreatePullRequest(req.ToEntity())
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+
+	c.Header("Location", "/v2/pull-requests/"+url.PathEscape(pr.ID))
+	respondWithETag(c, http.StatusCreated, versionETag(pr.Version), nil, dto.FromEntity(pr))
+}
+
+func (h *PullRequestHandler) GetV2(c *gin.Context, id string, params apiv2.GetPullRequestParams) {
+	pr, err := h.prService.GetPullRequest(id)
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+
+	respondWithETag(c, http.StatusOK, versionETag(pr.Version), params.IfNoneMatch, dto.FromEntity(pr))
+}
+
+func (h *PullRequestHandler) UpdateV2(c *gin.Context, id string, params apiv2.UpdatePullRequestParams) {
+	var req dto.PatchPRRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+
+	ifMatch := ""
+	if params.IfMatch != nil {
+		ifMatch = *params.IfMatch
+	}
+	version, err := expectedVersion(nil, ifMatch)
+	if err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid version: %v", c.Request.Method, c.Request.URL.Path, err)
+		status := http.StatusBadRequest
+		if stderrors.Is(err, errVersionRequired) {
+			status = http.StatusPreconditionRequired
+		}
+		c.JSON(status, dto.ErrorResponse{
 			Error: dto.ErrorDetail{
 				Code:    "INVALID_REQUEST",
 				Message: err.Error(),
@@ -121,17 +275,75 @@ func (h *PullRequestHandler) Reassign(c *gin.Context) {
 		return
 	}
 
-	pr, replacedBy, err := h.prService.ReassignReviewer(req.PullRequestID, req.OldUserID)
+	pr, err := h.prService.UpdatePullRequest(id, req.ToChanges(), version)
 	if err != nil {
-		errors.HandleError(c, err)
+		errors.HandleErrorV2(c, err)
 		return
 	}
 
-	response := dto.ReassignResponse{
-		PR:         dto.FromEntity(pr),
-		ReplacedBy: replacedBy,
+	respondWithETag(c, http.StatusOK, versionETag(pr.Version), nil, dto.FromEntity(pr))
+}
+
+func (h *PullRequestHandler) MergeV2(c *gin.Context, id string) {
+	var req dto.MergeRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !stderrors.Is(err, io.EOF) {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "invalid request body: " + err.Error(),
+			},
+		})
+		return
 	}
 
-	c.Header("Content-Type", "application/json")
-	c.JSON(http.StatusOK, response)
+	pr, err := h.prService.MergePR(id, req.MergedBy)
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+
+	respondWithETag(c, http.StatusOK, versionETag(pr.Version), nil, dto.FromEntity(pr))
+}
+
+func (h *PullRequestHandler) ListReviewersV2(c *gin.Context, id string, params apiv2.ListReviewersParams) {
+	pr, err := h.prService.GetPullRequest(id)
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+	reviewers := dto.ReviewersFromEntity(pr)
+	tag, err := contentETag(reviewers)
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+
+	respondWithETag(c, http.StatusOK, tag, params.IfNoneMatch, reviewers)
+}
+
+func (h *PullRequestHandler) RemoveReviewerV2(c *gin.Context, id, userID string) {
+	pr, replacedBy, err := h.prService.ReassignReviewer(id, userID)
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+	reviewers := dto.ReviewersFromEntity(pr)
+	tag, err := contentETag(reviewers)
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+
+	reviewers.ReplacedBy = replacedBy
+	respondWithETag(c, http.StatusOK, tag, nil, reviewers)
+}
+
+func (h *PullRequestHandler) ApproveV2(c *gin.Context, id, userID string) {
+	if _, err := h.prService.ApprovePR(id, userID); err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
 }
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"net/http"
+
+	"pr-review/internal/http/api"
+	"pr-review/internal/http/dto"
+	"pr-review/internal/http/errors"
+	"pr-review/internal/logging"
+	"pr-review/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RepositoryHandler struct {
+	repositoryService *service.RepositoryService
+}
+
+func NewRepositoryHandler(repositoryService *service.RepositoryService) *RepositoryHandler {
+	return &RepositoryHandler{
+		repositoryService: repositoryService,
+	}
+}
+
+func (h *RepositoryHandler) Add(c *gin.Context) {
+	var req dto.RepositoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	repository, err := h.repositoryService.SaveRepository(req.ToEntity())
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.RepositoryResponse{Repository: repository})
+}
+
+func (h *RepositoryHandler) Get(c *gin.Context, params api.GetRepositoryParams) {
+	repository, err := h.repositoryService.GetRepository(params.RepositoryName)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RepositoryResponse{Repository: repository})
+}
+
+func (h *RepositoryHandler) UploadCodeOwners(c *gin.Context) {
+	var req dto.UploadCodeOwnersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	repository, err := h.repositoryService.UploadCodeOwners(req.RepositoryName, req.Content)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RepositoryResponse{Repository: repository})
+}
@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"pr-review/internal/http/api"
+	"pr-review/internal/http/dto"
+	"pr-review/internal/logging"
+
+	"github.com/gin-gonic/gin"
+)
+
+var _ api.ServerInterface = (*Server)(nil)
+
+type Handlers struct {
+	Team        *TeamHandler
+	User        *UserHandler
+	PullRequest *PullRequestHandler
+	SLA         *SLAHandler
+	Affinity    *AffinityHandler
+	Repository  *RepositoryHandler
+	Admin       *AdminHandler
+}
+
+type Server struct {
+	resolve func(*gin.Context) *Handlers
+}
+
+func NewServer(resolve func(*gin.Context) *Handlers) *Server {
+	return &Server{
+		resolve: resolve,
+	}
+}
+
+func Register(router gin.IRouter, server api.ServerInterface) {
+	api.RegisterHandlersWithOptions(router, server, api.GinServerOptions{
+		ErrorHandler: invalidParameter,
+	})
+}
+
+func invalidParameter(c *gin.Context, err error, status int) {
+	logging.Printf("ERROR: [%s %s] Invalid request parameter: %v", c.Request.Method, c.Request.URL.Path, err)
+	c.JSON(status, dto.ErrorResponse{
+		Error: dto.ErrorDetail{
+			Code:    "INVALID_REQUEST",
+			Message: err.Error(),
+		},
+	})
+}
+
+func (s *Server) AddTeam(c *gin.Context) {
+	s.resolve(c).Team.Add(c)
+}
+
+func (s *Server) GetTeam(c *gin.Context, params api.GetTeamParams) {
+	s.resolve(c).Team.Get(c, params)
+}
+
+func (s *Server) SetReviewerPools(c *gin.Context) {
+	s.resolve(c).Team.SetReviewerPools(c)
+}
+
+func (s *Server) GetTeamPolicy(c *gin.Context, params api.GetTeamPolicyParams) {
+	s.resolve(c).Team.GetPolicy(c, params)
+}
+
+func (s *Server) SetTeamPolicy(c *gin.Context) {
+	s.resolve(c).Team.SetPolicy(c)
+}
+
+func (s *Server) SetParentTeam(c *gin.Context) {
+	s.resolve(c).Team.SetParent(c)
+}
+
+func (s *Server) GetTeamHierarchy(c *gin.Context, params api.GetTeamHierarchyParams) {
+	s.resolve(c).Team.GetHierarchy(c, params)
+}
+
+func (s *Server) GetTeamStats(c *gin.Context, params api.GetTeamStatsParams) {
+	s.resolve(c).Team.GetStats(c, params)
+}
+
+func (s *Server) GetUser(c *gin.Context, params api.GetUserParams) {
+	s.resolve(c).User.Get(c, params)
+}
+
+func (s *Server) ListUsers(c *gin.Context, params api.ListUsersParams) {
+	s.resolve(c).User.List(c, params)
+}
+
+func (s *Server) UpdateUsername(c *gin.Context) {
+	s.resolve(c).User.Update(c)
+}
+
+func (s *Server) DeleteUser(c *gin.Context, params api.DeleteUserParams) {
+	s.resolve(c).User.Delete(c, params)
+}
+
+func (s *Server) SetUserIsActive(c *gin.Context) {
+	s.resolve(c).User.SetIsActive(c)
+}
+
+func (s *Server) SetUserAway(c *gin.Context) {
+	s.resolve(c).User.SetAway(c)
+}
+
+func (s *Server) SetUserSchedule(c *gin.Context) {
+	s.resolve(c).User.SetSchedule(c)
+}
+
+func (s *Server) GetUserReview(c *gin.Context, params api.GetUserReviewParams) {
+	s.resolve(c).User.GetReview(c, params)
+}
+
+func (s *Server) GetDigestPreferences(c *gin.Context, params api.GetDigestPreferencesParams) {
+	s.resolve(c).User.GetDigestPreferences(c, params)
+}
+
+func (s *Server) SetDigestPreferences(c *gin.Context) {
+	s.resolve(c).User.SetDigestPreferences(c)
+}
+
+func (s *Server) GetUserExpertise(c *gin.Context, params api.GetUserExpertiseParams) {
+	s.resolve(c).User.GetExpertise(c, params)
+}
+
+func (s *Server) CreatePullRequest(c *gin.Context) {
+	s.resolve(c).PullRequest.Create(c)
+}
+
+func (s *Server) UpdatePullRequest(c *gin.Context, params api.UpdatePullRequestParams) {
+	s.resolve(c).PullRequest.Update(c, params)
+}
+
+func (s *Server) MergePullRequest(c *gin.Context) {
+	s.resolve(c).PullRequest.Merge(c)
+}
+
+func (s *Server) ApprovePullRequest(c *gin.Context) {
+	s.resolve(c).PullRequest.Approve(c)
+}
+
+func (s *Server) ReassignReviewer(c *gin.Context) {
+	s.resolve(c).PullRequest.Reassign(c)
+}
+
+func (s *Server) GetSLASettings(c *gin.Context, params api.GetSLASettingsParams) {
+	s.resolve(c).SLA.GetSettings(c, params)
+}
+
+func (s *Server) SetSLASettings(c *gin.Context) {
+	s.resolve(c).SLA.SetSettings(c)
+}
+
+func (s *Server) ListSLABreaches(c *gin.Context, params api.ListSLABreachesParams) {
+	s.resolve(c).SLA.GetBreaches(c, params)
+}
+
+func (s *Server) ListAffinities(c *gin.Context, params api.ListAffinitiesParams) {
+	s.resolve(c).Affinity.List(c, params)
+}
+
+func (s *Server) SetAffinity(c *gin.Context) {
+	s.resolve(c).Affinity.Set(c)
+}
+
+func (s *Server) DeleteAffinity(c *gin.Context) {
+	s.resolve(c).Affinity.Delete(c)
+}
+
+func (s *Server) AddRepository(c *gin.Context) {
+	s.resolve(c).Repository.Add(c)
+}
+
+func (s *Server) GetRepository(c *gin.Context, params api.GetRepositoryParams) {
+	s.resolve(c).Repository.Get(c, params)
+}
+
+func (s *Server) UploadCodeOwners(c *gin.Context) {
+	s.resolve(c).Repository.UploadCodeOwners(c)
+}
+
+func (s *Server) ImportData(c *gin.Context, params api.ImportDataParams) {
+	s.resolve(c).Admin.Import(c, params)
+}
+
+func (s *Server) ExportData(c *gin.Context, params api.ExportDataParams) {
+	s.resolve(c).Admin.Export(c, params)
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"pr-review/internal/http/apiv2"
+
+	"github.com/gin-gonic/gin"
+)
+
+var _ apiv2.ServerInterface = (*ServerV2)(nil)
+
+type ServerV2 struct {
+	resolve func(*gin.Context) *Handlers
+}
+
+func NewServerV2(resolve func(*gin.Context) *Handlers) *ServerV2 {
+	return &ServerV2{
+		resolve: resolve,
+	}
+}
+
+func RegisterV2(router gin.IRouter, server apiv2.ServerInterface) {
+	apiv2.RegisterHandlersWithOptions(router, server, apiv2.GinServerOptions{
+		ErrorHandler: invalidParameter,
+	})
+}
+
+func (s *ServerV2) CreateTeam(c *gin.Context) {
+	s.resolve(c).Team.CreateV2(c)
+}
+
+func (s *ServerV2) GetTeam(c *gin.Context, name apiv2.TeamName, params apiv2.GetTeamParams) {
+	s.resolve(c).Team.GetV2(c, name, params)
+}
+
+func (s *ServerV2) GetUser(c *gin.Context, id apiv2.UserId, params apiv2.GetUserParams) {
+	s.resolve(c).User.GetV2(c, id, params)
+}
+
+func (s *ServerV2) UpdateUser(c *gin.Context, id apiv2.UserId) {
+	s.resolve(c).User.UpdateV2(c, id)
+}
+
+func (s *ServerV2) DeleteUser(c *gin.Context, id apiv2.UserId) {
+	s.resolve(c).User.DeleteV2(c, id)
+}
+
+func (s *ServerV2) CreatePullRequest(c *gin.Context) {
+	s.resolve(c).PullRequest.CreateV2(c)
+}
+
+func (s *ServerV2) GetPullRequest(c *gin.Context, id apiv2.PullRequestId, params apiv2.GetPullRequestParams) {
+	s.resolve(c).PullRequest.GetV2(c, id, params)
+}
+
+func (s *ServerV2) UpdatePullRequest(c *gin.Context, id apiv2.PullRequestId, params apiv2.UpdatePullRequestParams) {
+	s.resolve(c).PullRequest.UpdateV2(c, id, params)
+}
+
+func (s *ServerV2) MergePullRequest(c *gin.Context, id apiv2.PullRequestId) {
+	s.resolve(c).PullRequest.MergeV2(c, id)
+}
+
+func (s *ServerV2) ListReviewers(c *gin.Context, id apiv2.PullRequestId, params apiv2.ListReviewersParams) {
+	s.resolve(c).PullRequest.ListReviewersV2(c, id, params)
+}
+
+func (s *ServerV2) RemoveReviewer(c *gin.Context, id apiv2.PullRequestId, userID apiv2.ReviewerId) {
+	s.resolve(c).PullRequest.RemoveReviewerV2(c, id, userID)
+}
+
+func (s *ServerV2) ApprovePullRequest(c *gin.Context, id apiv2.PullRequestId, userID apiv2.ReviewerId) {
+	s.resolve(c).PullRequest.ApproveV2(c, id, userID)
+}
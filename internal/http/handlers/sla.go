@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"net/http"
+
+	"pr-review/internal/entity"
+	"pr-review/internal/http/api"
+	"pr-review/internal/http/dto"
+	"pr-review/internal/http/errors"
+	"pr-review/internal/logging"
+	"pr-review/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SLAHandler struct {
+	slaService *service.SLAService
+}
+
+func NewSLAHandler(slaService *service.SLAService) *SLAHandler {
+	return &SLAHandler{
+		slaService: slaService,
+	}
+}
+
+func (h *SLAHandler) GetSettings(c *gin.Context, params api.GetSLASettingsParams) {
+	settings, err := h.slaService.GetSettings(params.TeamName)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SLASettingsResponse{Settings: settings})
+}
+
+func (h *SLAHandler) SetSettings(c *gin.Context) {
+	var req dto.SLASettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	settings, err := h.slaService.SetSettings(req.ToEntity())
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SLASettingsResponse{Settings: settings})
+}
+
+func (h *SLAHandler) GetBreaches(c *gin.Context, params api.ListSLABreachesParams) {
+	var filter entity.SLABreachFilter
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+	if params.Kind != nil {
+		filter.Kind = entity.SLABreachKind(*params.Kind)
+	}
+	if params.OpenOnly != nil {
+		filter.OpenOnly = *params.OpenOnly
+	}
+
+	breaches, err := h.slaService.ListBreaches(filter)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SLABreachesResponse{Breaches: breaches})
+}
@@ -2,8 +2,11 @@ package handlers
 
 import (
 	"net/http"
+	"net/url"
 
-	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/http/api"
+	"pr-review/internal/http/apiv2"
 	"pr-review/internal/http/dto"
 	"pr-review/internal/http/errors"
 	"pr-review/internal/logging"
@@ -35,62 +38,190 @@ func (h *TeamHandler) Add(c *gin.Context) {
 		return
 	}
 
-	if err := team.Validate(); err != nil {
-		logging.Printf("ERROR: [%s %s] Validation failed: %v", c.Request.Method, c.Request.URL.Path, err)
+	entityTeam := team.ToEntity()
+
+	err := h.teamService.AddTeam(entityTeam)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	response := dto.TeamResponse{
+		Team: entityTeam,
+	}
+
+	c.Header("Content-Type", "application/json")
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *TeamHandler) Get(c *gin.Context, params api.GetTeamParams) {
+	var team *entity.Team
+	var err error
+	if params.IncludeSubTeams != nil && *params.IncludeSubTeams {
+		team, err = h.teamService.GetTeamWithSubTeams(params.TeamName)
+	} else {
+		team, err = h.teamService.GetTeam(params.TeamName)
+	}
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "application/json")
+	c.JSON(http.StatusOK, team)
+}
+
+func (h *TeamHandler) SetReviewerPools(c *gin.Context) {
+	var req dto.SetReviewerPoolsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
 			Error: dto.ErrorDetail{
 				Code:    "INVALID_REQUEST",
-				Message: err.Error(),
+				Message: "invalid request body: " + err.Error(),
 			},
 		})
 		return
 	}
 
-	entityTeam := team.ToEntity()
-
-	err := h.teamService.AddTeam(entityTeam)
+	team, err := h.teamService.SetReviewerPools(req.TeamName, req.FallbackTeams, req.SharedReviewers)
 	if err != nil {
 		errors.HandleError(c, err)
 		return
 	}
 
 	response := dto.TeamResponse{
-		Team: entityTeam,
+		Team: team,
 	}
 
-	c.Header("Content-Type", "application/json")
-	c.JSON(http.StatusCreated, response)
+	c.JSON(http.StatusOK, response)
 }
 
-func (h *TeamHandler) Get(c *gin.Context) {
-	teamName := c.Query("team_name")
-	if teamName == "" {
-		logging.Printf("ERROR: [%s %s] Missing team_name query parameter", c.Request.Method, c.Request.URL.Path)
+func (h *TeamHandler) GetPolicy(c *gin.Context, params api.GetTeamPolicyParams) {
+	policy, err := h.teamService.GetPolicy(params.TeamName)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TeamPolicyResponse{Policy: policy})
+}
+
+func (h *TeamHandler) SetPolicy(c *gin.Context) {
+	var req dto.SetTeamPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
 			Error: dto.ErrorDetail{
 				Code:    "INVALID_REQUEST",
-				Message: "team_name query parameter is required",
+				Message: "invalid request body: " + err.Error(),
 			},
 		})
 		return
 	}
-	if len(teamName) > config.MaxStringLength {
-		logging.Printf("ERROR: [%s %s] team_name exceeds max length: %d", c.Request.Method, c.Request.URL.Path, len(teamName))
+
+	policy, err := h.teamService.SetPolicy(req.ToEntity())
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TeamPolicyResponse{Policy: policy})
+}
+
+func (h *TeamHandler) SetParent(c *gin.Context) {
+	var req dto.SetParentTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
 			Error: dto.ErrorDetail{
 				Code:    "INVALID_REQUEST",
-				Message: "team_name cannot exceed 255 characters",
+				Message: "invalid request body: " + err.Error(),
 			},
 		})
 		return
 	}
 
-	team, err := h.teamService.GetTeam(teamName)
+	team, err := h.teamService.SetParentTeam(req.TeamName, req.ParentTeam)
 	if err != nil {
 		errors.HandleError(c, err)
 		return
 	}
 
-	c.Header("Content-Type", "application/json")
-	c.JSON(http.StatusOK, team)
+	c.JSON(http.StatusOK, dto.TeamResponse{Team: team})
+}
+
+func (h *TeamHandler) GetHierarchy(c *gin.Context, params api.GetTeamHierarchyParams) {
+	hierarchy, err := h.teamService.GetHierarchy(params.TeamName)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TeamHierarchyResponse{Hierarchy: hierarchy})
+}
+
+func (h *TeamHandler) GetStats(c *gin.Context, params api.GetTeamStatsParams) {
+	includeSubTeams := params.IncludeSubTeams != nil && *params.IncludeSubTeams
+	stats, err := h.teamService.GetStats(params.TeamName, includeSubTeams)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TeamStatsResponse{Stats: stats})
+}
+
+func (h *TeamHandler) CreateV2(c *gin.Context) {
+	var req dto.TeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	if err := h.teamService.AddTeam(req.ToEntity()); err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+
+	team, err := h.teamService.GetTeam(req.TeamName)
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+	tag, err := contentETag(team)
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+
+	c.Header("Location", "/v2/teams/"+url.PathEscape(team.Name))
+	respondWithETag(c, http.StatusCreated, tag, nil, team)
+}
+
+func (h *TeamHandler) GetV2(c *gin.Context, name string, params apiv2.GetTeamParams) {
+	var team *entity.Team
+	var err error
+	if params.IncludeSubTeams != nil && *params.IncludeSubTeams {
+		team, err = h.teamService.GetTeamWithSubTeams(name)
+	} else {
+		team, err = h.teamService.GetTeam(name)
+	}
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+	tag, err := contentETag(team)
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+
+	respondWithETag(c, http.StatusOK, tag, params.IfNoneMatch, team)
 }
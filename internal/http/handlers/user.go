@@ -3,9 +3,12 @@ package handlers
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"pr-review/internal/config"
 	"pr-review/internal/entity"
+	"pr-review/internal/http/api"
+	"pr-review/internal/http/apiv2"
 	"pr-review/internal/http/dto"
 	"pr-review/internal/http/errors"
 	"pr-review/internal/logging"
@@ -15,15 +18,82 @@ import (
 )
 
 type UserHandler struct {
-	userService *service.UserService
+	userService         *service.UserService
+	availabilityService *service.AvailabilityService
+	digestService       *service.DigestService
+	expertiseService    *service.ExpertiseService
 }
 
-func NewUserHandler(userService *service.UserService) *UserHandler {
+func NewUserHandler(
+	userService *service.UserService,
+	availabilityService *service.AvailabilityService,
+	digestService *service.DigestService,
+	expertiseService *service.ExpertiseService,
+) *UserHandler {
 	return &UserHandler{
-		userService: userService,
+		userService:         userService,
+		availabilityService: availabilityService,
+		digestService:       digestService,
+		expertiseService:    expertiseService,
 	}
 }
 
+func (h *UserHandler) Get(c *gin.Context, params api.GetUserParams) {
+	userID := params.UserId
+
+	user, err := h.userService.GetUser(userID)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UserResponse{User: user})
+}
+
+func (h *UserHandler) List(c *gin.Context, params api.ListUsersParams) {
+	page, err := h.userService.ListUsers(dto.UserFilterFromParams(params))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+func (h *UserHandler) Update(c *gin.Context) {
+	var req dto.UpdateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	user, err := h.userService.UpdateUsername(req.UserID, req.Username)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UserResponse{User: user})
+}
+
+func (h *UserHandler) Delete(c *gin.Context, params api.DeleteUserParams) {
+	userID := params.UserId
+
+	report, err := h.userService.DeleteUser(userID)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DeleteUserResponse{UserID: userID, Handover: report})
+}
+
 func (h *UserHandler) SetIsActive(c *gin.Context) {
 	var req dto.SetIsActiveRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -58,43 +128,120 @@ func (h *UserHandler) SetIsActive(c *gin.Context) {
 		return
 	}
 
-	user, err := h.userService.SetIsActive(req.UserID, req.IsActive)
+	user, report, err := h.userService.SetIsActive(req.UserID, req.IsActive, req.ReassignReviews)
 	if err != nil {
 		errors.HandleError(c, err)
 		return
 	}
 
-	response := dto.UserResponse{
-		User: user,
+	response := dto.SetIsActiveResponse{
+		User:     user,
+		Handover: report,
 	}
 
 	c.JSON(http.StatusOK, response)
 }
 
-func (h *UserHandler) GetReview(c *gin.Context) {
-	userID := c.Query("user_id")
-	if userID == "" {
-		logging.Printf("ERROR: [%s %s] Missing user_id query parameter", c.Request.Method, c.Request.URL.Path)
+func (h *UserHandler) SetAway(c *gin.Context) {
+	var req dto.SetAwayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
 			Error: dto.ErrorDetail{
 				Code:    "INVALID_REQUEST",
-				Message: "user_id query parameter is required",
+				Message: "invalid request body: " + err.Error(),
 			},
 		})
 		return
 	}
-	if len(userID) > config.MaxStringLength {
-		logging.Printf("ERROR: [%s %s] user_id exceeds max length: %d", c.Request.Method, c.Request.URL.Path, len(userID))
+
+	period, report, err := h.availabilityService.SetAway(req.UserID, req.From, req.Until, req.Reason, req.Handover)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	response := dto.SetAwayResponse{
+		Away:     period,
+		Handover: report,
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *UserHandler) SetSchedule(c *gin.Context) {
+	var req dto.SetScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
 			Error: dto.ErrorDetail{
 				Code:    "INVALID_REQUEST",
-				Message: "user_id cannot exceed 255 characters",
+				Message: "invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	schedule, err := h.userService.SetWorkSchedule(req.UserID, req.ToEntity())
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	response := dto.ScheduleResponse{
+		UserID:   req.UserID,
+		Schedule: schedule,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *UserHandler) GetDigestPreferences(c *gin.Context, params api.GetDigestPreferencesParams) {
+	userID := params.UserId
+
+	prefs, err := h.digestService.GetPreferences(userID)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DigestPreferencesResponse{Preferences: prefs})
+}
+
+func (h *UserHandler) SetDigestPreferences(c *gin.Context) {
+	var req dto.SetDigestPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "invalid request body: " + err.Error(),
 			},
 		})
 		return
 	}
 
-	prs, err := h.userService.GetReviewPRs(userID)
+	prefs, err := h.digestService.SetPreferences(req.ToEntity())
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DigestPreferencesResponse{Preferences: prefs})
+}
+
+func (h *UserHandler) GetReview(c *gin.Context, params api.GetUserReviewParams) {
+	userID := params.UserId
+
+	var filter entity.ReviewQueueFilter
+	if params.Label != nil {
+		filter.Labels = *params.Label
+	}
+	if params.Priority != nil {
+		filter.Priority = entity.Priority(*params.Priority)
+	}
+
+	prs, err := h.userService.GetReviewPRs(userID, filter)
 	if err != nil {
 		errors.HandleError(c, err)
 		return
@@ -112,3 +259,84 @@ func (h *UserHandler) GetReview(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+func (h *UserHandler) GetExpertise(c *gin.Context, params api.GetUserExpertiseParams) {
+	userID := params.UserId
+
+	scores, err := h.expertiseService.GetExpertise(userID, time.Now())
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ExpertiseResponse{UserID: userID, Scores: scores})
+}
+
+func (h *UserHandler) GetV2(c *gin.Context, id string, params apiv2.GetUserParams) {
+	user, err := h.userService.GetUser(id)
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+	tag, err := contentETag(user)
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+
+	respondWithETag(c, http.StatusOK, tag, params.IfNoneMatch, user)
+}
+
+func (h *UserHandler) UpdateV2(c *gin.Context, id string) {
+	var req dto.PatchUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Printf("ERROR: [%s %s] Invalid request body: %v", c.Request.Method, c.Request.URL.Path, err)
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_REQUEST",
+				Message: "invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+
+	if req.Username != nil {
+		if _, err := h.userService.UpdateUsername(id, *req.Username); err != nil {
+			errors.HandleErrorV2(c, err)
+			return
+		}
+	}
+	if req.IsActive != nil {
+		if _, _, err := h.userService.SetIsActive(id, *req.IsActive, req.ReassignReviews); err != nil {
+			errors.HandleErrorV2(c, err)
+			return
+		}
+	}
+
+	user, err := h.userService.GetUser(id)
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+	tag, err := contentETag(user)
+	if err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+
+	respondWithETag(c, http.StatusOK, tag, nil, user)
+}
+
+func (h *UserHandler) DeleteV2(c *gin.Context, id string) {
+	if _, err := h.userService.DeleteUser(id); err != nil {
+		errors.HandleErrorV2(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
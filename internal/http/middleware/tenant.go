@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"strings"
+
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/http/errors"
+	"pr-review/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+const organizationKey = "organization_id"
+
+func Tenant(orgService *service.OrganizationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, ok := bearerToken(c.GetHeader("Authorization"))
+		if !ok {
+			errors.HandleError(c, entity.NewError(entity.ErrorCodeUnauthorized, "bearer_scheme", nil))
+			c.Abort()
+			return
+		}
+
+		org, err := orgService.ResolveOrganization(token, strings.TrimSpace(c.GetHeader(config.OrganizationHeader)))
+		if err != nil {
+			errors.HandleError(c, err)
+			c.Abort()
+			return
+		}
+
+		c.Set(organizationKey, org.ID)
+		c.Next()
+	}
+}
+
+func OrganizationID(c *gin.Context) string {
+	return c.GetString(organizationKey)
+}
+
+func bearerToken(header string) (string, bool) {
+	if header == "" {
+		return "", true
+	}
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
@@ -0,0 +1,178 @@
+package middleware
+
+import (
+	"bytes"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/http/dto"
+	"pr-review/internal/i18n"
+	"pr-review/internal/logging"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gin-gonic/gin"
+)
+
+func Validation(spec *openapi3.T, validateResponses bool) (gin.HandlerFunc, error) {
+	router, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		return nil, err
+	}
+	options := &openapi3filter.Options{
+		MultiError:          true,
+		SkipSettingDefaults: true,
+		AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(c *gin.Context) {
+		route, pathParams, err := router.FindRoute(c.Request)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, config.MaxImportSize)
+		input := &openapi3filter.RequestValidationInput{
+			Request:    c.Request,
+			PathParams: pathParams,
+			Route:      route,
+			Options:    options,
+		}
+		if err := openapi3filter.ValidateRequest(c.Request.Context(), input); err != nil {
+			logging.Printf("ERROR: [%s %s] Request does not match the API schema: %v", c.Request.Method, c.Request.URL.Path, err)
+			lang := i18n.FromAcceptLanguage(c.GetHeader("Accept-Language"))
+			c.Header("Content-Language", string(lang))
+			c.AbortWithStatusJSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: dto.ErrorDetail{
+					Code:    "INVALID_REQUEST",
+					Message: i18n.Translate(lang, "INVALID_REQUEST.schema", nil),
+					Details: violations("", err),
+				},
+			})
+			return
+		}
+
+		if !validateResponses {
+			c.Next()
+			return
+		}
+
+		writer := &bufferedWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		err = openapi3filter.ValidateResponse(c.Request.Context(), &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: input,
+			Status:                 writer.status,
+			Header:                 writer.Header(),
+			Body:                   io.NopCloser(bytes.NewReader(writer.body.Bytes())),
+			Options:                &openapi3filter.Options{MultiError: true, IncludeResponseStatus: true},
+		})
+		if err != nil {
+			logging.Printf("ERROR: [%s %s] Response does not match the API schema: %v", c.Request.Method, c.Request.URL.Path, err)
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+				Error: dto.ErrorDetail{
+					Code:    "INTERNAL_ERROR",
+					Message: "response does not match the API schema",
+					Details: violations("", err),
+				},
+			})
+			return
+		}
+
+		c.Writer.WriteHeader(writer.status)
+		_, _ = c.Writer.Write(writer.body.Bytes())
+	}, nil
+}
+
+func violations(field string, err error) []dto.FieldViolation {
+	switch err := err.(type) {
+	case openapi3.MultiError:
+		result := make([]dto.FieldViolation, 0, len(err))
+		for _, item := range err {
+			result = append(result, violations(field, item)...)
+		}
+		return result
+	case *openapi3filter.RequestError:
+		if err.Parameter != nil {
+			field = err.Parameter.Name
+		}
+		if stderrors.Is(err.Err, openapi3filter.ErrInvalidRequired) {
+			return []dto.FieldViolation{{Field: fieldName(field, nil), Rule: entity.RuleRequired, Message: err.Err.Error()}}
+		}
+		if err.Err == nil {
+			return []dto.FieldViolation{{Field: fieldName(field, nil), Rule: entity.RuleFormat, Message: err.Reason}}
+		}
+		return violations(field, err.Err)
+	case *openapi3filter.ResponseError:
+		if err.Err == nil {
+			return []dto.FieldViolation{{Field: fieldName(field, nil), Rule: entity.RuleFormat, Message: err.Reason}}
+		}
+		return violations(field, err.Err)
+	case *openapi3.SchemaError:
+		rule := err.SchemaField
+		if rule == "" {
+			rule = entity.RuleFormat
+		}
+		return []dto.FieldViolation{{Field: fieldName(field, err.JSONPointer()), Rule: rule, Message: err.Reason}}
+	}
+	return []dto.FieldViolation{{Field: fieldName(field, nil), Rule: entity.RuleFormat, Message: err.Error()}}
+}
+
+func fieldName(field string, pointer []string) string {
+	var b strings.Builder
+	b.WriteString(field)
+	for _, part := range pointer {
+		if part != "" && strings.Trim(part, "0123456789") == "" {
+			b.WriteString("[" + part + "]")
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString(".")
+		}
+		b.WriteString(part)
+	}
+	if b.Len() == 0 {
+		return "body"
+	}
+	return b.String()
+}
+
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	return w.body.Len() > 0
+}
@@ -0,0 +1,109 @@
+package i18n
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"strings"
+
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+type Language string
+
+const (
+	English Language = "en"
+	Russian Language = "ru"
+
+	DefaultLanguage = English
+)
+
+type Params map[string]any
+
+//go:embed locales/*.yaml
+var locales embed.FS
+
+var (
+	supported = []Language{English, Russian}
+	matcher   = language.NewMatcher([]language.Tag{language.English, language.Russian})
+	catalog   = mustLoad()
+)
+
+func mustLoad() map[Language]map[string]string {
+	result := make(map[Language]map[string]string, len(supported))
+	for _, lang := range supported {
+		data, err := locales.ReadFile(path.Join("locales", string(lang)+".yaml"))
+		if err != nil {
+			panic(fmt.Sprintf("i18n: failed to read %s catalog: %v", lang, err))
+		}
+		var tree map[string]any
+		if err := yaml.Unmarshal(data, &tree); err != nil {
+			panic(fmt.Sprintf("i18n: failed to parse %s catalog: %v", lang, err))
+		}
+		messages := make(map[string]string)
+		flatten("", tree, messages)
+		result[lang] = messages
+	}
+	return result
+}
+
+func flatten(prefix string, tree map[string]any, messages map[string]string) {
+	for name, value := range tree {
+		key := name
+		if prefix != "" {
+			key = prefix + "." + name
+		}
+		switch value := value.(type) {
+		case map[string]any:
+			flatten(key, value, messages)
+		default:
+			messages[key] = fmt.Sprint(value)
+		}
+	}
+}
+
+func Keys(lang Language) []string {
+	keys := make([]string, 0, len(catalog[lang]))
+	for key := range catalog[lang] {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func Translate(lang Language, key string, params Params) string {
+	template, ok := catalog[lang][key]
+	if !ok {
+		template, ok = catalog[DefaultLanguage][key]
+	}
+	if !ok {
+		return key
+	}
+	return render(template, params)
+}
+
+func render(template string, params Params) string {
+	if len(params) == 0 {
+		return template
+	}
+	replacements := make([]string, 0, len(params)*2)
+	for name, value := range params {
+		replacements = append(replacements, "{"+name+"}", fmt.Sprint(value))
+	}
+	return strings.NewReplacer(replacements...).Replace(template)
+}
+
+func FromAcceptLanguage(header string) Language {
+	if header == "" {
+		return DefaultLanguage
+	}
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil || len(tags) == 0 {
+		return DefaultLanguage
+	}
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return DefaultLanguage
+	}
+	return supported[index]
+}
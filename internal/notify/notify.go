@@ -0,0 +1,113 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/logging"
+	"strings"
+	"time"
+)
+
+type LogNotifier struct{}
+
+func (LogNotifier) Send(digest *entity.ReviewDigest) error {
+	logging.Printf("DIGEST: %d open reviews pending for %s", len(digest.Items), digest.UserID)
+	return nil
+}
+
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: config.WebhookTimeout},
+	}
+}
+
+func (n *WebhookNotifier) Send(digest *entity.ReviewDigest) error {
+	body, err := json.Marshal(digest)
+	if err != nil {
+		return fmt.Errorf("failed to encode digest: %w", err)
+	}
+
+	resp, err := n.client.Post(n.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to deliver digest webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("digest webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+var ErrNoEmail = errors.New("no email address configured")
+
+type SMTPNotifier struct {
+	cfg config.SMTPConfig
+}
+
+func NewSMTPNotifier(cfg config.SMTPConfig) *SMTPNotifier {
+	return &SMTPNotifier{cfg: cfg}
+}
+
+func (n *SMTPNotifier) Send(digest *entity.ReviewDigest) error {
+	if digest.Email == "" {
+		return ErrNoEmail
+	}
+
+	var auth smtp.Auth
+	if n.cfg.User != "" {
+		auth = smtp.PlainAuth("", n.cfg.User, n.cfg.Password, n.cfg.Host)
+	}
+
+	addr := net.JoinHostPort(n.cfg.Host, n.cfg.Port)
+	if err := smtp.SendMail(addr, auth, n.cfg.From, []string{digest.Email}, n.message(digest)); err != nil {
+		return fmt.Errorf("failed to send digest email: %w", err)
+	}
+	return nil
+}
+
+func (n *SMTPNotifier) message(digest *entity.ReviewDigest) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", n.cfg.From)
+	fmt.Fprintf(&b, "To: %s\r\n", digest.Email)
+	fmt.Fprintf(&b, "Subject: %d pull requests awaiting your review\r\n", len(digest.Items))
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(RenderText(digest), "\n", "\r\n"))
+	return []byte(b.String())
+}
+
+func RenderText(digest *entity.ReviewDigest) string {
+	var b strings.Builder
+	name := digest.Username
+	if name == "" {
+		name = digest.UserID
+	}
+	fmt.Fprintf(&b, "Hi %s,\n\nThe following pull requests are waiting for your review (oldest first):\n\n", name)
+	for _, item := range digest.Items {
+		age := time.Duration(item.AgeSeconds) * time.Second
+		fmt.Fprintf(&b, "- %s %q by %s, open for %s\n", item.PullRequestID, item.PullRequestName, item.AuthorID, formatAge(age))
+	}
+	return b.String()
+}
+
+func formatAge(age time.Duration) string {
+	days := int(age / (24 * time.Hour))
+	hours := int(age % (24 * time.Hour) / time.Hour)
+	if days > 0 {
+		return fmt.Sprintf("%dd %dh", days, hours)
+	}
+	return fmt.Sprintf("%dh %dm", hours, int(age%time.Hour/time.Minute))
+}
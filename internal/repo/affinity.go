@@ -0,0 +1,11 @@
+package repo
+
+import "pr-review/internal/entity"
+
+type AffinityRepository interface {
+	ListAffinities(authorID string) ([]*entity.ReviewerAffinity, error)
+
+	SaveAffinity(affinity *entity.ReviewerAffinity) error
+
+	DeleteAffinity(authorID, reviewerID string) (bool, error)
+}
@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"pr-review/internal/entity"
+	"time"
+)
+
+type AvailabilityRepository interface {
+	CreateAwayPeriod(period *entity.AwayPeriod) error
+
+	GetAwayUserIDs(at time.Time) ([]string, error)
+
+	GetPendingHandovers(at time.Time) ([]*entity.AwayPeriod, error)
+
+	CompleteHandover(periodID int64, at time.Time, reassignments []entity.ReviewerReassignment) error
+
+	GetEndedPeriods(at time.Time) ([]*entity.AwayPeriod, error)
+
+	MarkReturned(periodID int64, at time.Time) error
+}
@@ -0,0 +1,9 @@
+package repo
+
+import "pr-review/internal/entity"
+
+type BulkRepository interface {
+	Import(data *entity.BulkData) error
+
+	Export() (*entity.BulkData, error)
+}
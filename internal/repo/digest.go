@@ -0,0 +1,14 @@
+package repo
+
+import (
+	"pr-review/internal/entity"
+	"time"
+)
+
+type DigestRepository interface {
+	GetPreferences(userID string) (*entity.DigestPreferences, error)
+
+	SavePreferences(prefs *entity.DigestPreferences) error
+
+	MarkDigestSent(userID string, at time.Time) error
+}
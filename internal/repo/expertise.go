@@ -0,0 +1,14 @@
+package repo
+
+import (
+	"pr-review/internal/entity"
+	"time"
+)
+
+type ExpertiseRepository interface {
+	RecordReview(userID string, areas []string, at time.Time) error
+
+	GetScores(userIDs []string, areas []string) ([]*entity.ExpertiseScore, error)
+
+	GetUserExpertise(userID string) ([]*entity.ExpertiseScore, error)
+}
@@ -0,0 +1,9 @@
+package repo
+
+import "pr-review/internal/entity"
+
+type OrganizationRepository interface {
+	GetOrganization(orgID string) (*entity.Organization, error)
+	GetOrganizationByToken(tokenHash string) (*entity.Organization, error)
+	ListOrganizations() ([]*entity.Organization, error)
+}
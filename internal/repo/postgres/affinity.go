@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"pr-review/internal/entity"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+
+	"github.com/Masterminds/squirrel"
+)
+
+var _ repo.AffinityRepository = (*AffinityRepository)(nil)
+
+type AffinityRepository struct {
+	db    DB
+	sb    squirrel.StatementBuilderType
+	ctx   context.Context
+	orgID string
+}
+
+func NewAffinityRepository(db DB, orgID string) *AffinityRepository {
+	return &AffinityRepository{
+		db:    db,
+		sb:    squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		ctx:   context.Background(),
+		orgID: orgID,
+	}
+}
+
+func (r *AffinityRepository) ListAffinities(authorID string) ([]*entity.ReviewerAffinity, error) {
+	query := r.sb.Select("author_id", "reviewer_id", "weight", "blocked", "COALESCE(reason, '')").
+		From("reviewer_affinities").
+		Where(squirrel.Eq{"org_id": r.orgID, "author_id": authorID}).
+		OrderBy("reviewer_id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for ListAffinities: %v", err)
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute ListAffinities query for author %s: %v", authorID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	affinities := make([]*entity.ReviewerAffinity, 0)
+	for rows.Next() {
+		var affinity entity.ReviewerAffinity
+		if err := rows.Scan(&affinity.AuthorID, &affinity.ReviewerID, &affinity.Weight, &affinity.Blocked, &affinity.Reason); err != nil {
+			return nil, err
+		}
+		affinities = append(affinities, &affinity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return affinities, nil
+}
+
+func (r *AffinityRepository) SaveAffinity(affinity *entity.ReviewerAffinity) error {
+	if affinity == nil {
+		return errors.New("affinity cannot be nil")
+	}
+
+	query := r.sb.Insert("reviewer_affinities").
+		Columns("org_id", "author_id", "reviewer_id", "weight", "blocked", "reason").
+		Values(r.orgID, affinity.AuthorID, affinity.ReviewerID, affinity.Weight, affinity.Blocked, affinity.Reason).
+		Suffix("ON CONFLICT (org_id, author_id, reviewer_id) DO UPDATE SET weight = EXCLUDED.weight, blocked = EXCLUDED.blocked, reason = EXCLUDED.reason")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for SaveAffinity: %v", err)
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute SaveAffinity query for %s -> %s: %v", affinity.AuthorID, affinity.ReviewerID, err)
+		return err
+	}
+	return nil
+}
+
+func (r *AffinityRepository) DeleteAffinity(authorID, reviewerID string) (bool, error) {
+	query := r.sb.Delete("reviewer_affinities").
+		Where(squirrel.Eq{"org_id": r.orgID, "author_id": authorID, "reviewer_id": reviewerID})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for DeleteAffinity: %v", err)
+		return false, err
+	}
+
+	tag, err := r.db.Exec(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute DeleteAffinity query for %s -> %s: %v", authorID, reviewerID, err)
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"pr-review/internal/entity"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ repo.AvailabilityRepository = (*AvailabilityRepository)(nil)
+
+type AvailabilityRepository struct {
+	db    DB
+	sb    squirrel.StatementBuilderType
+	ctx   context.Context
+	orgID string
+}
+
+func NewAvailabilityRepository(db DB, orgID string) *AvailabilityRepository {
+	return &AvailabilityRepository{
+		db:    db,
+		sb:    squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		ctx:   context.Background(),
+		orgID: orgID,
+	}
+}
+
+func (r *AvailabilityRepository) CreateAwayPeriod(period *entity.AwayPeriod) error {
+	if period == nil {
+		return errors.New("away period cannot be nil")
+	}
+	if !period.Until.After(period.From) {
+		return errors.New("until must be after from")
+	}
+
+	query := r.sb.Insert("user_availability").
+		Columns("org_id", "user_id", "away_from", "away_until", "reason", "handover").
+		Values(r.orgID, period.UserID, period.From, period.Until, period.Reason, period.Handover).
+		Suffix("RETURNING id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for CreateAwayPeriod: %v", err)
+		return err
+	}
+
+	if err := r.db.QueryRow(r.ctx, sql, args...).Scan(&period.ID); err != nil {
+		logging.Printf("ERROR: Failed to execute CreateAwayPeriod query for user %s: %v", period.UserID, err)
+		return err
+	}
+	return nil
+}
+
+func (r *AvailabilityRepository) GetAwayUserIDs(at time.Time) ([]string, error) {
+	query := r.sb.Select("DISTINCT user_id").
+		From("user_availability").
+		Where(squirrel.Eq{"org_id": r.orgID}).
+		Where(squirrel.LtOrEq{"away_from": at}).
+		Where(squirrel.Gt{"away_until": at})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for GetAwayUserIDs: %v", err)
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute GetAwayUserIDs query: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	userIDs := make([]string, 0)
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func (r *AvailabilityRepository) GetPendingHandovers(at time.Time) ([]*entity.AwayPeriod, error) {
+	return r.queryPeriods(r.selectPeriods().
+		Where(squirrel.Eq{"handover": true, "handed_over_at": nil}).
+		Where(squirrel.LtOrEq{"away_from": at}).
+		Where(squirrel.Gt{"away_until": at}))
+}
+
+func (r *AvailabilityRepository) CompleteHandover(periodID int64, at time.Time, reassignments []entity.ReviewerReassignment) error {
+	return runInTransaction(r.ctx, r.db, func(tx pgx.Tx) error {
+		for _, reassignment := range reassignments {
+			if err := replaceReviewer(r.ctx, tx, r.sb, r.orgID, reassignment); err != nil {
+				logging.Printf("ERROR: Failed to reassign PR %s from %s to %s: %v",
+					reassignment.PullRequestID, reassignment.OldReviewerID, reassignment.NewReviewerID, err)
+				return err
+			}
+		}
+
+		query := r.sb.Update("user_availability").
+			Set("handed_over_at", at).
+			Where(squirrel.Eq{"org_id": r.orgID, "id": periodID})
+
+		sql, args, err := query.ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(r.ctx, sql, args...)
+		return err
+	}, "CompleteHandover")
+}
+
+func (r *AvailabilityRepository) GetEndedPeriods(at time.Time) ([]*entity.AwayPeriod, error) {
+	return r.queryPeriods(r.selectPeriods().
+		Where(squirrel.Eq{"returned_at": nil}).
+		Where(squirrel.LtOrEq{"away_until": at}))
+}
+
+func (r *AvailabilityRepository) MarkReturned(periodID int64, at time.Time) error {
+	query := r.sb.Update("user_availability").
+		Set("returned_at", at).
+		Where(squirrel.Eq{"org_id": r.orgID, "id": periodID})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for MarkReturned: %v", err)
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute MarkReturned query for period %d: %v", periodID, err)
+		return err
+	}
+	return nil
+}
+
+func (r *AvailabilityRepository) selectPeriods() squirrel.SelectBuilder {
+	return r.sb.Select(
+		"id",
+		"user_id",
+		"away_from",
+		"away_until",
+		"COALESCE(reason, '')",
+		"handover",
+		"handed_over_at",
+		"returned_at",
+	).
+		From("user_availability").
+		Where(squirrel.Eq{"org_id": r.orgID}).
+		OrderBy("away_from")
+}
+
+func (r *AvailabilityRepository) queryPeriods(query squirrel.SelectBuilder) ([]*entity.AwayPeriod, error) {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	periods := make([]*entity.AwayPeriod, 0)
+	for rows.Next() {
+		var period entity.AwayPeriod
+		if err := rows.Scan(
+			&period.ID,
+			&period.UserID,
+			&period.From,
+			&period.Until,
+			&period.Reason,
+			&period.Handover,
+			&period.HandedOverAt,
+			&period.ReturnedAt,
+		); err != nil {
+			logging.Printf("ERROR: Failed to scan away period row: %v", err)
+			return nil, err
+		}
+		periods = append(periods, &period)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return periods, nil
+}
@@ -0,0 +1,287 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ repo.BulkRepository = (*BulkRepository)(nil)
+
+type BulkRepository struct {
+	db    DB
+	sb    squirrel.StatementBuilderType
+	ctx   context.Context
+	orgID string
+}
+
+func NewBulkRepository(db DB, orgID string) *BulkRepository {
+	return &BulkRepository{
+		db:    db,
+		sb:    squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		ctx:   context.Background(),
+		orgID: orgID,
+	}
+}
+
+func (r *BulkRepository) Import(data *entity.BulkData) error {
+	if data == nil {
+		return errors.New("import data cannot be nil")
+	}
+
+	statements := make([]squirrel.Sqlizer, 0, 4*len(data.Teams)+len(data.Users)+2*len(data.PullRequests))
+	for _, team := range data.Teams {
+		statements = append(statements, r.sb.Insert("teams").
+			Columns("org_id", "team_name").
+			Values(r.orgID, team.Name))
+	}
+	for i := range data.Users {
+		statements = append(statements, upsertUserQuery(r.sb, r.orgID, &data.Users[i]))
+	}
+	for i := range data.Teams {
+		team := &data.Teams[i]
+		policy, err := savePolicyQuery(r.sb, r.orgID, team.Policy())
+		if err != nil {
+			return err
+		}
+		statements = append(statements, policy)
+
+		if len(team.FallbackTeams) > 0 {
+			fallbacks := r.sb.Insert("team_fallbacks").Columns("org_id", "team_name", "fallback_team_name", "position")
+			for position, fallback := range team.FallbackTeams {
+				fallbacks = fallbacks.Values(r.orgID, team.Name, fallback, position)
+			}
+			statements = append(statements, fallbacks)
+		}
+		if len(team.SharedReviewers) > 0 {
+			shared := r.sb.Insert("team_shared_reviewers").Columns("org_id", "team_name", "user_id")
+			for _, userID := range team.SharedReviewers {
+				shared = shared.Values(r.orgID, team.Name, userID)
+			}
+			statements = append(statements, shared)
+		}
+	}
+	for _, pr := range data.PullRequests {
+		statements = append(statements, r.sb.Insert("pull_requests").
+			Columns("org_id", "pull_request_id", "pull_request_name", "author_id", "status", "created_at", "merged_at").
+			Values(r.orgID, pr.ID, pr.Name, pr.AuthorID, string(pr.Status), pr.CreatedAt, pr.MergedAt))
+
+		if len(pr.AssignedReviewers) > 0 {
+			reviewers := r.sb.Insert("assigned_reviewers").
+				Columns("org_id", "pull_request_id", "reviewer_id")
+			for _, reviewer := range pr.AssignedReviewers {
+				reviewers = reviewers.Values(r.orgID, pr.ID, reviewer)
+			}
+			statements = append(statements, reviewers)
+		}
+	}
+
+	return runInTransaction(r.ctx, r.db, func(tx pgx.Tx) error {
+		for _, statement := range statements {
+			sql, args, err := statement.ToSql()
+			if err != nil {
+				logging.Printf("ERROR: Failed to build SQL query for Import: %v", err)
+				return err
+			}
+			if _, err := tx.Exec(r.ctx, sql, args...); err != nil {
+				logging.Printf("ERROR: Failed to execute Import query: %v", err)
+				return err
+			}
+		}
+		return nil
+	}, "Import")
+}
+
+func (r *BulkRepository) Export() (*entity.BulkData, error) {
+	data := &entity.BulkData{
+		Teams:        make([]entity.BulkTeam, 0),
+		Users:        make([]entity.User, 0),
+		PullRequests: make([]entity.BulkPullRequest, 0),
+	}
+
+	teamIndex := make(map[string]int)
+	teams := r.sb.Select(
+		"t.team_name",
+		"tp.required_reviewers",
+		"COALESCE(tp.selection_strategy, 'random')",
+		"COALESCE(tp.allow_self_merge, true)",
+		"COALESCE(tp.required_approvals, 0)",
+		"COALESCE(tp.reassign_on_deactivation, false)",
+		"COALESCE(tp.composition_rules, '[]')",
+		"COALESCE(tp.security_team, '')",
+		"COALESCE(tp.max_open_reviews, 0)",
+		"COALESCE(tp.hierarchy_fallback, false)",
+	).
+		From("teams t").
+		LeftJoin("team_policies tp ON tp.org_id = t.org_id AND tp.team_name = t.team_name").
+		Where(squirrel.Eq{"t.org_id": r.orgID}).
+		OrderBy("t.team_name")
+	err := r.queryRows(teams, "teams", func(rows pgx.Rows) error {
+		var team entity.BulkTeam
+		var strategy string
+		var allowSelfMerge bool
+		var rules []byte
+		if err := rows.Scan(
+			&team.Name,
+			&team.RequiredReviewers,
+			&strategy,
+			&allowSelfMerge,
+			&team.RequiredApprovals,
+			&team.ReassignOnDeactivation,
+			&rules,
+			&team.SecurityTeam,
+			&team.MaxOpenReviews,
+			&team.HierarchyFallback,
+		); err != nil {
+			return err
+		}
+		if team.RequiredReviewers == nil {
+			required := config.DefaultReviewers
+			team.RequiredReviewers = &required
+		}
+		if err := json.Unmarshal(rules, &team.CompositionRules); err != nil {
+			return err
+		}
+		team.SelectionStrategy = entity.SelectionStrategy(strategy)
+		team.AllowSelfMerge = &allowSelfMerge
+		team.FallbackTeams = make([]string, 0)
+		team.SharedReviewers = make([]string, 0)
+		teamIndex[team.Name] = len(data.Teams)
+		data.Teams = append(data.Teams, team)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fallbacks := r.sb.Select("team_name", "fallback_team_name").
+		From("team_fallbacks").
+		Where(squirrel.Eq{"org_id": r.orgID}).
+		OrderBy("team_name", "position")
+	err = r.queryRows(fallbacks, "fallback teams", func(rows pgx.Rows) error {
+		var teamName, fallback string
+		if err := rows.Scan(&teamName, &fallback); err != nil {
+			return err
+		}
+		if i, ok := teamIndex[teamName]; ok {
+			data.Teams[i].FallbackTeams = append(data.Teams[i].FallbackTeams, fallback)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	shared := r.sb.Select("sr.team_name", "sr.user_id").
+		From("team_shared_reviewers sr").
+		Join("users u ON u.org_id = sr.org_id AND u.user_id = sr.user_id").
+		Where(squirrel.Eq{"sr.org_id": r.orgID, "u.deleted_at": nil}).
+		OrderBy("sr.team_name", "sr.user_id")
+	err = r.queryRows(shared, "shared reviewers", func(rows pgx.Rows) error {
+		var teamName, userID string
+		if err := rows.Scan(&teamName, &userID); err != nil {
+			return err
+		}
+		if i, ok := teamIndex[teamName]; ok {
+			data.Teams[i].SharedReviewers = append(data.Teams[i].SharedReviewers, userID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	users := r.sb.Select("user_id", "username", "team_name", "is_active", "grade").
+		From("users").
+		Where(squirrel.Eq{"org_id": r.orgID, "deleted_at": nil}).
+		OrderBy("user_id")
+	err = r.queryRows(users, "users", func(rows pgx.Rows) error {
+		var user entity.User
+		if err := rows.Scan(&user.ID, &user.Name, &user.Team, &user.IsActive, &user.Grade); err != nil {
+			return err
+		}
+		data.Users = append(data.Users, user)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prIndex := make(map[string]int)
+	prs := r.sb.Select("pr.pull_request_id", "pr.pull_request_name", "pr.author_id", "pr.status", "pr.created_at", "pr.merged_at").
+		From("pull_requests pr").
+		Join("users u ON u.org_id = pr.org_id AND u.user_id = pr.author_id").
+		Where(squirrel.Eq{"pr.org_id": r.orgID, "u.deleted_at": nil}).
+		OrderBy("pr.pull_request_id")
+	err = r.queryRows(prs, "pull requests", func(rows pgx.Rows) error {
+		var pr entity.BulkPullRequest
+		var status string
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &status, &pr.CreatedAt, &pr.MergedAt); err != nil {
+			return err
+		}
+		pr.Status = entity.Status(status)
+		pr.AssignedReviewers = make([]string, 0)
+		prIndex[pr.ID] = len(data.PullRequests)
+		data.PullRequests = append(data.PullRequests, pr)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reviewers := r.sb.Select("ar.pull_request_id", "ar.reviewer_id").
+		From("assigned_reviewers ar").
+		Join("users u ON u.org_id = ar.org_id AND u.user_id = ar.reviewer_id").
+		Where(squirrel.Eq{"ar.org_id": r.orgID, "u.deleted_at": nil}).
+		OrderBy("ar.pull_request_id", "ar.assigned_at")
+	err = r.queryRows(reviewers, "assigned reviewers", func(rows pgx.Rows) error {
+		var prID, reviewerID string
+		if err := rows.Scan(&prID, &reviewerID); err != nil {
+			return err
+		}
+		if i, ok := prIndex[prID]; ok {
+			data.PullRequests[i].AssignedReviewers = append(data.PullRequests[i].AssignedReviewers, reviewerID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (r *BulkRepository) queryRows(query squirrel.SelectBuilder, name string, scan func(pgx.Rows) error) error {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for exporting %s: %v", name, err)
+		return err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to export %s: %v", name, err)
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			logging.Printf("ERROR: Failed to scan exported %s row: %v", name, err)
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.Printf("ERROR: Error iterating exported %s: %v", name, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"pr-review/internal/entity"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ repo.DigestRepository = (*DigestRepository)(nil)
+
+type DigestRepository struct {
+	db    DB
+	sb    squirrel.StatementBuilderType
+	ctx   context.Context
+	orgID string
+}
+
+func NewDigestRepository(db DB, orgID string) *DigestRepository {
+	return &DigestRepository{
+		db:    db,
+		sb:    squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		ctx:   context.Background(),
+		orgID: orgID,
+	}
+}
+
+func (r *DigestRepository) GetPreferences(userID string) (*entity.DigestPreferences, error) {
+	query := r.sb.Select("user_id", "enabled", "frequency", "COALESCE(email, '')", "last_sent_at").
+		From("digest_preferences").
+		Where(squirrel.Eq{"org_id": r.orgID, "user_id": userID})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for GetPreferences: %v", err)
+		return nil, err
+	}
+
+	var prefs entity.DigestPreferences
+	var frequency string
+	err = r.db.QueryRow(r.ctx, sql, args...).Scan(
+		&prefs.UserID,
+		&prefs.Enabled,
+		&frequency,
+		&prefs.Email,
+		&prefs.LastSentAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		logging.Printf("ERROR: Failed to execute GetPreferences query for user %s: %v", userID, err)
+		return nil, err
+	}
+	prefs.Frequency = entity.DigestFrequency(frequency)
+
+	return &prefs, nil
+}
+
+func (r *DigestRepository) SavePreferences(prefs *entity.DigestPreferences) error {
+	if prefs == nil {
+		return errors.New("digest preferences cannot be nil")
+	}
+
+	query := r.sb.Insert("digest_preferences").
+		Columns("org_id", "user_id", "enabled", "frequency", "email").
+		Values(r.orgID, prefs.UserID, prefs.Enabled, string(prefs.Frequency), prefs.Email).
+		Suffix("ON CONFLICT (org_id, user_id) DO UPDATE SET enabled = EXCLUDED.enabled, frequency = EXCLUDED.frequency, email = EXCLUDED.email")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for SavePreferences: %v", err)
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute SavePreferences query for user %s: %v", prefs.UserID, err)
+		return err
+	}
+	return nil
+}
+
+func (r *DigestRepository) MarkDigestSent(userID string, at time.Time) error {
+	query := r.sb.Insert("digest_preferences").
+		Columns("org_id", "user_id", "last_sent_at").
+		Values(r.orgID, userID, at).
+		Suffix("ON CONFLICT (org_id, user_id) DO UPDATE SET last_sent_at = EXCLUDED.last_sent_at")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for MarkDigestSent: %v", err)
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute MarkDigestSent query for user %s: %v", userID, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+var _ repo.ExpertiseRepository = (*ExpertiseRepository)(nil)
+
+type ExpertiseRepository struct {
+	db    DB
+	sb    squirrel.StatementBuilderType
+	ctx   context.Context
+	orgID string
+}
+
+func NewExpertiseRepository(db DB, orgID string) *ExpertiseRepository {
+	return &ExpertiseRepository{
+		db:    db,
+		sb:    squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		ctx:   context.Background(),
+		orgID: orgID,
+	}
+}
+
+func (r *ExpertiseRepository) RecordReview(userID string, areas []string, at time.Time) error {
+	if len(areas) == 0 {
+		return nil
+	}
+
+	query := r.sb.Insert("reviewer_expertise").
+		Columns("org_id", "user_id", "area", "score", "updated_at").
+		Suffix("ON CONFLICT (org_id, user_id, area) DO UPDATE SET score = reviewer_expertise.score * power(0.5, GREATEST(EXTRACT(EPOCH FROM EXCLUDED.updated_at - reviewer_expertise.updated_at), 0) / ?) + EXCLUDED.score, updated_at = GREATEST(EXCLUDED.updated_at, reviewer_expertise.updated_at)", config.ExpertiseHalfLife.Seconds())
+
+	for _, area := range areas {
+		query = query.Values(r.orgID, userID, area, 1.0, at)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for RecordReview: %v", err)
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute RecordReview query for user %s: %v", userID, err)
+		return err
+	}
+	return nil
+}
+
+func (r *ExpertiseRepository) GetScores(userIDs []string, areas []string) ([]*entity.ExpertiseScore, error) {
+	if len(userIDs) == 0 || len(areas) == 0 {
+		return []*entity.ExpertiseScore{}, nil
+	}
+
+	query := r.sb.Select("user_id", "area", "score", "updated_at").
+		From("reviewer_expertise").
+		Where(squirrel.Eq{"org_id": r.orgID, "user_id": userIDs, "area": areas})
+
+	return r.queryScores(query, "GetScores")
+}
+
+func (r *ExpertiseRepository) GetUserExpertise(userID string) ([]*entity.ExpertiseScore, error) {
+	query := r.sb.Select("user_id", "area", "score", "updated_at").
+		From("reviewer_expertise").
+		Where(squirrel.Eq{"org_id": r.orgID, "user_id": userID}).
+		OrderBy("area")
+
+	return r.queryScores(query, "GetUserExpertise")
+}
+
+func (r *ExpertiseRepository) queryScores(query squirrel.SelectBuilder, operationName string) ([]*entity.ExpertiseScore, error) {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for %s: %v", operationName, err)
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute %s query: %v", operationName, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	scores := make([]*entity.ExpertiseScore, 0)
+	for rows.Next() {
+		var score entity.ExpertiseScore
+		if err := rows.Scan(&score.UserID, &score.Area, &score.Score, &score.UpdatedAt); err != nil {
+			return nil, err
+		}
+		scores = append(scores, &score)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return scores, nil
+}
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ repo.OrganizationRepository = (*OrganizationRepository)(nil)
+
+type OrganizationRepository struct {
+	db  DB
+	sb  squirrel.StatementBuilderType
+	ctx context.Context
+}
+
+func NewOrganizationRepository(db DB) *OrganizationRepository {
+	return &OrganizationRepository{
+		db:  db,
+		sb:  squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		ctx: context.Background(),
+	}
+}
+
+func (r *OrganizationRepository) GetOrganization(orgID string) (*entity.Organization, error) {
+	if orgID == "" {
+		return nil, errors.New("organization_id cannot be empty")
+	}
+	if len(orgID) > config.MaxStringLength {
+		return nil, errors.New("organization_id cannot exceed 255 characters")
+	}
+
+	query := r.sb.Select("org_id", "name").
+		From("organizations").
+		Where(squirrel.Eq{"org_id": orgID})
+
+	return r.queryOrganization(query, "GetOrganization")
+}
+
+func (r *OrganizationRepository) GetOrganizationByToken(tokenHash string) (*entity.Organization, error) {
+	if tokenHash == "" {
+		return nil, errors.New("token cannot be empty")
+	}
+
+	query := r.sb.Select("o.org_id", "o.name").
+		From("organization_tokens t").
+		Join("organizations o ON o.org_id = t.org_id").
+		Where(squirrel.Eq{"t.token_hash": tokenHash, "t.revoked_at": nil})
+
+	return r.queryOrganization(query, "GetOrganizationByToken")
+}
+
+func (r *OrganizationRepository) ListOrganizations() ([]*entity.Organization, error) {
+	query := r.sb.Select("org_id", "name").
+		From("organizations").
+		OrderBy("org_id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for ListOrganizations: %v", err)
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute ListOrganizations query: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	organizations := make([]*entity.Organization, 0)
+	for rows.Next() {
+		var organization entity.Organization
+		if err := rows.Scan(&organization.ID, &organization.Name); err != nil {
+			return nil, err
+		}
+		organizations = append(organizations, &organization)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return organizations, nil
+}
+
+func (r *OrganizationRepository) queryOrganization(query squirrel.SelectBuilder, operationName string) (*entity.Organization, error) {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for %s: %v", operationName, err)
+		return nil, err
+	}
+
+	var organization entity.Organization
+	if err := r.db.QueryRow(r.ctx, sql, args...).Scan(&organization.ID, &organization.Name); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		logging.Printf("ERROR: Failed to execute %s query: %v", operationName, err)
+		return nil, err
+	}
+	return &organization, nil
+}
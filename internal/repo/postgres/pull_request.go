@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"pr-review/internal/config"
 	"pr-review/internal/entity"
 	"pr-review/internal/logging"
 	"pr-review/internal/repo"
@@ -12,91 +11,44 @@ import (
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var _ repo.PullRequestRepository = (*PullRequestRepository)(nil)
 
 type PullRequestRepository struct {
-	db  *pgxpool.Pool
-	sb  squirrel.StatementBuilderType
-	ctx context.Context
+	db    DB
+	sb    squirrel.StatementBuilderType
+	ctx   context.Context
+	orgID string
 }
 
-func NewPullRequestRepository(db *pgxpool.Pool) *PullRequestRepository {
+func NewPullRequestRepository(db DB, orgID string) *PullRequestRepository {
 	return &PullRequestRepository{
-		db:  db,
-		sb:  squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
-		ctx: context.Background(),
+		db:    db,
+		sb:    squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		ctx:   context.Background(),
+		orgID: orgID,
 	}
 }
 
-func (r *PullRequestRepository) validatePR(pr *entity.PullRequest) error {
-	if pr == nil {
-		return errors.New("pull request cannot be nil")
-	}
-	if pr.ID == "" {
-		return errors.New("pull_request_id cannot be empty")
-	}
-	if len(pr.ID) > config.MaxStringLength {
-		return errors.New("pull_request_id cannot exceed 255 characters")
-	}
-	if pr.Name == "" {
-		return errors.New("pull_request_name cannot be empty")
-	}
-	if len(pr.Name) > config.MaxStringLength {
-		return errors.New("pull_request_name cannot exceed 255 characters")
-	}
-	if pr.AuthorID == "" {
-		return errors.New("author_id cannot be empty")
-	}
-	if len(pr.AuthorID) > config.MaxStringLength {
-		return errors.New("author_id cannot exceed 255 characters")
-	}
-	if pr.Status != entity.StatusOpen && pr.Status != entity.StatusMerged {
-		return fmt.Errorf("invalid status: %s", pr.Status)
-	}
-	return nil
-}
-
-func (r *PullRequestRepository) validatePRID(prID string) error {
-	if prID == "" {
-		return errors.New("pull_request_id cannot be empty")
-	}
-	if len(prID) > config.MaxStringLength {
-		return errors.New("pull_request_id cannot exceed 255 characters")
-	}
-	return nil
-}
-
 func (r *PullRequestRepository) executeInTransaction(operation func(tx pgx.Tx) error, operationName string) error {
-	tx, err := r.db.Begin(r.ctx)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		if err := tx.Rollback(r.ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
-			logging.Printf("ERROR: failed to rollback transaction in %s: %v", operationName, err)
-		}
-	}()
-
-	if err := operation(tx); err != nil {
-		return err
-	}
-
-	return tx.Commit(r.ctx)
+	return runInTransaction(r.ctx, r.db, operation, operationName)
 }
 
 func (r *PullRequestRepository) insertPRData(tx pgx.Tx, pr *entity.PullRequest) error {
 	query := r.sb.Insert("pull_requests").
-		Columns("pull_request_id", "pull_request_name", "author_id", "status", "created_at", "merged_at").
+		Columns("org_id", "pull_request_id", "pull_request_name", "author_id", "status", "created_at", "merged_at", "repository_name", "priority", "description").
 		Values(
+			r.orgID,
 			pr.ID,
 			pr.Name,
 			pr.AuthorID,
 			string(pr.Status),
 			pr.CreatedAt,
 			pr.MergedAt,
+			nullableString(pr.Repository),
+			string(pr.Priority.OrDefault()),
+			pr.Description,
 		)
 
 	sql, args, err := query.ToSql()
@@ -108,27 +60,34 @@ func (r *PullRequestRepository) insertPRData(tx pgx.Tx, pr *entity.PullRequest)
 	return err
 }
 
-func (r *PullRequestRepository) updatePRData(tx pgx.Tx, pr *entity.PullRequest) error {
+func (r *PullRequestRepository) updatePRData(tx pgx.Tx, pr *entity.PullRequest) (bool, error) {
 	query := r.sb.Update("pull_requests").
 		Set("pull_request_name", pr.Name).
 		Set("author_id", pr.AuthorID).
 		Set("status", string(pr.Status)).
 		Set("created_at", pr.CreatedAt).
 		Set("merged_at", pr.MergedAt).
-		Where(squirrel.Eq{"pull_request_id": pr.ID})
+		Set("version", squirrel.Expr("version + 1")).
+		Where(squirrel.Eq{"org_id": r.orgID, "pull_request_id": pr.ID, "version": pr.Version}).
+		Suffix("RETURNING version")
 
 	sql, args, err := query.ToSql()
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	_, err = tx.Exec(r.ctx, sql, args...)
-	return err
+	if err := tx.QueryRow(r.ctx, sql, args...).Scan(&pr.Version); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
 }
 
 func (r *PullRequestRepository) CreatePR(pr *entity.PullRequest) error {
-	if err := r.validatePR(pr); err != nil {
-		return err
+	if pr == nil {
+		return errors.New("pull request cannot be nil")
 	}
 
 	return r.executeInTransaction(func(tx pgx.Tx) error {
@@ -136,6 +95,14 @@ func (r *PullRequestRepository) CreatePR(pr *entity.PullRequest) error {
 			return err
 		}
 
+		if err := r.insertPRValues(tx, "pull_request_files", "path", pr.ID, pr.ChangedFiles); err != nil {
+			return err
+		}
+
+		if err := r.insertPRValues(tx, "pull_request_labels", "label", pr.ID, pr.Labels); err != nil {
+			return err
+		}
+
 		if len(pr.AssignedReviewers) > 0 {
 			return r.insertReviewers(tx, pr.ID, pr.AssignedReviewers)
 		}
@@ -144,10 +111,6 @@ func (r *PullRequestRepository) CreatePR(pr *entity.PullRequest) error {
 }
 
 func (r *PullRequestRepository) GetPR(prID string) (*entity.PullRequest, error) {
-	if err := r.validatePRID(prID); err != nil {
-		return nil, err
-	}
-
 	query := r.sb.Select(
 		"pull_request_id",
 		"pull_request_name",
@@ -155,9 +118,13 @@ func (r *PullRequestRepository) GetPR(prID string) (*entity.PullRequest, error)
 		"status",
 		"created_at",
 		"merged_at",
+		"COALESCE(repository_name, '')",
+		"priority",
+		"description",
+		"version",
 	).
 		From("pull_requests").
-		Where(squirrel.Eq{"pull_request_id": prID})
+		Where(squirrel.Eq{"org_id": r.orgID, "pull_request_id": prID})
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -165,7 +132,7 @@ func (r *PullRequestRepository) GetPR(prID string) (*entity.PullRequest, error)
 	}
 
 	var pr entity.PullRequest
-	var statusStr string
+	var statusStr, priority string
 	var createdAt, mergedAt *time.Time
 
 	err = r.db.QueryRow(r.ctx, sql, args...).Scan(
@@ -175,6 +142,10 @@ func (r *PullRequestRepository) GetPR(prID string) (*entity.PullRequest, error)
 		&statusStr,
 		&createdAt,
 		&mergedAt,
+		&pr.Repository,
+		&priority,
+		&pr.Description,
+		&pr.Version,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -184,6 +155,7 @@ func (r *PullRequestRepository) GetPR(prID string) (*entity.PullRequest, error)
 	}
 
 	pr.Status = entity.Status(statusStr)
+	pr.Priority = entity.Priority(priority)
 	pr.CreatedAt = createdAt
 	pr.MergedAt = mergedAt
 
@@ -193,38 +165,110 @@ func (r *PullRequestRepository) GetPR(prID string) (*entity.PullRequest, error)
 	}
 	pr.AssignedReviewers = reviewers
 
+	files, err := r.getPRValues("pull_request_files", "path", prID)
+	if err != nil {
+		return nil, err
+	}
+	pr.ChangedFiles = files
+
+	labels, err := r.getPRValues("pull_request_labels", "label", prID)
+	if err != nil {
+		return nil, err
+	}
+	pr.Labels = labels
+
 	return &pr, nil
 }
 
-func (r *PullRequestRepository) UpdatePR(pr *entity.PullRequest) error {
-	if err := r.validatePR(pr); err != nil {
-		return err
+func (r *PullRequestRepository) UpdatePR(pr *entity.PullRequest) (bool, error) {
+	if pr == nil {
+		return false, errors.New("pull request cannot be nil")
 	}
 
-	return r.executeInTransaction(func(tx pgx.Tx) error {
-		if err := r.updatePRData(tx, pr); err != nil {
+	updated := false
+	err := r.executeInTransaction(func(tx pgx.Tx) error {
+		ok, err := r.updatePRData(tx, pr)
+		if err != nil || !ok {
 			return err
 		}
 
-		if _, err := tx.Exec(r.ctx, "DELETE FROM assigned_reviewers WHERE pull_request_id = $1", pr.ID); err != nil {
+		if err := r.deleteUnassignedReviewers(tx, pr.ID, pr.AssignedReviewers); err != nil {
 			return err
 		}
 
 		if len(pr.AssignedReviewers) > 0 {
-			return r.insertReviewers(tx, pr.ID, pr.AssignedReviewers)
+			if err := r.insertReviewers(tx, pr.ID, pr.AssignedReviewers); err != nil {
+				return err
+			}
 		}
+
+		updated = true
 		return nil
 	}, "UpdatePR")
+	if err != nil {
+		return false, err
+	}
+	return updated, nil
 }
 
-func (r *PullRequestRepository) PRExists(prID string) (bool, error) {
-	if err := r.validatePRID(prID); err != nil {
+func (r *PullRequestRepository) UpdatePRMetadata(pr *entity.PullRequest, version int) (bool, error) {
+	if pr == nil {
+		return false, errors.New("pull request cannot be nil")
+	}
+
+	updated := false
+	err := r.executeInTransaction(func(tx pgx.Tx) error {
+		sql, args, err := r.sb.Update("pull_requests").
+			Set("pull_request_name", pr.Name).
+			Set("author_id", pr.AuthorID).
+			Set("description", pr.Description).
+			Set("version", squirrel.Expr("version + 1")).
+			Where(squirrel.Eq{"org_id": r.orgID, "pull_request_id": pr.ID, "version": version}).
+			Suffix("RETURNING version").
+			ToSql()
+		if err != nil {
+			return err
+		}
+		if err := tx.QueryRow(r.ctx, sql, args...).Scan(&pr.Version); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+
+		labelsSQL, labelsArgs, err := r.sb.Delete("pull_request_labels").
+			Where(squirrel.Eq{"org_id": r.orgID, "pull_request_id": pr.ID}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(r.ctx, labelsSQL, labelsArgs...); err != nil {
+			return err
+		}
+		if err := r.insertPRValues(tx, "pull_request_labels", "label", pr.ID, pr.Labels); err != nil {
+			return err
+		}
+
+		if err := r.deleteUnassignedReviewers(tx, pr.ID, pr.AssignedReviewers); err != nil {
+			return err
+		}
+		if err := r.insertReviewers(tx, pr.ID, pr.AssignedReviewers); err != nil {
+			return err
+		}
+
+		updated = true
+		return nil
+	}, "UpdatePRMetadata")
+	if err != nil {
 		return false, err
 	}
+	return updated, nil
+}
 
+func (r *PullRequestRepository) PRExists(prID string) (bool, error) {
 	query := r.sb.Select("COUNT(*)").
 		From("pull_requests").
-		Where(squirrel.Eq{"pull_request_id": prID})
+		Where(squirrel.Eq{"org_id": r.orgID, "pull_request_id": prID})
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -247,7 +291,7 @@ func (r *PullRequestRepository) PRExists(prID string) (bool, error) {
 
 func (r *PullRequestRepository) scanPR(scanner interface{ Scan(...interface{}) error }) (*entity.PullRequest, error) {
 	var pr entity.PullRequest
-	var statusStr string
+	var statusStr, priority string
 	var createdAt, mergedAt *time.Time
 
 	if err := scanner.Scan(
@@ -257,6 +301,10 @@ func (r *PullRequestRepository) scanPR(scanner interface{ Scan(...interface{}) e
 		&statusStr,
 		&createdAt,
 		&mergedAt,
+		&pr.Repository,
+		&priority,
+		&pr.Description,
+		&pr.Version,
 	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
@@ -265,6 +313,7 @@ func (r *PullRequestRepository) scanPR(scanner interface{ Scan(...interface{}) e
 	}
 
 	pr.Status = entity.Status(statusStr)
+	pr.Priority = entity.Priority(priority)
 	pr.CreatedAt = createdAt
 	pr.MergedAt = mergedAt
 
@@ -274,24 +323,16 @@ func (r *PullRequestRepository) scanPR(scanner interface{ Scan(...interface{}) e
 	}
 	pr.AssignedReviewers = reviewers
 
-	return &pr, nil
-}
-
-func (r *PullRequestRepository) validateUserID(userID string) error {
-	if userID == "" {
-		return errors.New("user_id cannot be empty")
-	}
-	if len(userID) > config.MaxStringLength {
-		return errors.New("user_id cannot exceed 255 characters")
+	labels, err := r.getPRValues("pull_request_labels", "label", pr.ID)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	pr.Labels = labels
+
+	return &pr, nil
 }
 
 func (r *PullRequestRepository) GetPRsByReviewer(userID string) ([]*entity.PullRequest, error) {
-	if err := r.validateUserID(userID); err != nil {
-		return nil, err
-	}
-
 	query := r.sb.Select(
 		"pr.pull_request_id",
 		"pr.pull_request_name",
@@ -299,10 +340,14 @@ func (r *PullRequestRepository) GetPRsByReviewer(userID string) ([]*entity.PullR
 		"pr.status",
 		"pr.created_at",
 		"pr.merged_at",
+		"COALESCE(pr.repository_name, '')",
+		"pr.priority",
+		"pr.description",
+		"pr.version",
 	).
 		From("pull_requests pr").
-		Join("assigned_reviewers ar ON pr.pull_request_id = ar.pull_request_id").
-		Where(squirrel.Eq{"ar.reviewer_id": userID})
+		Join("assigned_reviewers ar ON ar.org_id = pr.org_id AND pr.pull_request_id = ar.pull_request_id").
+		Where(squirrel.Eq{"pr.org_id": r.orgID, "ar.reviewer_id": userID})
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -335,20 +380,202 @@ func (r *PullRequestRepository) GetPRsByReviewer(userID string) ([]*entity.PullR
 	return prs, nil
 }
 
+func (r *PullRequestRepository) GetReviewersWithOpenPRs() ([]string, error) {
+	query := r.sb.Select("DISTINCT ar.reviewer_id").
+		From("assigned_reviewers ar").
+		Join("pull_requests pr ON pr.org_id = ar.org_id AND pr.pull_request_id = ar.pull_request_id").
+		Where(squirrel.Eq{"ar.org_id": r.orgID, "pr.status": string(entity.StatusOpen)}).
+		OrderBy("ar.reviewer_id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute GetReviewersWithOpenPRs query: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviewers := make([]string, 0)
+	for rows.Next() {
+		var reviewer string
+		if err := rows.Scan(&reviewer); err != nil {
+			return nil, err
+		}
+		reviewers = append(reviewers, reviewer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reviewers, nil
+}
+
+func (r *PullRequestRepository) CountOpenReviews(userIDs []string) (map[string]int, error) {
+	counts := make(map[string]int, len(userIDs))
+	if len(userIDs) == 0 {
+		return counts, nil
+	}
+
+	query := r.sb.Select("ar.reviewer_id", "COUNT(*)").
+		From("assigned_reviewers ar").
+		Join("pull_requests pr ON pr.org_id = ar.org_id AND pr.pull_request_id = ar.pull_request_id").
+		Where(squirrel.Eq{"ar.org_id": r.orgID, "pr.status": string(entity.StatusOpen), "ar.reviewer_id": userIDs}).
+		GroupBy("ar.reviewer_id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute CountOpenReviews query: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reviewer string
+		var count int
+		if err := rows.Scan(&reviewer, &count); err != nil {
+			return nil, err
+		}
+		counts[reviewer] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func (r *PullRequestRepository) AddApproval(prID, reviewerID string) error {
+	query := r.sb.Insert("pull_request_approvals").
+		Columns("org_id", "pull_request_id", "reviewer_id").
+		Values(r.orgID, prID, reviewerID).
+		Suffix("ON CONFLICT (org_id, pull_request_id, reviewer_id) DO NOTHING")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute AddApproval query for PR %s: %v", prID, err)
+		return err
+	}
+	return nil
+}
+
+func (r *PullRequestRepository) CountApprovals(prID string) (int, error) {
+	query := r.sb.Select("COUNT(*)").
+		From("pull_request_approvals pa").
+		Join("assigned_reviewers ar ON ar.org_id = pa.org_id AND ar.pull_request_id = pa.pull_request_id AND ar.reviewer_id = pa.reviewer_id").
+		Where(squirrel.Eq{"pa.org_id": r.orgID, "pa.pull_request_id": prID})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	if err := r.db.QueryRow(r.ctx, sql, args...).Scan(&count); err != nil {
+		logging.Printf("ERROR: Failed to execute CountApprovals query for PR %s: %v", prID, err)
+		return 0, err
+	}
+	return count, nil
+}
+
 func (r *PullRequestRepository) insertReviewers(tx pgx.Tx, prID string, reviewers []string) error {
 	if len(reviewers) == 0 {
 		return nil
 	}
 
 	query := r.sb.Insert("assigned_reviewers").
-		Columns("pull_request_id", "reviewer_id")
+		Columns("org_id", "pull_request_id", "reviewer_id").
+		Suffix("ON CONFLICT (org_id, pull_request_id, reviewer_id) DO NOTHING")
 
 	for _, reviewer := range reviewers {
 		if reviewer != "" {
-			query = query.Values(prID, reviewer)
+			query = query.Values(r.orgID, prID, reviewer)
+		}
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(r.ctx, sql, args...)
+	return err
+}
+
+func (r *PullRequestRepository) insertPRValues(tx pgx.Tx, table, column, prID string, values []string) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	query := r.sb.Insert(table).
+		Columns("org_id", "pull_request_id", column).
+		Suffix("ON CONFLICT (org_id, pull_request_id, " + column + ") DO NOTHING")
+
+	for _, value := range values {
+		query = query.Values(r.orgID, prID, value)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(r.ctx, sql, args...)
+	return err
+}
+
+func (r *PullRequestRepository) getPRValues(table, column, prID string) ([]string, error) {
+	query := r.sb.Select(column).
+		From(table).
+		Where(squirrel.Eq{"org_id": r.orgID, "pull_request_id": prID}).
+		OrderBy(column)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := make([]string, 0)
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
 		}
+		values = append(values, value)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func (r *PullRequestRepository) deleteUnassignedReviewers(tx pgx.Tx, prID string, reviewers []string) error {
+	query := r.sb.Delete("assigned_reviewers").
+		Where(squirrel.Eq{"org_id": r.orgID, "pull_request_id": prID}).
+		Where(squirrel.NotEq{"reviewer_id": reviewers})
+
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return err
@@ -358,10 +585,67 @@ func (r *PullRequestRepository) insertReviewers(tx pgx.Tx, prID string, reviewer
 	return err
 }
 
+func replaceReviewer(ctx context.Context, tx pgx.Tx, sb squirrel.StatementBuilderType, orgID string, reassignment entity.ReviewerReassignment) error {
+	query := sb.Update("assigned_reviewers").
+		Set("reviewer_id", reassignment.NewReviewerID).
+		Set("assigned_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{
+			"org_id":          orgID,
+			"pull_request_id": reassignment.PullRequestID,
+			"reviewer_id":     reassignment.OldReviewerID,
+		}).
+		Where(`EXISTS (
+			SELECT 1 FROM pull_requests pr
+			WHERE pr.org_id = assigned_reviewers.org_id
+				AND pr.pull_request_id = assigned_reviewers.pull_request_id
+				AND pr.status = ?
+		)`, string(entity.StatusOpen)).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM assigned_reviewers taken
+			WHERE taken.org_id = assigned_reviewers.org_id
+				AND taken.pull_request_id = assigned_reviewers.pull_request_id
+				AND taken.reviewer_id = ?
+		)`, reassignment.NewReviewerID).
+		Where(`EXISTS (
+			SELECT 1 FROM users u
+			WHERE u.org_id = assigned_reviewers.org_id
+				AND u.user_id = ?
+				AND u.is_active
+				AND u.deleted_at IS NULL
+		)`, reassignment.NewReviewerID)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repo.ErrReviewerChanged
+	}
+	return bumpPRVersion(ctx, tx, sb, orgID, reassignment.PullRequestID)
+}
+
+func bumpPRVersion(ctx context.Context, tx pgx.Tx, sb squirrel.StatementBuilderType, orgID, prID string) error {
+	sql, args, err := sb.Update("pull_requests").
+		Set("version", squirrel.Expr("version + 1")).
+		Where(squirrel.Eq{"org_id": orgID, "pull_request_id": prID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	return err
+}
+
 func (r *PullRequestRepository) getReviewers(prID string) ([]string, error) {
 	query := r.sb.Select("reviewer_id").
 		From("assigned_reviewers").
-		Where(squirrel.Eq{"pull_request_id": prID}).
+		Where(squirrel.Eq{"org_id": r.orgID, "pull_request_id": prID}).
 		OrderBy("assigned_at")
 
 	sql, args, err := query.ToSql()
@@ -392,9 +676,6 @@ func (r *PullRequestRepository) getReviewers(prID string) ([]string, error) {
 }
 
 func (r *PullRequestRepository) AddReviewers(prID string, reviewers []string) error {
-	if prID == "" {
-		return errors.New("pull_request_id cannot be empty")
-	}
 	if len(reviewers) == 0 {
 		return nil
 	}
@@ -407,25 +688,39 @@ func (r *PullRequestRepository) AddReviewers(prID string, reviewers []string) er
 		return fmt.Errorf("pull request with id %s does not exist", prID)
 	}
 
-	return r.insertReviewers(nil, prID, reviewers)
+	return r.executeInTransaction(func(tx pgx.Tx) error {
+		if err := r.insertReviewers(tx, prID, reviewers); err != nil {
+			return err
+		}
+		return bumpPRVersion(r.ctx, tx, r.sb, r.orgID, prID)
+	}, "AddReviewers")
 }
 
 func (r *PullRequestRepository) RemoveReviewers(prID string, reviewers []string) error {
-	if prID == "" {
-		return errors.New("pull_request_id cannot be empty")
-	}
 	if len(reviewers) == 0 {
 		return nil
 	}
 
 	query := r.sb.Delete("assigned_reviewers").
-		Where(squirrel.Eq{"pull_request_id": prID, "reviewer_id": reviewers})
+		Where(squirrel.Eq{"org_id": r.orgID, "pull_request_id": prID, "reviewer_id": reviewers})
 
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return err
 	}
 
-	_, err = r.db.Exec(r.ctx, sql, args...)
-	return err
+	return r.executeInTransaction(func(tx pgx.Tx) error {
+		tag, err := tx.Exec(r.ctx, sql, args...)
+		if err != nil || tag.RowsAffected() == 0 {
+			return err
+		}
+		return bumpPRVersion(r.ctx, tx, r.sb, r.orgID, prID)
+	}, "RemoveReviewers")
+}
+
+func nullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
 }
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"pr-review/internal/entity"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ repo.RepositoryRepository = (*RepositoryRepository)(nil)
+
+type RepositoryRepository struct {
+	db    DB
+	sb    squirrel.StatementBuilderType
+	ctx   context.Context
+	orgID string
+}
+
+func NewRepositoryRepository(db DB, orgID string) *RepositoryRepository {
+	return &RepositoryRepository{
+		db:    db,
+		sb:    squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		ctx:   context.Background(),
+		orgID: orgID,
+	}
+}
+
+func (r *RepositoryRepository) SaveRepository(repository *entity.Repository) error {
+	if repository == nil {
+		return errors.New("repository cannot be nil")
+	}
+
+	query := r.sb.Insert("repositories").
+		Columns("org_id", "repository_name", "team_name").
+		Values(r.orgID, repository.Name, nullableString(repository.TeamName)).
+		Suffix("ON CONFLICT (org_id, repository_name) DO UPDATE SET team_name = EXCLUDED.team_name, updated_at = NOW()")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for SaveRepository: %v", err)
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute SaveRepository query for %s: %v", repository.Name, err)
+		return err
+	}
+	return nil
+}
+
+func (r *RepositoryRepository) GetRepository(name string) (*entity.Repository, error) {
+	query := r.sb.Select("repository_name", "COALESCE(team_name, '')", "codeowners", "updated_at").
+		From("repositories").
+		Where(squirrel.Eq{"org_id": r.orgID, "repository_name": name})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for GetRepository: %v", err)
+		return nil, err
+	}
+
+	var repository entity.Repository
+	err = r.db.QueryRow(r.ctx, sql, args...).Scan(
+		&repository.Name,
+		&repository.TeamName,
+		&repository.CodeOwners,
+		&repository.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		logging.Printf("ERROR: Failed to execute GetRepository query for %s: %v", name, err)
+		return nil, err
+	}
+
+	return &repository, nil
+}
+
+func (r *RepositoryRepository) SetCodeOwners(name, content string) error {
+	query := r.sb.Update("repositories").
+		Set("codeowners", content).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"org_id": r.orgID, "repository_name": name})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for SetCodeOwners: %v", err)
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute SetCodeOwners query for %s: %v", name, err)
+		return err
+	}
+	return nil
+}
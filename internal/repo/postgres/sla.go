@@ -0,0 +1,338 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"pr-review/internal/entity"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ repo.SLARepository = (*SLARepository)(nil)
+
+type SLARepository struct {
+	db    DB
+	sb    squirrel.StatementBuilderType
+	ctx   context.Context
+	orgID string
+}
+
+func NewSLARepository(db DB, orgID string) *SLARepository {
+	return &SLARepository{
+		db:    db,
+		sb:    squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		ctx:   context.Background(),
+		orgID: orgID,
+	}
+}
+
+func (r *SLARepository) GetSettings(teamName string) (*entity.SLASettings, error) {
+	query := r.sb.Select("team_name", "first_review_minutes", "merge_minutes", "auto_reassign").
+		From("team_sla").
+		Where(squirrel.Eq{"org_id": r.orgID, "team_name": teamName})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for GetSettings: %v", err)
+		return nil, err
+	}
+
+	var settings entity.SLASettings
+	err = r.db.QueryRow(r.ctx, sql, args...).Scan(
+		&settings.TeamName,
+		&settings.FirstReviewMinutes,
+		&settings.MergeMinutes,
+		&settings.AutoReassign,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		logging.Printf("ERROR: Failed to execute GetSettings query for team %s: %v", teamName, err)
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+func (r *SLARepository) SaveSettings(settings *entity.SLASettings) error {
+	if settings == nil {
+		return errors.New("sla settings cannot be nil")
+	}
+
+	query := r.sb.Insert("team_sla").
+		Columns("org_id", "team_name", "first_review_minutes", "merge_minutes", "auto_reassign").
+		Values(r.orgID, settings.TeamName, settings.FirstReviewMinutes, settings.MergeMinutes, settings.AutoReassign).
+		Suffix("ON CONFLICT (org_id, team_name) DO UPDATE SET first_review_minutes = EXCLUDED.first_review_minutes, merge_minutes = EXCLUDED.merge_minutes, auto_reassign = EXCLUDED.auto_reassign")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for SaveSettings: %v", err)
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute SaveSettings query for team %s: %v", settings.TeamName, err)
+		return err
+	}
+	return nil
+}
+
+func (r *SLARepository) ListSettings() ([]*entity.SLASettings, error) {
+	query := r.sb.Select("team_name", "first_review_minutes", "merge_minutes", "auto_reassign").
+		From("team_sla").
+		Where(squirrel.Eq{"org_id": r.orgID}).
+		OrderBy("team_name")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for ListSettings: %v", err)
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute ListSettings query: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	settings := make([]*entity.SLASettings, 0)
+	for rows.Next() {
+		var s entity.SLASettings
+		if err := rows.Scan(&s.TeamName, &s.FirstReviewMinutes, &s.MergeMinutes, &s.AutoReassign); err != nil {
+			return nil, err
+		}
+		settings = append(settings, &s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (r *SLARepository) FindReviewBreaches(teamName string, assignedBefore time.Time) ([]*entity.SLABreach, error) {
+	query := r.sb.Select("ar.pull_request_id", "ar.reviewer_id", "ar.assigned_at").
+		From("assigned_reviewers ar").
+		Join("pull_requests pr ON pr.org_id = ar.org_id AND pr.pull_request_id = ar.pull_request_id").
+		Join("users u ON u.org_id = pr.org_id AND u.user_id = pr.author_id").
+		Where(squirrel.Eq{"ar.org_id": r.orgID, "u.team_name": teamName, "pr.status": string(entity.StatusOpen)}).
+		Where(squirrel.Lt{"ar.assigned_at": assignedBefore}).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM sla_breaches b
+			WHERE b.org_id = ar.org_id
+				AND b.pull_request_id = ar.pull_request_id
+				AND b.reviewer_id = ar.reviewer_id
+				AND b.kind = ?
+				AND b.resolved_at IS NULL)`, string(entity.SLABreachFirstReview)).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM pull_request_approvals pa
+			WHERE pa.org_id = ar.org_id
+				AND pa.pull_request_id = ar.pull_request_id
+				AND pa.reviewer_id = ar.reviewer_id)`).
+		OrderBy("ar.assigned_at")
+
+	return r.queryBreachCandidates(query, entity.SLABreachFirstReview, teamName)
+}
+
+func (r *SLARepository) FindMergeBreaches(teamName string, createdBefore time.Time) ([]*entity.SLABreach, error) {
+	query := r.sb.Select("pr.pull_request_id", "''", "pr.created_at").
+		From("pull_requests pr").
+		Join("users u ON u.org_id = pr.org_id AND u.user_id = pr.author_id").
+		Where(squirrel.Eq{"pr.org_id": r.orgID, "u.team_name": teamName, "pr.status": string(entity.StatusOpen)}).
+		Where(squirrel.Lt{"pr.created_at": createdBefore}).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM sla_breaches b
+			WHERE b.org_id = pr.org_id
+				AND b.pull_request_id = pr.pull_request_id
+				AND b.kind = ?
+				AND b.resolved_at IS NULL)`, string(entity.SLABreachMerge)).
+		OrderBy("pr.created_at")
+
+	return r.queryBreachCandidates(query, entity.SLABreachMerge, teamName)
+}
+
+func (r *SLARepository) queryBreachCandidates(query squirrel.SelectBuilder, kind entity.SLABreachKind, teamName string) ([]*entity.SLABreach, error) {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for %s breaches: %v", kind, err)
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute %s breaches query for team %s: %v", kind, teamName, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	breaches := make([]*entity.SLABreach, 0)
+	for rows.Next() {
+		breach := entity.SLABreach{Kind: kind, TeamName: teamName}
+		if err := rows.Scan(&breach.PullRequestID, &breach.ReviewerID, &breach.StartedAt); err != nil {
+			return nil, err
+		}
+		breaches = append(breaches, &breach)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return breaches, nil
+}
+
+func (r *SLARepository) RecordBreach(breach *entity.SLABreach) error {
+	if breach == nil {
+		return errors.New("sla breach cannot be nil")
+	}
+
+	query := r.sb.Insert("sla_breaches").
+		Columns("org_id", "kind", "pull_request_id", "reviewer_id", "team_name", "started_at", "deadline", "detected_at").
+		Values(
+			r.orgID,
+			string(breach.Kind),
+			breach.PullRequestID,
+			breach.ReviewerID,
+			breach.TeamName,
+			breach.StartedAt,
+			breach.Deadline,
+			breach.DetectedAt,
+		).
+		Suffix("RETURNING id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for RecordBreach: %v", err)
+		return err
+	}
+
+	if err := r.db.QueryRow(r.ctx, sql, args...).Scan(&breach.ID); err != nil {
+		logging.Printf("ERROR: Failed to record %s breach for PR %s: %v", breach.Kind, breach.PullRequestID, err)
+		return err
+	}
+	return nil
+}
+
+func (r *SLARepository) MarkReassigned(breachID int64, newReviewerID string) error {
+	query := r.sb.Update("sla_breaches").
+		Set("reassigned_to", newReviewerID).
+		Where(squirrel.Eq{"org_id": r.orgID, "id": breachID})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for MarkReassigned: %v", err)
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute MarkReassigned query for breach %d: %v", breachID, err)
+		return err
+	}
+	return nil
+}
+
+func (r *SLARepository) ResolveBreaches(at time.Time) error {
+	query := r.sb.Update("sla_breaches b").
+		Set("resolved_at", at).
+		Where(squirrel.Eq{"b.org_id": r.orgID, "b.resolved_at": nil}).
+		Where(`(
+			EXISTS (
+				SELECT 1 FROM pull_requests pr
+				WHERE pr.org_id = b.org_id AND pr.pull_request_id = b.pull_request_id AND pr.status = ?)
+			OR (b.kind = ? AND NOT EXISTS (
+				SELECT 1 FROM assigned_reviewers ar
+				WHERE ar.org_id = b.org_id AND ar.pull_request_id = b.pull_request_id AND ar.reviewer_id = b.reviewer_id))
+			OR (b.kind = ? AND EXISTS (
+				SELECT 1 FROM pull_request_approvals pa
+				WHERE pa.org_id = b.org_id AND pa.pull_request_id = b.pull_request_id AND pa.reviewer_id = b.reviewer_id)))`,
+			string(entity.StatusMerged), string(entity.SLABreachFirstReview), string(entity.SLABreachFirstReview))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for ResolveBreaches: %v", err)
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute ResolveBreaches query: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *SLARepository) ListBreaches(filter entity.SLABreachFilter) ([]*entity.SLABreach, error) {
+	query := r.sb.Select(
+		"id",
+		"kind",
+		"pull_request_id",
+		"reviewer_id",
+		"team_name",
+		"started_at",
+		"deadline",
+		"detected_at",
+		"COALESCE(reassigned_to, '')",
+		"resolved_at",
+	).
+		From("sla_breaches").
+		Where(squirrel.Eq{"org_id": r.orgID}).
+		OrderBy("detected_at DESC", "id DESC")
+
+	if filter.TeamName != "" {
+		query = query.Where(squirrel.Eq{"team_name": filter.TeamName})
+	}
+	if filter.Kind != "" {
+		query = query.Where(squirrel.Eq{"kind": string(filter.Kind)})
+	}
+	if filter.OpenOnly {
+		query = query.Where(squirrel.Eq{"resolved_at": nil})
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for ListBreaches: %v", err)
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute ListBreaches query: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	breaches := make([]*entity.SLABreach, 0)
+	for rows.Next() {
+		var breach entity.SLABreach
+		var kind string
+		if err := rows.Scan(
+			&breach.ID,
+			&kind,
+			&breach.PullRequestID,
+			&breach.ReviewerID,
+			&breach.TeamName,
+			&breach.StartedAt,
+			&breach.Deadline,
+			&breach.DetectedAt,
+			&breach.ReassignedTo,
+			&breach.ResolvedAt,
+		); err != nil {
+			logging.Printf("ERROR: Failed to scan sla breach row: %v", err)
+			return nil, err
+		}
+		breach.Kind = entity.SLABreachKind(kind)
+		breaches = append(breaches, &breach)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return breaches, nil
+}
@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"pr-review/internal/entity"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var _ repo.SnapshotRepository = (*SnapshotRepository)(nil)
+
+var serialTables = []string{"user_availability", "sla_breaches"}
+
+type SnapshotRepository struct {
+	db  DB
+	ctx context.Context
+}
+
+func NewSnapshotRepository(db DB) *SnapshotRepository {
+	return &SnapshotRepository{
+		db:  db,
+		ctx: context.Background(),
+	}
+}
+
+func (r *SnapshotRepository) SchemaVersion() (int, error) {
+	var version int
+	err := r.db.QueryRow(r.ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version)
+	if err != nil {
+		logging.Printf("ERROR: Failed to read schema version: %v", err)
+		return 0, err
+	}
+	return version, nil
+}
+
+func (r *SnapshotRepository) CountRows(tables []string) (map[string]int64, error) {
+	counts := make(map[string]int64, len(tables))
+	for _, table := range tables {
+		var count int64
+		sql := "SELECT COUNT(*) FROM " + pgx.Identifier{table}.Sanitize()
+		if err := r.db.QueryRow(r.ctx, sql).Scan(&count); err != nil {
+			logging.Printf("ERROR: Failed to count rows in %s: %v", table, err)
+			return nil, err
+		}
+		counts[table] = count
+	}
+	return counts, nil
+}
+
+func (r *SnapshotRepository) Dump(tables []string) ([]*entity.SnapshotTable, error) {
+	result := make([]*entity.SnapshotTable, 0, len(tables))
+
+	err := runInTransaction(r.ctx, r.db, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(r.ctx, "SET TRANSACTION ISOLATION LEVEL REPEATABLE READ, READ ONLY"); err != nil {
+			logging.Printf("ERROR: Failed to start snapshot transaction: %v", err)
+			return err
+		}
+
+		for _, table := range tables {
+			columns, err := r.tableColumns(tx, table)
+			if err != nil {
+				return err
+			}
+			if len(columns) == 0 {
+				return fmt.Errorf("table %s does not exist", table)
+			}
+
+			var buf bytes.Buffer
+			tag, err := tx.Conn().PgConn().CopyTo(r.ctx, &buf, copySQL(table, columns, "TO STDOUT"))
+			if err != nil {
+				logging.Printf("ERROR: Failed to dump table %s: %v", table, err)
+				return err
+			}
+
+			result = append(result, &entity.SnapshotTable{
+				Name:    table,
+				Columns: columns,
+				Rows:    tag.RowsAffected(),
+				Data:    buf.Bytes(),
+			})
+		}
+		return nil
+	}, "Dump")
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *SnapshotRepository) tableColumns(tx pgx.Tx, table string) ([]string, error) {
+	rows, err := tx.Query(r.ctx, `
+		SELECT column_name FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1
+		ORDER BY ordinal_position`, table)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get columns of %s: %v", table, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := make([]string, 0)
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			logging.Printf("ERROR: Failed to scan column of %s: %v", table, err)
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.Printf("ERROR: Error iterating columns of %s: %v", table, err)
+		return nil, err
+	}
+	return columns, nil
+}
+
+func (r *SnapshotRepository) Restore(tables []*entity.SnapshotTable) error {
+	if len(tables) == 0 {
+		return errors.New("snapshot contains no tables")
+	}
+
+	return runInTransaction(r.ctx, r.db, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(r.ctx, "DELETE FROM organizations"); err != nil {
+			logging.Printf("ERROR: Failed to clear organizations before restore: %v", err)
+			return err
+		}
+
+		for _, table := range tables {
+			sql := copySQL(table.Name, table.Columns, "FROM STDIN")
+			tag, err := tx.Conn().PgConn().CopyFrom(r.ctx, bytes.NewReader(table.Data), sql)
+			if err != nil {
+				logging.Printf("ERROR: Failed to restore table %s: %v", table.Name, err)
+				return err
+			}
+			if tag.RowsAffected() != table.Rows {
+				return fmt.Errorf("table %s: restored %d rows, snapshot has %d", table.Name, tag.RowsAffected(), table.Rows)
+			}
+		}
+
+		for _, table := range serialTables {
+			name := pgx.Identifier{table}.Sanitize()
+			sql := fmt.Sprintf("SELECT setval(pg_get_serial_sequence($1, 'id'), COALESCE(MAX(id), 0) + 1, false) FROM %s", name)
+			if _, err := tx.Exec(r.ctx, sql, table); err != nil {
+				logging.Printf("ERROR: Failed to reset sequence of %s: %v", table, err)
+				return err
+			}
+		}
+		return nil
+	}, "Restore")
+}
+
+func copySQL(table string, columns []string, direction string) string {
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = pgx.Identifier{column}.Sanitize()
+	}
+	return fmt.Sprintf("COPY %s (%s) %s", pgx.Identifier{table}.Sanitize(), strings.Join(quoted, ", "), direction)
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"pr-review/internal/config"
 	"pr-review/internal/entity"
@@ -10,22 +11,23 @@ import (
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var _ repo.TeamRepository = (*TeamRepository)(nil)
 
 type TeamRepository struct {
-	db  *pgxpool.Pool
-	sb  squirrel.StatementBuilderType
-	ctx context.Context
+	db    DB
+	sb    squirrel.StatementBuilderType
+	ctx   context.Context
+	orgID string
 }
 
-func NewTeamRepository(db *pgxpool.Pool) *TeamRepository {
+func NewTeamRepository(db DB, orgID string) *TeamRepository {
 	return &TeamRepository{
-		db:  db,
-		sb:  squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
-		ctx: context.Background(),
+		db:    db,
+		sb:    squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		ctx:   context.Background(),
+		orgID: orgID,
 	}
 }
 
@@ -33,51 +35,93 @@ func (r *TeamRepository) CreateTeam(team *entity.Team) error {
 	if team == nil {
 		return errors.New("team cannot be nil")
 	}
-	if team.Name == "" {
-		return errors.New("team_name cannot be empty")
-	}
-	if len(team.Name) > config.MaxStringLength {
-		return errors.New("team_name cannot exceed 255 characters")
+
+	strategy := team.SelectionStrategy
+	if strategy == "" {
+		strategy = entity.SelectionStrategyRandom
 	}
 
-	query := r.sb.Insert("teams").
-		Columns("team_name").
-		Values(team.Name)
+	return runInTransaction(r.ctx, r.db, func(tx pgx.Tx) error {
+		teamSQL, teamArgs, err := r.sb.Insert("teams").
+			Columns("org_id", "team_name").
+			Values(r.orgID, team.Name).
+			ToSql()
+		if err != nil {
+			logging.Printf("ERROR: Failed to build SQL query for CreateTeam: %v", err)
+			return err
+		}
+		if _, err := tx.Exec(r.ctx, teamSQL, teamArgs...); err != nil {
+			logging.Printf("ERROR: Failed to execute CreateTeam query for team %s: %v", team.Name, err)
+			return err
+		}
 
-	sql, args, err := query.ToSql()
-	if err != nil {
-		logging.Printf("ERROR: Failed to build SQL query for CreateTeam: %v", err)
-		return err
-	}
+		policySQL, policyArgs, err := r.sb.Insert("team_policies").
+			Columns("org_id", "team_name", "required_reviewers", "selection_strategy", "reassign_on_deactivation").
+			Values(r.orgID, team.Name, config.DefaultReviewers, string(strategy), team.ReassignOnDeactivation).
+			ToSql()
+		if err != nil {
+			logging.Printf("ERROR: Failed to build SQL query for CreateTeam: %v", err)
+			return err
+		}
+		if _, err := tx.Exec(r.ctx, policySQL, policyArgs...); err != nil {
+			logging.Printf("ERROR: Failed to create policy for team %s: %v", team.Name, err)
+			return err
+		}
 
-	_, err = r.db.Exec(r.ctx, sql, args...)
-	if err != nil {
-		logging.Printf("ERROR: Failed to execute CreateTeam query for team %s: %v", team.Name, err)
-		return err
-	}
+		for i := range team.Members {
+			member := team.Members[i]
+			member.Team = team.Name
+			memberSQL, memberArgs, err := upsertUserQuery(r.sb, r.orgID, &member).ToSql()
+			if err != nil {
+				logging.Printf("ERROR: Failed to build SQL query for CreateTeam: %v", err)
+				return err
+			}
+			if _, err := tx.Exec(r.ctx, memberSQL, memberArgs...); err != nil {
+				logging.Printf("ERROR: Failed to create/update user %s: %v", member.ID, err)
+				return err
+			}
+		}
 
-	return nil
+		if len(team.FallbackTeams) == 0 && len(team.SharedReviewers) == 0 {
+			return nil
+		}
+		if err := r.replaceFallbackTeams(tx, team.Name, team.FallbackTeams); err != nil {
+			return err
+		}
+		return r.replaceSharedReviewers(tx, team.Name, team.SharedReviewers)
+	}, "CreateTeam")
 }
 
 func (r *TeamRepository) GetTeam(teamName string) (*entity.Team, error) {
-	if teamName == "" {
-		return nil, errors.New("team_name cannot be empty")
-	}
-	if len(teamName) > config.MaxStringLength {
-		return nil, errors.New("team_name cannot exceed 255 characters")
-	}
+	teamQuery := r.sb.Select(
+		"COALESCE(tp.reassign_on_deactivation, false)",
+		"COALESCE(tp.selection_strategy, 'random')",
+		"COALESCE(t.parent_team, '')",
+	).
+		From("teams t").
+		LeftJoin("team_policies tp ON tp.org_id = t.org_id AND tp.team_name = t.team_name").
+		Where(squirrel.Eq{"t.org_id": r.orgID, "t.team_name": teamName})
 
-	exists, err := r.TeamExists(teamName)
+	teamSQL, teamArgs, err := teamQuery.ToSql()
 	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for GetTeam: %v", err)
 		return nil, err
 	}
-	if !exists {
-		return nil, nil
+
+	var reassignOnDeactivation bool
+	var strategy, parentTeam string
+	err = r.db.QueryRow(r.ctx, teamSQL, teamArgs...).Scan(&reassignOnDeactivation, &strategy, &parentTeam)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		logging.Printf("ERROR: Failed to execute GetTeam query for team %s: %v", teamName, err)
+		return nil, err
 	}
 
-	query := r.sb.Select("user_id", "username", "team_name", "is_active").
+	query := r.sb.Select("user_id", "username", "team_name", "is_active", "grade").
 		From("users").
-		Where(squirrel.Eq{"team_name": teamName})
+		Where(squirrel.Eq{"org_id": r.orgID, "team_name": teamName, "deleted_at": nil})
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -95,7 +139,7 @@ func (r *TeamRepository) GetTeam(teamName string) (*entity.Team, error) {
 	members := make([]entity.User, 0)
 	for rows.Next() {
 		var user entity.User
-		err := rows.Scan(&user.ID, &user.Name, &user.Team, &user.IsActive)
+		err := rows.Scan(&user.ID, &user.Name, &user.Team, &user.IsActive, &user.Grade)
 		if err != nil {
 			return nil, err
 		}
@@ -106,23 +150,31 @@ func (r *TeamRepository) GetTeam(teamName string) (*entity.Team, error) {
 		return nil, err
 	}
 
+	fallbackTeams, err := r.getFallbackTeams(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedReviewers, err := r.getSharedReviewers(teamName)
+	if err != nil {
+		return nil, err
+	}
+
 	return &entity.Team{
-		Name:    teamName,
-		Members: members,
+		Name:                   teamName,
+		Members:                members,
+		ReassignOnDeactivation: reassignOnDeactivation,
+		SelectionStrategy:      entity.SelectionStrategy(strategy),
+		FallbackTeams:          fallbackTeams,
+		SharedReviewers:        sharedReviewers,
+		ParentTeam:             parentTeam,
 	}, nil
 }
 
 func (r *TeamRepository) TeamExists(teamName string) (bool, error) {
-	if teamName == "" {
-		return false, errors.New("team_name cannot be empty")
-	}
-	if len(teamName) > config.MaxStringLength {
-		return false, errors.New("team_name cannot exceed 255 characters")
-	}
-
 	query := r.sb.Select("COUNT(*)").
 		From("teams").
-		Where(squirrel.Eq{"team_name": teamName})
+		Where(squirrel.Eq{"org_id": r.orgID, "team_name": teamName})
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -142,3 +194,398 @@ func (r *TeamRepository) TeamExists(teamName string) (bool, error) {
 
 	return count > 0, nil
 }
+
+func (r *TeamRepository) SetReviewerPools(teamName string, fallbackTeams, sharedReviewers []string) error {
+	return runInTransaction(r.ctx, r.db, func(tx pgx.Tx) error {
+		if err := r.replaceFallbackTeams(tx, teamName, fallbackTeams); err != nil {
+			return err
+		}
+		return r.replaceSharedReviewers(tx, teamName, sharedReviewers)
+	}, "SetReviewerPools")
+}
+
+func (r *TeamRepository) replaceFallbackTeams(tx pgx.Tx, teamName string, fallbackTeams []string) error {
+	deleteSQL, deleteArgs, err := r.sb.Delete("team_fallbacks").
+		Where(squirrel.Eq{"org_id": r.orgID, "team_name": teamName}).
+		ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for replaceFallbackTeams: %v", err)
+		return err
+	}
+	if _, err := tx.Exec(r.ctx, deleteSQL, deleteArgs...); err != nil {
+		logging.Printf("ERROR: Failed to delete fallback teams for team %s: %v", teamName, err)
+		return err
+	}
+
+	if len(fallbackTeams) == 0 {
+		return nil
+	}
+
+	insert := r.sb.Insert("team_fallbacks").Columns("org_id", "team_name", "fallback_team_name", "position")
+	for i, fallback := range fallbackTeams {
+		insert = insert.Values(r.orgID, teamName, fallback, i)
+	}
+
+	insertSQL, insertArgs, err := insert.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for replaceFallbackTeams: %v", err)
+		return err
+	}
+	if _, err := tx.Exec(r.ctx, insertSQL, insertArgs...); err != nil {
+		logging.Printf("ERROR: Failed to insert fallback teams for team %s: %v", teamName, err)
+		return err
+	}
+	return nil
+}
+
+func (r *TeamRepository) replaceSharedReviewers(tx pgx.Tx, teamName string, sharedReviewers []string) error {
+	deleteSQL, deleteArgs, err := r.sb.Delete("team_shared_reviewers").
+		Where(squirrel.Eq{"org_id": r.orgID, "team_name": teamName}).
+		ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for replaceSharedReviewers: %v", err)
+		return err
+	}
+	if _, err := tx.Exec(r.ctx, deleteSQL, deleteArgs...); err != nil {
+		logging.Printf("ERROR: Failed to delete shared reviewers for team %s: %v", teamName, err)
+		return err
+	}
+
+	if len(sharedReviewers) == 0 {
+		return nil
+	}
+
+	insert := r.sb.Insert("team_shared_reviewers").Columns("org_id", "team_name", "user_id")
+	for _, userID := range sharedReviewers {
+		insert = insert.Values(r.orgID, teamName, userID)
+	}
+
+	insertSQL, insertArgs, err := insert.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for replaceSharedReviewers: %v", err)
+		return err
+	}
+	if _, err := tx.Exec(r.ctx, insertSQL, insertArgs...); err != nil {
+		logging.Printf("ERROR: Failed to insert shared reviewers for team %s: %v", teamName, err)
+		return err
+	}
+	return nil
+}
+
+func (r *TeamRepository) getFallbackTeams(teamName string) ([]string, error) {
+	query := r.sb.Select("fallback_team_name").
+		From("team_fallbacks").
+		Where(squirrel.Eq{"org_id": r.orgID, "team_name": teamName}).
+		OrderBy("position")
+
+	return r.queryStrings(query, "getFallbackTeams", teamName)
+}
+
+func (r *TeamRepository) getSharedReviewers(teamName string) ([]string, error) {
+	query := r.sb.Select("user_id").
+		From("team_shared_reviewers").
+		Where(squirrel.Eq{"org_id": r.orgID, "team_name": teamName}).
+		OrderBy("user_id")
+
+	return r.queryStrings(query, "getSharedReviewers", teamName)
+}
+
+func (r *TeamRepository) queryStrings(query squirrel.SelectBuilder, operationName, teamName string) ([]string, error) {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for %s: %v", operationName, err)
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute %s query for team %s: %v", operationName, teamName, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := make([]string, 0)
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func (r *TeamRepository) GetPolicy(teamName string) (*entity.TeamPolicy, error) {
+	query := r.sb.Select(
+		"team_name",
+		"required_reviewers",
+		"selection_strategy",
+		"allow_self_merge",
+		"required_approvals",
+		"reassign_on_deactivation",
+		"composition_rules",
+		"COALESCE(security_team, '')",
+		"max_open_reviews",
+		"hierarchy_fallback",
+	).
+		From("team_policies").
+		Where(squirrel.Eq{"org_id": r.orgID, "team_name": teamName})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for GetPolicy: %v", err)
+		return nil, err
+	}
+
+	var policy entity.TeamPolicy
+	var strategy string
+	var rules []byte
+	err = r.db.QueryRow(r.ctx, sql, args...).Scan(
+		&policy.TeamName,
+		&policy.RequiredReviewers,
+		&strategy,
+		&policy.AllowSelfMerge,
+		&policy.RequiredApprovals,
+		&policy.ReassignOnDeactivation,
+		&rules,
+		&policy.SecurityTeam,
+		&policy.MaxOpenReviews,
+		&policy.HierarchyFallback,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		logging.Printf("ERROR: Failed to execute GetPolicy query for team %s: %v", teamName, err)
+		return nil, err
+	}
+	policy.SelectionStrategy = entity.SelectionStrategy(strategy)
+
+	if err := json.Unmarshal(rules, &policy.CompositionRules); err != nil {
+		logging.Printf("ERROR: Failed to decode composition rules for team %s: %v", teamName, err)
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func (r *TeamRepository) SavePolicy(policy *entity.TeamPolicy) error {
+	if policy == nil {
+		return errors.New("policy cannot be nil")
+	}
+
+	query, err := savePolicyQuery(r.sb, r.orgID, policy)
+	if err != nil {
+		return err
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for SavePolicy: %v", err)
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute SavePolicy query for team %s: %v", policy.TeamName, err)
+		return err
+	}
+	return nil
+}
+
+func savePolicyQuery(sb squirrel.StatementBuilderType, orgID string, policy *entity.TeamPolicy) (squirrel.InsertBuilder, error) {
+	rules := policy.CompositionRules
+	if rules == nil {
+		rules = []entity.CompositionRule{}
+	}
+	encodedRules, err := json.Marshal(rules)
+	if err != nil {
+		return squirrel.InsertBuilder{}, err
+	}
+
+	return sb.Insert("team_policies").
+		Columns(
+			"org_id",
+			"team_name",
+			"required_reviewers",
+			"selection_strategy",
+			"allow_self_merge",
+			"required_approvals",
+			"reassign_on_deactivation",
+			"composition_rules",
+			"security_team",
+			"max_open_reviews",
+			"hierarchy_fallback",
+		).
+		Values(
+			orgID,
+			policy.TeamName,
+			policy.RequiredReviewers,
+			string(policy.SelectionStrategy),
+			policy.AllowSelfMerge,
+			policy.RequiredApprovals,
+			policy.ReassignOnDeactivation,
+			string(encodedRules),
+			nullableString(policy.SecurityTeam),
+			policy.MaxOpenReviews,
+			policy.HierarchyFallback,
+		).
+		Suffix("ON CONFLICT (org_id, team_name) DO UPDATE SET required_reviewers = EXCLUDED.required_reviewers, selection_strategy = EXCLUDED.selection_strategy, allow_self_merge = EXCLUDED.allow_self_merge, required_approvals = EXCLUDED.required_approvals, reassign_on_deactivation = EXCLUDED.reassign_on_deactivation, composition_rules = EXCLUDED.composition_rules, security_team = EXCLUDED.security_team, max_open_reviews = EXCLUDED.max_open_reviews, hierarchy_fallback = EXCLUDED.hierarchy_fallback, updated_at = CURRENT_TIMESTAMP"), nil
+}
+
+func (r *TeamRepository) SetParentTeam(teamName, parentTeam string) error {
+	query := r.sb.Update("teams").
+		Set("parent_team", nullableString(parentTeam)).
+		Where(squirrel.Eq{"org_id": r.orgID, "team_name": teamName})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for SetParentTeam: %v", err)
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute SetParentTeam query for team %s: %v", teamName, err)
+		return err
+	}
+	return nil
+}
+
+func (r *TeamRepository) GetSubTeams(teamName string) ([]string, error) {
+	query := r.sb.Select("team_name").
+		From("teams").
+		Where(squirrel.Eq{"org_id": r.orgID, "parent_team": teamName}).
+		OrderBy("team_name")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for GetSubTeams: %v", err)
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute GetSubTeams query for team %s: %v", teamName, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := make([]string, 0)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		teams = append(teams, name)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+func (r *TeamRepository) GetTeamAncestors(teamName string, maxDepth int) ([]string, error) {
+	query := r.sb.Select("team_name").
+		Prefix(`WITH RECURSIVE chain AS (
+			SELECT org_id, team_name, parent_team, 0 AS depth, ARRAY[team_name::TEXT] AS path
+			FROM teams
+			WHERE org_id = ? AND team_name = ?
+			UNION ALL
+			SELECT t.org_id, t.team_name, t.parent_team, c.depth + 1, c.path || t.team_name::TEXT
+			FROM chain c
+			JOIN teams t ON t.org_id = c.org_id AND t.team_name = c.parent_team
+			WHERE NOT t.team_name = ANY(c.path) AND c.depth < ?
+		)`, r.orgID, teamName, maxDepth).
+		From("chain").
+		Where(squirrel.Eq{"org_id": r.orgID}).
+		Where(squirrel.Gt{"depth": 0}).
+		OrderBy("depth")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for GetTeamAncestors: %v", err)
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute GetTeamAncestors query for team %s: %v", teamName, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	ancestors := make([]string, 0)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		ancestors = append(ancestors, name)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ancestors, nil
+}
+
+func (r *TeamRepository) GetTeamStats(teamNames []string) (*entity.TeamStats, error) {
+	stats := &entity.TeamStats{Teams: teamNames}
+	if len(teamNames) == 0 {
+		return stats, nil
+	}
+
+	membersSQL, membersArgs, err := r.sb.Select("COUNT(*)", "COUNT(*) FILTER (WHERE is_active)").
+		From("users").
+		Where(squirrel.Eq{"org_id": r.orgID, "team_name": teamNames, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for GetTeamStats: %v", err)
+		return nil, err
+	}
+	if err := r.db.QueryRow(r.ctx, membersSQL, membersArgs...).Scan(&stats.Members, &stats.ActiveMembers); err != nil {
+		logging.Printf("ERROR: Failed to execute GetTeamStats members query: %v", err)
+		return nil, err
+	}
+
+	prSQL, prArgs, err := r.sb.Select(
+		"COUNT(*) FILTER (WHERE pr.status = 'OPEN')",
+		"COUNT(*) FILTER (WHERE pr.status = 'MERGED')",
+	).
+		From("pull_requests pr").
+		Join("users u ON u.org_id = pr.org_id AND u.user_id = pr.author_id").
+		Where(squirrel.Eq{"pr.org_id": r.orgID, "u.team_name": teamNames}).
+		ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for GetTeamStats: %v", err)
+		return nil, err
+	}
+	if err := r.db.QueryRow(r.ctx, prSQL, prArgs...).Scan(&stats.OpenPullRequests, &stats.MergedPullRequests); err != nil {
+		logging.Printf("ERROR: Failed to execute GetTeamStats pull request query: %v", err)
+		return nil, err
+	}
+
+	reviewSQL, reviewArgs, err := r.sb.Select("COUNT(*)").
+		From("assigned_reviewers ar").
+		Join("pull_requests pr ON pr.org_id = ar.org_id AND pr.pull_request_id = ar.pull_request_id").
+		Join("users u ON u.org_id = ar.org_id AND u.user_id = ar.reviewer_id").
+		Where(squirrel.Eq{"ar.org_id": r.orgID, "u.team_name": teamNames, "pr.status": string(entity.StatusOpen)}).
+		ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for GetTeamStats: %v", err)
+		return nil, err
+	}
+	if err := r.db.QueryRow(r.ctx, reviewSQL, reviewArgs...).Scan(&stats.OpenReviewAssignments); err != nil {
+		logging.Printf("ERROR: Failed to execute GetTeamStats review query: %v", err)
+		return nil, err
+	}
+
+	return stats, nil
+}
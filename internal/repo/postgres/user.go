@@ -3,43 +3,51 @@ package postgres
 import (
 	"context"
 	"errors"
-	"pr-review/internal/config"
 	"pr-review/internal/entity"
 	"pr-review/internal/logging"
 	"pr-review/internal/repo"
+	"strings"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var _ repo.UserRepository = (*UserRepository)(nil)
 
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 type UserRepository struct {
-	db  *pgxpool.Pool
-	sb  squirrel.StatementBuilderType
-	ctx context.Context
+	db    DB
+	sb    squirrel.StatementBuilderType
+	ctx   context.Context
+	orgID string
 }
 
-func NewUserRepository(db *pgxpool.Pool) *UserRepository {
+func NewUserRepository(db DB, orgID string) *UserRepository {
 	return &UserRepository{
-		db:  db,
-		sb:  squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
-		ctx: context.Background(),
+		db:    db,
+		sb:    squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		ctx:   context.Background(),
+		orgID: orgID,
 	}
 }
 
 func (r *UserRepository) GetUser(userID string) (*entity.User, error) {
-	if userID == "" {
-		return nil, errors.New("user_id cannot be empty")
-	}
-	if len(userID) > config.MaxStringLength {
-		return nil, errors.New("user_id cannot exceed 255 characters")
-	}
+	return r.getUser(userID, false)
+}
+
+func (r *UserRepository) GetUserIncludingDeleted(userID string) (*entity.User, error) {
+	return r.getUser(userID, true)
+}
 
-	query := r.sb.Select("user_id", "username", "team_name", "is_active").
+func (r *UserRepository) getUser(userID string, includeDeleted bool) (*entity.User, error) {
+	query := r.sb.Select("user_id", "username", "team_name", "is_active", "grade").
 		From("users").
-		Where(squirrel.Eq{"user_id": userID})
+		Where(squirrel.Eq{"org_id": r.orgID, "user_id": userID})
+	if !includeDeleted {
+		query = query.Where(squirrel.Eq{"deleted_at": nil})
+	}
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -53,6 +61,7 @@ func (r *UserRepository) GetUser(userID string) (*entity.User, error) {
 		&user.Name,
 		&user.Team,
 		&user.IsActive,
+		&user.Grade,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -69,31 +78,8 @@ func (r *UserRepository) CreateOrUpdateUser(user *entity.User) error {
 	if user == nil {
 		return errors.New("user cannot be nil")
 	}
-	if user.ID == "" {
-		return errors.New("user_id cannot be empty")
-	}
-	if len(user.ID) > config.MaxStringLength {
-		return errors.New("user_id cannot exceed 255 characters")
-	}
-	if user.Name == "" {
-		return errors.New("username cannot be empty")
-	}
-	if len(user.Name) > config.MaxStringLength {
-		return errors.New("username cannot exceed 255 characters")
-	}
-	if user.Team == "" {
-		return errors.New("team_name cannot be empty")
-	}
-	if len(user.Team) > config.MaxStringLength {
-		return errors.New("team_name cannot exceed 255 characters")
-	}
-
-	query := r.sb.Insert("users").
-		Columns("user_id", "username", "team_name", "is_active").
-		Values(user.ID, user.Name, user.Team, user.IsActive).
-		Suffix("ON CONFLICT (user_id) DO UPDATE SET username = EXCLUDED.username, team_name = EXCLUDED.team_name, is_active = EXCLUDED.is_active")
 
-	sql, args, err := query.ToSql()
+	sql, args, err := upsertUserQuery(r.sb, r.orgID, user).ToSql()
 	if err != nil {
 		logging.Printf("ERROR: Failed to build SQL query for CreateOrUpdateUser: %v", err)
 		return err
@@ -107,34 +93,30 @@ func (r *UserRepository) CreateOrUpdateUser(user *entity.User) error {
 	return nil
 }
 
+func upsertUserQuery(sb squirrel.StatementBuilderType, orgID string, user *entity.User) squirrel.InsertBuilder {
+	var grade *string
+	if user.Grade != "" {
+		value := string(user.Grade)
+		grade = &value
+	}
+
+	return sb.Insert("users").
+		Columns("org_id", "user_id", "username", "team_name", "is_active", "grade").
+		Values(orgID, user.ID, user.Name, user.Team, user.IsActive, string(user.Grade.OrDefault())).
+		Suffix("ON CONFLICT (org_id, user_id) DO UPDATE SET username = EXCLUDED.username, team_name = EXCLUDED.team_name, is_active = EXCLUDED.is_active, grade = COALESCE(?, users.grade) WHERE users.deleted_at IS NULL", grade)
+}
+
 func (r *UserRepository) UpdateUser(user *entity.User) error {
 	if user == nil {
 		return errors.New("user cannot be nil")
 	}
-	if user.ID == "" {
-		return errors.New("user_id cannot be empty")
-	}
-	if len(user.ID) > config.MaxStringLength {
-		return errors.New("user_id cannot exceed 255 characters")
-	}
-	if user.Name == "" {
-		return errors.New("username cannot be empty")
-	}
-	if len(user.Name) > config.MaxStringLength {
-		return errors.New("username cannot exceed 255 characters")
-	}
-	if user.Team == "" {
-		return errors.New("team_name cannot be empty")
-	}
-	if len(user.Team) > config.MaxStringLength {
-		return errors.New("team_name cannot exceed 255 characters")
-	}
 
 	query := r.sb.Update("users").
 		Set("username", user.Name).
 		Set("team_name", user.Team).
 		Set("is_active", user.IsActive).
-		Where(squirrel.Eq{"user_id": user.ID})
+		Set("grade", string(user.Grade.OrDefault())).
+		Where(squirrel.Eq{"org_id": r.orgID, "user_id": user.ID, "deleted_at": nil})
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -150,17 +132,43 @@ func (r *UserRepository) UpdateUser(user *entity.User) error {
 	return nil
 }
 
-func (r *UserRepository) GetUsersByTeam(teamName string) ([]*entity.User, error) {
-	if teamName == "" {
-		return nil, errors.New("team_name cannot be empty")
-	}
-	if len(teamName) > config.MaxStringLength {
-		return nil, errors.New("team_name cannot exceed 255 characters")
+func (r *UserRepository) UpdateUserWithReassignments(user *entity.User, reassignments []entity.ReviewerReassignment) error {
+	if user == nil {
+		return errors.New("user cannot be nil")
 	}
 
-	query := r.sb.Select("user_id", "username", "team_name", "is_active").
+	return runInTransaction(r.ctx, r.db, func(tx pgx.Tx) error {
+		query := r.sb.Update("users").
+			Set("username", user.Name).
+			Set("team_name", user.Team).
+			Set("is_active", user.IsActive).
+			Set("grade", string(user.Grade.OrDefault())).
+			Where(squirrel.Eq{"org_id": r.orgID, "user_id": user.ID, "deleted_at": nil})
+
+		sql, args, err := query.ToSql()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(r.ctx, sql, args...); err != nil {
+			logging.Printf("ERROR: Failed to update user %s: %v", user.ID, err)
+			return err
+		}
+
+		for _, reassignment := range reassignments {
+			if err := replaceReviewer(r.ctx, tx, r.sb, r.orgID, reassignment); err != nil {
+				logging.Printf("ERROR: Failed to reassign PR %s from %s to %s: %v",
+					reassignment.PullRequestID, reassignment.OldReviewerID, reassignment.NewReviewerID, err)
+				return err
+			}
+		}
+		return nil
+	}, "UpdateUserWithReassignments")
+}
+
+func (r *UserRepository) GetUsersByTeam(teamName string) ([]*entity.User, error) {
+	query := r.sb.Select("user_id", "username", "team_name", "is_active", "grade").
 		From("users").
-		Where(squirrel.Eq{"team_name": teamName})
+		Where(squirrel.Eq{"org_id": r.orgID, "team_name": teamName, "deleted_at": nil})
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -178,7 +186,7 @@ func (r *UserRepository) GetUsersByTeam(teamName string) ([]*entity.User, error)
 	users := make([]*entity.User, 0)
 	for rows.Next() {
 		var user entity.User
-		err := rows.Scan(&user.ID, &user.Name, &user.Team, &user.IsActive)
+		err := rows.Scan(&user.ID, &user.Name, &user.Team, &user.IsActive, &user.Grade)
 		if err != nil {
 			logging.Printf("ERROR: Failed to scan user row: %v", err)
 			return nil, err
@@ -195,16 +203,9 @@ func (r *UserRepository) GetUsersByTeam(teamName string) ([]*entity.User, error)
 }
 
 func (r *UserRepository) GetActiveUsersByTeam(teamName string) ([]*entity.User, error) {
-	if teamName == "" {
-		return nil, errors.New("team_name cannot be empty")
-	}
-	if len(teamName) > config.MaxStringLength {
-		return nil, errors.New("team_name cannot exceed 255 characters")
-	}
-
-	query := r.sb.Select("user_id", "username", "team_name", "is_active").
+	query := r.sb.Select("user_id", "username", "team_name", "is_active", "grade").
 		From("users").
-		Where(squirrel.Eq{"team_name": teamName, "is_active": true})
+		Where(squirrel.Eq{"org_id": r.orgID, "team_name": teamName, "is_active": true, "deleted_at": nil})
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -222,7 +223,7 @@ func (r *UserRepository) GetActiveUsersByTeam(teamName string) ([]*entity.User,
 	users := make([]*entity.User, 0)
 	for rows.Next() {
 		var user entity.User
-		err := rows.Scan(&user.ID, &user.Name, &user.Team, &user.IsActive)
+		err := rows.Scan(&user.ID, &user.Name, &user.Team, &user.IsActive, &user.Grade)
 		if err != nil {
 			logging.Printf("ERROR: Failed to scan user row: %v", err)
 			return nil, err
@@ -237,3 +238,239 @@ func (r *UserRepository) GetActiveUsersByTeam(teamName string) ([]*entity.User,
 
 	return users, nil
 }
+
+func (r *UserRepository) SetWorkSchedule(userID string, schedule *entity.WorkSchedule) error {
+	if schedule == nil {
+		return errors.New("schedule cannot be nil")
+	}
+
+	days := make([]int32, len(schedule.Days))
+	for i, day := range schedule.Days {
+		days[i] = int32(day)
+	}
+
+	query := r.sb.Insert("user_schedules").
+		Columns("org_id", "user_id", "time_zone", "work_start", "work_end", "work_days").
+		Values(r.orgID, userID, schedule.TimeZone, schedule.Start, schedule.End, days).
+		Suffix("ON CONFLICT (org_id, user_id) DO UPDATE SET time_zone = EXCLUDED.time_zone, work_start = EXCLUDED.work_start, work_end = EXCLUDED.work_end, work_days = EXCLUDED.work_days")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for SetWorkSchedule: %v", err)
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute SetWorkSchedule query for user %s: %v", userID, err)
+		return err
+	}
+	return nil
+}
+
+func (r *UserRepository) GetWorkSchedules(userIDs []string) (map[string]*entity.WorkSchedule, error) {
+	schedules := make(map[string]*entity.WorkSchedule)
+	if len(userIDs) == 0 {
+		return schedules, nil
+	}
+
+	query := r.sb.Select("user_id", "time_zone", "work_start", "work_end", "work_days").
+		From("user_schedules").
+		Where(squirrel.Eq{"org_id": r.orgID, "user_id": userIDs})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for GetWorkSchedules: %v", err)
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute GetWorkSchedules query: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID string
+		var days []int32
+		var schedule entity.WorkSchedule
+		if err := rows.Scan(&userID, &schedule.TimeZone, &schedule.Start, &schedule.End, &days); err != nil {
+			logging.Printf("ERROR: Failed to scan schedule row: %v", err)
+			return nil, err
+		}
+		schedule.Days = make([]time.Weekday, len(days))
+		for i, day := range days {
+			schedule.Days[i] = time.Weekday(day)
+		}
+		schedules[userID] = &schedule
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+func (r *UserRepository) ListUsers(filter entity.UserFilter) ([]*entity.User, error) {
+	conditions := squirrel.And{squirrel.Eq{"org_id": r.orgID, "deleted_at": nil}}
+	if filter.TeamName != "" {
+		conditions = append(conditions, squirrel.Eq{"team_name": filter.TeamName})
+	}
+	if filter.IsActive != nil {
+		conditions = append(conditions, squirrel.Eq{"is_active": *filter.IsActive})
+	}
+	if filter.UsernamePrefix != "" {
+		conditions = append(conditions, squirrel.Like{"lower(username)": likeEscaper.Replace(strings.ToLower(filter.UsernamePrefix)) + "%"})
+	}
+
+	query := r.sb.Select("user_id", "username", "team_name", "is_active", "grade").
+		From("users").
+		Where(conditions).
+		OrderBy("username", "user_id").
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for ListUsers: %v", err)
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute ListUsers query: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]*entity.User, 0)
+	for rows.Next() {
+		var user entity.User
+		err := rows.Scan(&user.ID, &user.Name, &user.Team, &user.IsActive, &user.Grade)
+		if err != nil {
+			logging.Printf("ERROR: Failed to scan user row: %v", err)
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.Printf("ERROR: Error iterating rows in ListUsers: %v", err)
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (r *UserRepository) UpdateUsername(userID, username string) error {
+	query := r.sb.Update("users").
+		Set("username", username).
+		Where(squirrel.Eq{"org_id": r.orgID, "user_id": userID, "deleted_at": nil})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for UpdateUsername: %v", err)
+		return err
+	}
+
+	if _, err := r.db.Exec(r.ctx, sql, args...); err != nil {
+		logging.Printf("ERROR: Failed to execute UpdateUsername query for user %s: %v", userID, err)
+		return err
+	}
+	return nil
+}
+
+func (r *UserRepository) GetDeletedUserIDs(userIDs []string) ([]string, error) {
+	deleted := make([]string, 0)
+	if len(userIDs) == 0 {
+		return deleted, nil
+	}
+
+	query := r.sb.Select("user_id").
+		From("users").
+		Where(squirrel.Eq{"org_id": r.orgID, "user_id": userIDs}).
+		Where(squirrel.NotEq{"deleted_at": nil}).
+		OrderBy("user_id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logging.Printf("ERROR: Failed to build SQL query for GetDeletedUserIDs: %v", err)
+		return nil, err
+	}
+
+	rows, err := r.db.Query(r.ctx, sql, args...)
+	if err != nil {
+		logging.Printf("ERROR: Failed to execute GetDeletedUserIDs query: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			logging.Printf("ERROR: Failed to scan deleted user row: %v", err)
+			return nil, err
+		}
+		deleted = append(deleted, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
+func (r *UserRepository) DeleteUser(userID string, reassignments []entity.ReviewerReassignment, deletedAt time.Time) error {
+	return runInTransaction(r.ctx, r.db, func(tx pgx.Tx) error {
+		for _, reassignment := range reassignments {
+			if err := replaceReviewer(r.ctx, tx, r.sb, r.orgID, reassignment); err != nil {
+				logging.Printf("ERROR: Failed to reassign PR %s from %s to %s: %v",
+					reassignment.PullRequestID, reassignment.OldReviewerID, reassignment.NewReviewerID, err)
+				return err
+			}
+		}
+
+		statements := []squirrel.Sqlizer{
+			r.sb.Update("pull_requests pr").
+				Set("version", squirrel.Expr("pr.version + 1")).
+				Where(squirrel.Eq{"pr.org_id": r.orgID, "pr.status": string(entity.StatusOpen)}).
+				Where(`EXISTS (
+					SELECT 1 FROM assigned_reviewers ar
+					WHERE ar.org_id = pr.org_id AND ar.pull_request_id = pr.pull_request_id AND ar.reviewer_id = ?)`,
+					userID),
+			r.sb.Delete("assigned_reviewers ar").
+				Where(squirrel.Eq{"ar.org_id": r.orgID, "ar.reviewer_id": userID}).
+				Where(`EXISTS (
+					SELECT 1 FROM pull_requests pr
+					WHERE pr.org_id = ar.org_id AND pr.pull_request_id = ar.pull_request_id AND pr.status = ?)`,
+					string(entity.StatusOpen)),
+			r.sb.Delete("user_schedules").Where(squirrel.Eq{"org_id": r.orgID, "user_id": userID}),
+			r.sb.Delete("user_availability").Where(squirrel.Eq{"org_id": r.orgID, "user_id": userID}),
+			r.sb.Delete("digest_preferences").Where(squirrel.Eq{"org_id": r.orgID, "user_id": userID}),
+			r.sb.Delete("team_shared_reviewers").Where(squirrel.Eq{"org_id": r.orgID, "user_id": userID}),
+			r.sb.Delete("reviewer_expertise").Where(squirrel.Eq{"org_id": r.orgID, "user_id": userID}),
+			r.sb.Delete("reviewer_affinities").
+				Where(squirrel.Eq{"org_id": r.orgID}).
+				Where(squirrel.Or{squirrel.Eq{"author_id": userID}, squirrel.Eq{"reviewer_id": userID}}),
+			r.sb.Update("users").
+				Set("username", entity.DeletedUsername).
+				Set("is_active", false).
+				Set("deleted_at", deletedAt).
+				Where(squirrel.Eq{"org_id": r.orgID, "user_id": userID, "deleted_at": nil}),
+		}
+
+		for _, statement := range statements {
+			sql, args, err := statement.ToSql()
+			if err != nil {
+				return err
+			}
+			if _, err := tx.Exec(r.ctx, sql, args...); err != nil {
+				logging.Printf("ERROR: Failed to delete user %s: %v", userID, err)
+				return err
+			}
+		}
+		return nil
+	}, "DeleteUser")
+}
@@ -1,15 +1,30 @@
 package repo
 
-import "pr-review/internal/entity"
+import (
+	"errors"
+	"pr-review/internal/entity"
+)
+
+var ErrReviewerChanged = errors.New("reviewer assignment changed concurrently")
 
 type PullRequestRepository interface {
 	CreatePR(pr *entity.PullRequest) error
 
 	GetPR(prID string) (*entity.PullRequest, error)
 
-	UpdatePR(pr *entity.PullRequest) error
+	UpdatePR(pr *entity.PullRequest) (bool, error)
+
+	UpdatePRMetadata(pr *entity.PullRequest, version int) (bool, error)
 
 	PRExists(prID string) (bool, error)
 
 	GetPRsByReviewer(userID string) ([]*entity.PullRequest, error)
+
+	GetReviewersWithOpenPRs() ([]string, error)
+
+	AddApproval(prID, reviewerID string) error
+
+	CountApprovals(prID string) (int, error)
+
+	CountOpenReviews(userIDs []string) (map[string]int, error)
 }
@@ -0,0 +1,11 @@
+package repo
+
+import "pr-review/internal/entity"
+
+type RepositoryRepository interface {
+	SaveRepository(repository *entity.Repository) error
+
+	GetRepository(name string) (*entity.Repository, error)
+
+	SetCodeOwners(name, content string) error
+}
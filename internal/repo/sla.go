@@ -0,0 +1,26 @@
+package repo
+
+import (
+	"pr-review/internal/entity"
+	"time"
+)
+
+type SLARepository interface {
+	GetSettings(teamName string) (*entity.SLASettings, error)
+
+	SaveSettings(settings *entity.SLASettings) error
+
+	ListSettings() ([]*entity.SLASettings, error)
+
+	FindReviewBreaches(teamName string, assignedBefore time.Time) ([]*entity.SLABreach, error)
+
+	FindMergeBreaches(teamName string, createdBefore time.Time) ([]*entity.SLABreach, error)
+
+	RecordBreach(breach *entity.SLABreach) error
+
+	MarkReassigned(breachID int64, newReviewerID string) error
+
+	ResolveBreaches(at time.Time) error
+
+	ListBreaches(filter entity.SLABreachFilter) ([]*entity.SLABreach, error)
+}
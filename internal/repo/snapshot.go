@@ -0,0 +1,13 @@
+package repo
+
+import "pr-review/internal/entity"
+
+type SnapshotRepository interface {
+	SchemaVersion() (int, error)
+
+	CountRows(tables []string) (map[string]int64, error)
+
+	Dump(tables []string) ([]*entity.SnapshotTable, error)
+
+	Restore(tables []*entity.SnapshotTable) error
+}
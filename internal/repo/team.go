@@ -8,4 +8,18 @@ type TeamRepository interface {
 	GetTeam(teamName string) (*entity.Team, error)
 
 	TeamExists(teamName string) (bool, error)
+
+	SetReviewerPools(teamName string, fallbackTeams, sharedReviewers []string) error
+
+	GetPolicy(teamName string) (*entity.TeamPolicy, error)
+
+	SavePolicy(policy *entity.TeamPolicy) error
+
+	SetParentTeam(teamName, parentTeam string) error
+
+	GetSubTeams(teamName string) ([]string, error)
+
+	GetTeamAncestors(teamName string, maxDepth int) ([]string, error)
+
+	GetTeamStats(teamNames []string) (*entity.TeamStats, error)
 }
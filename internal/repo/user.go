@@ -1,15 +1,34 @@
 package repo
 
-import "pr-review/internal/entity"
+import (
+	"pr-review/internal/entity"
+	"time"
+)
 
 type UserRepository interface {
 	GetUser(userID string) (*entity.User, error)
 
+	GetUserIncludingDeleted(userID string) (*entity.User, error)
+
 	CreateOrUpdateUser(user *entity.User) error
 
 	UpdateUser(user *entity.User) error
 
+	UpdateUserWithReassignments(user *entity.User, reassignments []entity.ReviewerReassignment) error
+
 	GetUsersByTeam(teamName string) ([]*entity.User, error)
 
 	GetActiveUsersByTeam(teamName string) ([]*entity.User, error)
+
+	SetWorkSchedule(userID string, schedule *entity.WorkSchedule) error
+
+	GetWorkSchedules(userIDs []string) (map[string]*entity.WorkSchedule, error)
+
+	ListUsers(filter entity.UserFilter) ([]*entity.User, error)
+
+	UpdateUsername(userID, username string) error
+
+	GetDeletedUserIDs(userIDs []string) ([]string, error)
+
+	DeleteUser(userID string, reassignments []entity.ReviewerReassignment, deletedAt time.Time) error
 }
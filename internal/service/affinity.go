@@ -0,0 +1,104 @@
+package service
+
+import (
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/i18n"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+)
+
+type AffinityService struct {
+	affinityRepo repo.AffinityRepository
+	userRepo     repo.UserRepository
+}
+
+func NewAffinityService(affinityRepo repo.AffinityRepository, userRepo repo.UserRepository) *AffinityService {
+	return &AffinityService{
+		affinityRepo: affinityRepo,
+		userRepo:     userRepo,
+	}
+}
+
+func (s *AffinityService) ListAffinities(authorID string) ([]*entity.ReviewerAffinity, error) {
+	if err := s.requireUser("author", authorID); err != nil {
+		return nil, err
+	}
+
+	affinities, err := s.affinityRepo.ListAffinities(authorID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to list affinities for author %s: %v", authorID, err)
+		return nil, err
+	}
+	return affinities, nil
+}
+
+func (s *AffinityService) SetAffinity(affinity *entity.ReviewerAffinity) (*entity.ReviewerAffinity, error) {
+	if derr := validateAffinity(affinity); derr != nil {
+		return nil, derr
+	}
+	if err := s.requireUser("author", affinity.AuthorID); err != nil {
+		return nil, err
+	}
+	if err := s.requireUser("reviewer", affinity.ReviewerID); err != nil {
+		return nil, err
+	}
+
+	if err := s.affinityRepo.SaveAffinity(affinity); err != nil {
+		logging.Printf("ERROR: Failed to save affinity %s -> %s: %v", affinity.AuthorID, affinity.ReviewerID, err)
+		return nil, err
+	}
+	return affinity, nil
+}
+
+func (s *AffinityService) DeleteAffinity(authorID, reviewerID string) error {
+	deleted, err := s.affinityRepo.DeleteAffinity(authorID, reviewerID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to delete affinity %s -> %s: %v", authorID, reviewerID, err)
+		return err
+	}
+	if !deleted {
+		return entity.NewError(entity.ErrorCodeNotFound, "affinity", nil)
+	}
+	return nil
+}
+
+func (s *AffinityService) requireUser(role, userID string) error {
+	user, err := s.userRepo.GetUser(userID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get %s %s: %v", role, userID, err)
+		return err
+	}
+	if user == nil {
+		return entity.NewError(entity.ErrorCodeNotFound, role, nil)
+	}
+	return nil
+}
+
+func validateAffinity(affinity *entity.ReviewerAffinity) *entity.DomainError {
+	if affinity.AuthorID == "" {
+		return entity.NewValidationError("author_id", entity.RuleRequired, "pair_required", nil)
+	}
+	if affinity.ReviewerID == "" {
+		return entity.NewValidationError("reviewer_id", entity.RuleRequired, "pair_required", nil)
+	}
+	if len(affinity.AuthorID) > config.MaxStringLength {
+		return entity.NewValidationError("author_id", entity.RuleMaxLength, "pair_max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+	if len(affinity.ReviewerID) > config.MaxStringLength {
+		return entity.NewValidationError("reviewer_id", entity.RuleMaxLength, "pair_max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+	if affinity.AuthorID == affinity.ReviewerID {
+		return entity.NewValidationError("reviewer_id", entity.RuleConsistent, "pair_differ", nil)
+	}
+	if len(affinity.Reason) > config.MaxStringLength {
+		return entity.NewValidationError("reason", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+	if affinity.Blocked && affinity.Weight != 0 {
+		return entity.NewValidationError("weight", entity.RuleConsistent, "blocked_weight", nil)
+	}
+	if !affinity.Blocked && (affinity.Weight < 1 || affinity.Weight > config.MaxAffinityWeight) {
+		return entity.NewValidationError("weight", entity.RuleRange, "range", i18n.Params{"min": 1, "max": config.MaxAffinityWeight})
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/i18n"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+	"time"
+)
+
+type AvailabilityService struct {
+	availabilityRepo repo.AvailabilityRepository
+	userRepo         repo.UserRepository
+	prService        *PullRequestService
+}
+
+func NewAvailabilityService(
+	availabilityRepo repo.AvailabilityRepository,
+	userRepo repo.UserRepository,
+	prService *PullRequestService,
+) *AvailabilityService {
+	return &AvailabilityService{
+		availabilityRepo: availabilityRepo,
+		userRepo:         userRepo,
+		prService:        prService,
+	}
+}
+
+func (s *AvailabilityService) SetAway(userID string, from, until time.Time, reason string, handover bool) (*entity.AwayPeriod, *entity.ReassignmentReport, error) {
+	if derr := s.prService.validateField("user_id", userID); derr != nil {
+		return nil, nil, derr
+	}
+	if len(reason) > config.MaxStringLength {
+		return nil, nil, entity.NewValidationError("reason", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+	if !until.After(from) {
+		return nil, nil, entity.NewValidationError("until", entity.RuleConsistent, "until_after_from", nil)
+	}
+
+	now := time.Now().UTC()
+	if !until.After(now) {
+		return nil, nil, entity.NewValidationError("until", entity.RuleConsistent, "until_future", nil)
+	}
+
+	user, err := s.userRepo.GetUser(userID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get user %s: %v", userID, err)
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, entity.NewError(entity.ErrorCodeNotFound, "user", nil)
+	}
+
+	period := &entity.AwayPeriod{
+		UserID:   userID,
+		From:     from.UTC(),
+		Until:    until.UTC(),
+		Reason:   reason,
+		Handover: handover,
+	}
+	if err := s.availabilityRepo.CreateAwayPeriod(period); err != nil {
+		logging.Printf("ERROR: Failed to create away period for user %s: %v", userID, err)
+		return nil, nil, err
+	}
+
+	if !period.Handover || !period.IsActiveAt(now) {
+		return period, nil, nil
+	}
+
+	report, err := s.handOver(period, user, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	return period, report, nil
+}
+
+func (s *AvailabilityService) ProcessWindows(now time.Time) error {
+	pending, err := s.availabilityRepo.GetPendingHandovers(now)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get pending handovers: %v", err)
+		return err
+	}
+
+	for _, period := range pending {
+		user, err := s.userRepo.GetUser(period.UserID)
+		if err != nil {
+			logging.Printf("ERROR: Failed to get user %s: %v", period.UserID, err)
+			return err
+		}
+		if user == nil {
+			continue
+		}
+
+		report, err := s.handOver(period, user, now)
+		if err != nil {
+			return err
+		}
+		logging.Printf("Handed over %d reviews of away user %s, %d left without replacement",
+			len(report.Reassignments), user.ID, len(report.UnreplacedPRs))
+	}
+
+	ended, err := s.availabilityRepo.GetEndedPeriods(now)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get ended away periods: %v", err)
+		return err
+	}
+
+	for _, period := range ended {
+		if err := s.availabilityRepo.MarkReturned(period.ID, now); err != nil {
+			logging.Printf("ERROR: Failed to mark away period %d as returned: %v", period.ID, err)
+			return err
+		}
+		logging.Printf("User %s is back from away period %d and is a review candidate again", period.UserID, period.ID)
+	}
+
+	return nil
+}
+
+func (s *AvailabilityService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.ProcessWindows(time.Now().UTC()); err != nil {
+				logging.Printf("ERROR: Failed to process away periods: %v", err)
+			}
+		}
+	}
+}
+
+func (s *AvailabilityService) handOver(period *entity.AwayPeriod, user *entity.User, now time.Time) (*entity.ReassignmentReport, error) {
+	report, err := s.prService.handOverReviews(user, func(reassignments []entity.ReviewerReassignment) error {
+		return s.availabilityRepo.CompleteHandover(period.ID, now, reassignments)
+	})
+	if err != nil {
+		logging.Printf("ERROR: Failed to hand over reviews for away period %d: %v", period.ID, err)
+		return nil, err
+	}
+	period.HandedOverAt = &now
+
+	return report, nil
+}
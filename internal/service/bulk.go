@@ -0,0 +1,415 @@
+package service
+
+import (
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/i18n"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+	"strconv"
+	"time"
+)
+
+type BulkService struct {
+	bulkRepo    repo.BulkRepository
+	teamRepo    repo.TeamRepository
+	userRepo    repo.UserRepository
+	prRepo      repo.PullRequestRepository
+	teamService *TeamService
+}
+
+func NewBulkService(
+	bulkRepo repo.BulkRepository,
+	teamRepo repo.TeamRepository,
+	userRepo repo.UserRepository,
+	prRepo repo.PullRequestRepository,
+	teamService *TeamService,
+) *BulkService {
+	return &BulkService{
+		bulkRepo:    bulkRepo,
+		teamRepo:    teamRepo,
+		userRepo:    userRepo,
+		prRepo:      prRepo,
+		teamService: teamService,
+	}
+}
+
+func (s *BulkService) Import(data *entity.BulkData, dryRun bool) (*entity.ImportReport, error) {
+	if data == nil {
+		data = &entity.BulkData{}
+	}
+	if len(data.Teams) > config.MaxImportRows || len(data.Users) > config.MaxImportRows || len(data.PullRequests) > config.MaxImportRows {
+		return nil, entity.NewValidationError("body", entity.RuleMaxItems, "import_rows", i18n.Params{"max": config.MaxImportRows})
+	}
+
+	report := &entity.ImportReport{
+		DryRun:       dryRun,
+		Teams:        len(data.Teams),
+		Users:        len(data.Users),
+		PullRequests: len(data.PullRequests),
+		Errors:       make([]entity.ImportError, 0),
+	}
+
+	v := &importValidator{
+		service:       s,
+		report:        report,
+		teams:         make(map[string]bool, len(data.Teams)),
+		users:         make(map[string]bool, len(data.Users)),
+		prs:           make(map[string]bool, len(data.PullRequests)),
+		deleted:       make(map[string]bool),
+		existingTeams: make(map[string]bool),
+		existingUsers: make(map[string]bool),
+		now:           time.Now(),
+	}
+	if err := v.validate(data); err != nil {
+		return nil, err
+	}
+
+	if dryRun || len(report.Errors) > 0 {
+		return report, nil
+	}
+
+	if err := s.bulkRepo.Import(data); err != nil {
+		logging.Printf("ERROR: Failed to import data: %v", err)
+		return nil, err
+	}
+	report.Applied = true
+	return report, nil
+}
+
+func (s *BulkService) Export() (*entity.BulkData, error) {
+	data, err := s.bulkRepo.Export()
+	if err != nil {
+		logging.Printf("ERROR: Failed to export data: %v", err)
+		return nil, err
+	}
+	return data, nil
+}
+
+type importValidator struct {
+	service       *BulkService
+	report        *entity.ImportReport
+	teams         map[string]bool
+	users         map[string]bool
+	prs           map[string]bool
+	deleted       map[string]bool
+	existingTeams map[string]bool
+	existingUsers map[string]bool
+	now           time.Time
+}
+
+func (v *importValidator) fail(resource entity.BulkResource, row int, id, message string) {
+	v.report.Errors = append(v.report.Errors, entity.ImportError{
+		Resource: resource,
+		Row:      row,
+		ID:       id,
+		Message:  message,
+	})
+}
+
+func (v *importValidator) validate(data *entity.BulkData) error {
+	for i := range data.Teams {
+		if err := v.validateTeam(i+1, &data.Teams[i]); err != nil {
+			return err
+		}
+	}
+
+	userIDs := make([]string, 0, len(data.Users))
+	for _, user := range data.Users {
+		if user.ID != "" && len(user.ID) <= config.MaxStringLength {
+			userIDs = append(userIDs, user.ID)
+		}
+	}
+	deleted, err := v.service.userRepo.GetDeletedUserIDs(userIDs)
+	if err != nil {
+		logging.Printf("ERROR: Failed to check deleted users for import: %v", err)
+		return err
+	}
+	for _, id := range deleted {
+		v.deleted[id] = true
+	}
+
+	for i := range data.Users {
+		if err := v.validateUser(i+1, &data.Users[i]); err != nil {
+			return err
+		}
+	}
+	for i := range data.Teams {
+		if err := v.validateTeamReferences(i+1, &data.Teams[i]); err != nil {
+			return err
+		}
+	}
+	for i := range data.PullRequests {
+		if err := v.validatePullRequest(i+1, &data.PullRequests[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *importValidator) validateTeam(row int, team *entity.BulkTeam) error {
+	resource := entity.BulkResourceTeams
+	switch {
+	case team.Name == "":
+		v.fail(resource, row, "", "team_name cannot be empty")
+		return nil
+	case len(team.Name) > config.MaxStringLength:
+		v.fail(resource, row, "", "team_name cannot exceed 255 characters")
+		return nil
+	case v.teams[team.Name]:
+		v.fail(resource, row, team.Name, "duplicate team_name")
+		return nil
+	}
+	v.teams[team.Name] = true
+
+	if team.RequiredReviewers == nil {
+		required := config.DefaultReviewers
+		team.RequiredReviewers = &required
+	}
+	if team.SelectionStrategy == "" {
+		team.SelectionStrategy = entity.SelectionStrategyRandom
+	}
+	if team.AllowSelfMerge == nil {
+		allow := true
+		team.AllowSelfMerge = &allow
+	}
+	if team.CompositionRules == nil {
+		team.CompositionRules = []entity.CompositionRule{}
+	}
+	if team.FallbackTeams == nil {
+		team.FallbackTeams = []string{}
+	}
+	if team.SharedReviewers == nil {
+		team.SharedReviewers = []string{}
+	}
+	if derr := validateTeamPolicy(team.Policy()); derr != nil {
+		v.fail(resource, row, team.Name, derr.Message)
+	}
+
+	exists, err := v.service.teamRepo.TeamExists(team.Name)
+	if err != nil {
+		logging.Printf("ERROR: Failed to check if team exists: %v", err)
+		return err
+	}
+	if exists {
+		v.fail(resource, row, team.Name, "team_name already exists")
+	}
+	return nil
+}
+
+func (v *importValidator) validateTeamReferences(row int, team *entity.BulkTeam) error {
+	resource := entity.BulkResourceTeams
+	if team.Name == "" || len(team.Name) > config.MaxStringLength {
+		return nil
+	}
+
+	if team.SecurityTeam != "" && len(team.SecurityTeam) <= config.MaxStringLength {
+		exists, err := v.teamExists(team.SecurityTeam)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			v.fail(resource, row, team.Name, "security team "+team.SecurityTeam+" not found")
+		}
+	}
+
+	if len(team.FallbackTeams) > config.MaxFallbackTeams {
+		v.fail(resource, row, team.Name, "fallback_teams cannot contain more than "+strconv.Itoa(config.MaxFallbackTeams)+" teams")
+	}
+	seenTeams := make(map[string]bool, len(team.FallbackTeams))
+	for _, fallback := range team.FallbackTeams {
+		switch {
+		case fallback == "" || len(fallback) > config.MaxStringLength:
+			v.fail(resource, row, team.Name, "fallback team name must be 1-255 characters")
+		case fallback == team.Name:
+			v.fail(resource, row, team.Name, "team cannot be its own fallback")
+		case seenTeams[fallback]:
+			v.fail(resource, row, team.Name, "duplicate fallback team "+fallback)
+		default:
+			exists, err := v.teamExists(fallback)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				v.fail(resource, row, team.Name, "fallback team "+fallback+" not found")
+			}
+		}
+		seenTeams[fallback] = true
+	}
+
+	if len(team.SharedReviewers) > config.MaxTeamMembers {
+		v.fail(resource, row, team.Name, "shared_reviewers cannot contain more than "+strconv.Itoa(config.MaxTeamMembers)+" users")
+	}
+	seenUsers := make(map[string]bool, len(team.SharedReviewers))
+	for _, userID := range team.SharedReviewers {
+		switch {
+		case userID == "" || len(userID) > config.MaxStringLength:
+			v.fail(resource, row, team.Name, "shared reviewer user_id must be 1-255 characters")
+		case seenUsers[userID]:
+			v.fail(resource, row, team.Name, "duplicate shared reviewer "+userID)
+		default:
+			exists, err := v.userExists(userID)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				v.fail(resource, row, team.Name, "shared reviewer "+userID+" not found")
+			}
+		}
+		seenUsers[userID] = true
+	}
+	return nil
+}
+
+func (v *importValidator) validateUser(row int, user *entity.User) error {
+	resource := entity.BulkResourceUsers
+	if user.Team == "" {
+		v.fail(resource, row, user.ID, "member team_name cannot be empty")
+		return nil
+	}
+	if derr := v.service.teamService.validateTeamMember(user, user.Team); derr != nil {
+		v.fail(resource, row, user.ID, derr.Message)
+		return nil
+	}
+	if v.users[user.ID] {
+		v.fail(resource, row, user.ID, "duplicate user_id")
+		return nil
+	}
+	v.users[user.ID] = true
+
+	if v.deleted[user.ID] {
+		v.fail(resource, row, user.ID, "user "+user.ID+" has been deleted")
+	} else {
+		existing, err := v.service.userRepo.GetUser(user.ID)
+		if err != nil {
+			logging.Printf("ERROR: Failed to get user %s: %v", user.ID, err)
+			return err
+		}
+		if existing != nil && existing.Team != user.Team {
+			v.fail(resource, row, user.ID, "user "+user.ID+" already belongs to team "+existing.Team)
+		}
+	}
+
+	exists, err := v.teamExists(user.Team)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		v.fail(resource, row, user.ID, "team not found")
+	}
+	return nil
+}
+
+func (v *importValidator) validatePullRequest(row int, pr *entity.BulkPullRequest) error {
+	resource := entity.BulkResourcePullRequests
+	switch {
+	case pr.ID == "":
+		v.fail(resource, row, "", "pull_request_id cannot be empty")
+		return nil
+	case len(pr.ID) > config.MaxStringLength:
+		v.fail(resource, row, "", "pull_request_id cannot exceed 255 characters")
+		return nil
+	case v.prs[pr.ID]:
+		v.fail(resource, row, pr.ID, "duplicate pull_request_id")
+		return nil
+	}
+	v.prs[pr.ID] = true
+
+	if pr.Name == "" {
+		v.fail(resource, row, pr.ID, "pull_request_name cannot be empty")
+	} else if len(pr.Name) > config.MaxStringLength {
+		v.fail(resource, row, pr.ID, "pull_request_name cannot exceed 255 characters")
+	}
+
+	if pr.Status == "" {
+		pr.Status = entity.StatusOpen
+	}
+	if pr.Status != entity.StatusOpen && pr.Status != entity.StatusMerged {
+		v.fail(resource, row, pr.ID, "status must be one of: OPEN, MERGED")
+	}
+	if pr.CreatedAt == nil {
+		pr.CreatedAt = &v.now
+	}
+	if pr.Status == entity.StatusMerged && pr.MergedAt == nil {
+		pr.MergedAt = &v.now
+	}
+	if pr.Status == entity.StatusOpen && pr.MergedAt != nil {
+		v.fail(resource, row, pr.ID, "mergedAt can only be set for MERGED pull requests")
+	}
+
+	exists, err := v.service.prRepo.PRExists(pr.ID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to check if PR exists: %v", err)
+		return err
+	}
+	if exists {
+		v.fail(resource, row, pr.ID, "PR id already exists")
+	}
+
+	author, err := v.userExists(pr.AuthorID)
+	if err != nil {
+		return err
+	}
+	if !author {
+		v.fail(resource, row, pr.ID, "author not found")
+	}
+
+	if len(pr.AssignedReviewers) > config.DefaultReviewers {
+		v.fail(resource, row, pr.ID, "assigned_reviewers cannot contain more than 2 reviewers")
+		return nil
+	}
+	seen := make(map[string]bool, len(pr.AssignedReviewers))
+	for _, reviewer := range pr.AssignedReviewers {
+		switch {
+		case reviewer == pr.AuthorID:
+			v.fail(resource, row, pr.ID, "author cannot review their own pull request")
+		case seen[reviewer]:
+			v.fail(resource, row, pr.ID, "duplicate reviewer "+reviewer)
+		default:
+			exists, err := v.userExists(reviewer)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				v.fail(resource, row, pr.ID, "reviewer "+reviewer+" not found")
+			}
+		}
+		seen[reviewer] = true
+	}
+	return nil
+}
+
+func (v *importValidator) teamExists(name string) (bool, error) {
+	if v.teams[name] {
+		return true, nil
+	}
+	if exists, ok := v.existingTeams[name]; ok {
+		return exists, nil
+	}
+	exists, err := v.service.teamRepo.TeamExists(name)
+	if err != nil {
+		logging.Printf("ERROR: Failed to check if team exists: %v", err)
+		return false, err
+	}
+	v.existingTeams[name] = exists
+	return exists, nil
+}
+
+func (v *importValidator) userExists(userID string) (bool, error) {
+	if userID == "" || len(userID) > config.MaxStringLength {
+		return false, nil
+	}
+	if v.users[userID] {
+		return !v.deleted[userID], nil
+	}
+	if exists, ok := v.existingUsers[userID]; ok {
+		return exists, nil
+	}
+	user, err := v.service.userRepo.GetUser(userID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get user %s: %v", userID, err)
+		return false, err
+	}
+	v.existingUsers[userID] = user != nil
+	return user != nil, nil
+}
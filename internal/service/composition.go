@@ -0,0 +1,136 @@
+package service
+
+import (
+	"errors"
+	"pr-review/internal/entity"
+	"pr-review/internal/i18n"
+	"pr-review/internal/logging"
+)
+
+type reviewerSelection struct {
+	author   *entity.User
+	policy   *entity.TeamPolicy
+	pools    [][]*entity.User
+	weights  map[string]int
+	assigned []string
+	areas    []string
+
+	securityTeam string
+}
+
+func (s *PullRequestService) chooseReviewers(selection *reviewerSelection, n int) ([]string, error) {
+	rules := applicableRules(selection.policy.CompositionRules, selection.author)
+	if selection.securityTeam != "" && n > 0 {
+		rules = append(rules, entity.CompositionRule{Team: selection.securityTeam, AtLeast: 1})
+	}
+	if len(rules) == 0 {
+		return s.pickFromPools(selection, n)
+	}
+
+	ordered, err := s.orderedCandidates(selection)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.assignedUsers(selection.assigned)
+	if err != nil {
+		return nil, err
+	}
+
+	chosen := make([]string, 0, n)
+	taken := make(map[string]bool, len(ordered))
+	fits := func(candidate *entity.User) bool {
+		for _, rule := range rules {
+			if rule.AtMost != nil && rule.Matches(candidate) && rule.Count(current) >= *rule.AtMost {
+				return false
+			}
+		}
+		return true
+	}
+	take := func(candidate *entity.User) {
+		taken[candidate.ID] = true
+		current = append(current, candidate)
+		chosen = append(chosen, candidate.ID)
+	}
+
+	for _, rule := range rules {
+		for _, candidate := range ordered {
+			if len(chosen) >= n || rule.Count(current) >= rule.AtLeast {
+				break
+			}
+			if !taken[candidate.ID] && rule.Matches(candidate) && fits(candidate) {
+				take(candidate)
+			}
+		}
+	}
+
+	for _, candidate := range ordered {
+		if len(chosen) >= n {
+			break
+		}
+		if !taken[candidate.ID] && fits(candidate) {
+			take(candidate)
+		}
+	}
+
+	for _, rule := range rules {
+		if have := rule.Count(current); have < rule.AtLeast {
+			return nil, entity.NewError(entity.ErrorCodeNoCandidate, "composition_unsatisfied", i18n.Params{"rule": rule.Describe(), "have": have})
+		}
+	}
+	if n > 0 && len(ordered) > 0 && len(chosen) == 0 {
+		return nil, entity.NewError(entity.ErrorCodeNoCandidate, "composition", nil)
+	}
+
+	return chosen, nil
+}
+
+func (s *PullRequestService) orderedCandidates(selection *reviewerSelection) ([]*entity.User, error) {
+	ids, err := s.pickFromPools(selection, countCandidates(selection.pools))
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*entity.User, len(ids))
+	for _, pool := range selection.pools {
+		for _, user := range pool {
+			byID[user.ID] = user
+		}
+	}
+
+	ordered := make([]*entity.User, 0, len(ids))
+	for _, id := range ids {
+		ordered = append(ordered, byID[id])
+	}
+	return ordered, nil
+}
+
+func (s *PullRequestService) assignedUsers(userIDs []string) ([]*entity.User, error) {
+	users := make([]*entity.User, 0, len(userIDs))
+	for _, userID := range userIDs {
+		user, err := s.userRepo.GetUser(userID)
+		if err != nil {
+			logging.Printf("ERROR: Failed to get user %s: %v", userID, err)
+			return nil, err
+		}
+		if user != nil {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+func applicableRules(rules []entity.CompositionRule, author *entity.User) []entity.CompositionRule {
+	applicable := make([]entity.CompositionRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.AppliesTo(author) {
+			applicable = append(applicable, rule)
+		}
+	}
+	return applicable
+}
+
+func isNoCandidate(err error) bool {
+	var derr *entity.DomainError
+	return errors.As(err, &derr) && derr.Code == entity.ErrorCodeNoCandidate
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"net/mail"
+	"pr-review/internal/config"
+	"pr-review/internal/cron"
+	"pr-review/internal/entity"
+	"pr-review/internal/i18n"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+	"sort"
+	"time"
+)
+
+type Notifier interface {
+	Send(digest *entity.ReviewDigest) error
+}
+
+type DigestService struct {
+	digestRepo repo.DigestRepository
+	prRepo     repo.PullRequestRepository
+	userRepo   repo.UserRepository
+	notifier   Notifier
+}
+
+func NewDigestService(
+	digestRepo repo.DigestRepository,
+	prRepo repo.PullRequestRepository,
+	userRepo repo.UserRepository,
+	notifier Notifier,
+) *DigestService {
+	return &DigestService{
+		digestRepo: digestRepo,
+		prRepo:     prRepo,
+		userRepo:   userRepo,
+		notifier:   notifier,
+	}
+}
+
+func (s *DigestService) GetPreferences(userID string) (*entity.DigestPreferences, error) {
+	if _, err := s.getUser(userID); err != nil {
+		return nil, err
+	}
+	return s.preferencesOrDefault(userID)
+}
+
+func (s *DigestService) SetPreferences(prefs *entity.DigestPreferences) (*entity.DigestPreferences, error) {
+	if _, err := s.getUser(prefs.UserID); err != nil {
+		return nil, err
+	}
+
+	if prefs.Frequency == "" {
+		prefs.Frequency = entity.DigestFrequencyDaily
+	}
+	if !prefs.Frequency.IsValid() {
+		return nil, entity.NewValidationError("frequency", entity.RuleEnum, "one_of", i18n.Params{"values": "hourly, daily, weekly"})
+	}
+	if len(prefs.Email) > config.MaxStringLength {
+		return nil, entity.NewValidationError("email", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+	if prefs.Email != "" {
+		addr, err := mail.ParseAddress(prefs.Email)
+		if err != nil {
+			return nil, entity.NewValidationError("email", entity.RuleFormat, "email", nil)
+		}
+		prefs.Email = addr.Address
+	}
+
+	if err := s.digestRepo.SavePreferences(prefs); err != nil {
+		logging.Printf("ERROR: Failed to save digest preferences for user %s: %v", prefs.UserID, err)
+		return nil, err
+	}
+	return prefs, nil
+}
+
+func (s *DigestService) BuildDigest(user *entity.User, now time.Time) (*entity.ReviewDigest, error) {
+	prs, err := s.prRepo.GetPRsByReviewer(user.ID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get PRs for reviewer %s: %v", user.ID, err)
+		return nil, err
+	}
+
+	items := make([]entity.DigestItem, 0, len(prs))
+	for _, pr := range prs {
+		if pr.Status != entity.StatusOpen {
+			continue
+		}
+		createdAt := now
+		if pr.CreatedAt != nil {
+			createdAt = *pr.CreatedAt
+		}
+		items = append(items, entity.DigestItem{
+			PullRequestID:   pr.ID,
+			PullRequestName: pr.Name,
+			AuthorID:        pr.AuthorID,
+			CreatedAt:       createdAt,
+			AgeSeconds:      int64(now.Sub(createdAt).Seconds()),
+		})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].CreatedAt.Before(items[j].CreatedAt)
+	})
+
+	return &entity.ReviewDigest{
+		UserID:      user.ID,
+		Username:    user.Name,
+		GeneratedAt: now,
+		Items:       items,
+	}, nil
+}
+
+func (s *DigestService) SendDigests(now time.Time) (int, error) {
+	reviewerIDs, err := s.prRepo.GetReviewersWithOpenPRs()
+	if err != nil {
+		logging.Printf("ERROR: Failed to get reviewers with open PRs: %v", err)
+		return 0, err
+	}
+
+	sent := 0
+	for _, reviewerID := range reviewerIDs {
+		prefs, err := s.preferencesOrDefault(reviewerID)
+		if err != nil {
+			return sent, err
+		}
+		if !s.isDue(prefs, now) {
+			continue
+		}
+
+		user, err := s.userRepo.GetUser(reviewerID)
+		if err != nil {
+			logging.Printf("ERROR: Failed to get user %s: %v", reviewerID, err)
+			return sent, err
+		}
+		if user == nil || !user.IsActive {
+			continue
+		}
+
+		digest, err := s.BuildDigest(user, now)
+		if err != nil {
+			return sent, err
+		}
+		if len(digest.Items) == 0 {
+			continue
+		}
+		digest.Email = prefs.Email
+
+		if err := s.notifier.Send(digest); err != nil {
+			logging.Printf("ERROR: Failed to send digest to %s: %v", reviewerID, err)
+			continue
+		}
+		if err := s.digestRepo.MarkDigestSent(reviewerID, now); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (s *DigestService) Run(ctx context.Context, schedule *cron.Schedule) {
+	for {
+		next := schedule.Next(time.Now())
+		if next.IsZero() {
+			logging.Printf("ERROR: Digest schedule never fires, stopping digest job")
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			sent, err := s.SendDigests(time.Now().UTC())
+			if err != nil {
+				logging.Printf("ERROR: Failed to send review digests: %v", err)
+				continue
+			}
+			logging.Printf("Sent %d review digests", sent)
+		}
+	}
+}
+
+func (s *DigestService) isDue(prefs *entity.DigestPreferences, now time.Time) bool {
+	if !prefs.Enabled {
+		return false
+	}
+	if prefs.LastSentAt == nil {
+		return true
+	}
+	return now.Sub(*prefs.LastSentAt) >= prefs.Frequency.Interval()-config.DigestIntervalSlack
+}
+
+func (s *DigestService) preferencesOrDefault(userID string) (*entity.DigestPreferences, error) {
+	prefs, err := s.digestRepo.GetPreferences(userID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get digest preferences for user %s: %v", userID, err)
+		return nil, err
+	}
+	if prefs == nil {
+		return &entity.DigestPreferences{
+			UserID:    userID,
+			Enabled:   true,
+			Frequency: entity.DigestFrequencyDaily,
+		}, nil
+	}
+	return prefs, nil
+}
+
+func (s *DigestService) getUser(userID string) (*entity.User, error) {
+	if userID == "" {
+		return nil, entity.NewValidationError("user_id", entity.RuleRequired, "required", nil)
+	}
+	if len(userID) > config.MaxStringLength {
+		return nil, entity.NewValidationError("user_id", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+
+	user, err := s.userRepo.GetUser(userID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get user %s: %v", userID, err)
+		return nil, err
+	}
+	if user == nil {
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "user", nil)
+	}
+	return user, nil
+}
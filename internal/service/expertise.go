@@ -0,0 +1,127 @@
+package service
+
+import (
+	crand "crypto/rand"
+	"math/big"
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+	"sort"
+	"time"
+)
+
+type ExpertiseService struct {
+	expertiseRepo repo.ExpertiseRepository
+	userRepo      repo.UserRepository
+}
+
+func NewExpertiseService(expertiseRepo repo.ExpertiseRepository, userRepo repo.UserRepository) *ExpertiseService {
+	return &ExpertiseService{
+		expertiseRepo: expertiseRepo,
+		userRepo:      userRepo,
+	}
+}
+
+func (s *ExpertiseService) GetExpertise(userID string, at time.Time) ([]*entity.ExpertiseScore, error) {
+	if userID == "" {
+		return nil, entity.NewValidationError("user_id", entity.RuleRequired, "required", nil)
+	}
+
+	user, err := s.userRepo.GetUser(userID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get user %s: %v", userID, err)
+		return nil, err
+	}
+	if user == nil {
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "user", nil)
+	}
+
+	scores, err := s.expertiseRepo.GetUserExpertise(userID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get expertise for user %s: %v", userID, err)
+		return nil, err
+	}
+
+	result := make([]*entity.ExpertiseScore, 0, len(scores))
+	for _, score := range scores {
+		decayed := score.DecayedAt(at, config.ExpertiseHalfLife)
+		if decayed < config.MinExpertiseScore {
+			continue
+		}
+		result = append(result, &entity.ExpertiseScore{
+			UserID:    score.UserID,
+			Area:      score.Area,
+			Score:     decayed,
+			UpdatedAt: score.UpdatedAt,
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Score > result[j].Score
+	})
+	return result, nil
+}
+
+func (s *PullRequestService) selectByExpertise(candidates []*entity.User, n int, areas []string, at time.Time, weights map[string]int) ([]string, error) {
+	if len(areas) == 0 {
+		return s.selectReviewers(candidates, n, weights), nil
+	}
+	if n > len(candidates) {
+		n = len(candidates)
+	}
+
+	userIDs := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		userIDs[i] = candidate.ID
+	}
+
+	scores, err := s.expertiseRepo.GetScores(userIDs, areas)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get expertise scores: %v", err)
+		return nil, err
+	}
+
+	totals := make(map[string]float64, len(candidates))
+	for _, score := range scores {
+		totals[score.UserID] += score.DecayedAt(at, config.ExpertiseHalfLife)
+	}
+
+	shuffled := s.weightedShuffle(candidates, weights)
+	keys := make(map[string]float64, len(shuffled))
+	for _, candidate := range shuffled {
+		keys[candidate.ID] = totals[candidate.ID] * (1 - config.ExpertiseRandomness*randomFraction())
+	}
+
+	sort.SliceStable(shuffled, func(i, j int) bool {
+		return keys[shuffled[i].ID] > keys[shuffled[j].ID]
+	})
+
+	result := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		result = append(result, shuffled[i].ID)
+	}
+	return result, nil
+}
+
+func (s *PullRequestService) recordExpertise(pr *entity.PullRequest, at time.Time) {
+	areas := entity.ExpertiseAreas(pr, config.ExpertisePathDepth)
+	if len(areas) == 0 {
+		return
+	}
+	for _, reviewerID := range pr.AssignedReviewers {
+		if err := s.expertiseRepo.RecordReview(reviewerID, areas, at); err != nil {
+			logging.Printf("ERROR: Failed to record expertise for reviewer %s on PR %s: %v", reviewerID, pr.ID, err)
+		}
+	}
+}
+
+func randomFraction() float64 {
+	const precision = 1 << 53
+	value, err := crand.Int(crand.Reader, big.NewInt(precision))
+	if err != nil {
+		logging.Printf("ERROR: failed to generate crypto random: %v", err)
+		return 0
+	}
+	return float64(value.Int64()) / precision
+}
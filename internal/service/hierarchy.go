@@ -0,0 +1,230 @@
+package service
+
+import (
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/i18n"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+)
+
+func (s *TeamService) SetParentTeam(teamName, parentTeam string) (*entity.Team, error) {
+	if _, err := s.GetTeam(teamName); err != nil {
+		return nil, err
+	}
+
+	if parentTeam != "" {
+		if parentTeam == teamName {
+			return nil, entity.NewValidationError("parent_team", entity.RuleConsistent, "own_parent", nil)
+		}
+		if _, err := s.GetTeam(parentTeam); err != nil {
+			return nil, err
+		}
+
+		ancestors, err := teamAncestors(s.teamRepo, parentTeam)
+		if err != nil {
+			return nil, err
+		}
+		for _, ancestor := range ancestors {
+			if ancestor == teamName {
+				return nil, entity.NewValidationError("parent_team", entity.RuleConsistent, "hierarchy_cycle", nil)
+			}
+		}
+
+		subTeams, err := subTeamTree(s.teamRepo, teamName, map[string]bool{teamName: true})
+		if err != nil {
+			return nil, err
+		}
+		if len(ancestors)+2+treeHeight(subTeams) > config.MaxTeamDepth {
+			return nil, entity.NewValidationError("parent_team", entity.RuleMaximum, "hierarchy_depth", i18n.Params{"max": config.MaxTeamDepth})
+		}
+	}
+
+	if err := s.teamRepo.SetParentTeam(teamName, parentTeam); err != nil {
+		logging.Printf("ERROR: Failed to set parent of team %s: %v", teamName, err)
+		return nil, err
+	}
+	return s.GetTeam(teamName)
+}
+
+func (s *TeamService) GetTeamWithSubTeams(teamName string) (*entity.Team, error) {
+	team, err := s.GetTeam(teamName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.loadSubTeams(team, map[string]bool{teamName: true}); err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+func (s *TeamService) GetHierarchy(teamName string) (*entity.TeamHierarchy, error) {
+	if _, err := s.GetTeam(teamName); err != nil {
+		return nil, err
+	}
+
+	ancestors, err := teamAncestors(s.teamRepo, teamName)
+	if err != nil {
+		return nil, err
+	}
+	subTeams, err := subTeamTree(s.teamRepo, teamName, map[string]bool{teamName: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.TeamHierarchy{TeamName: teamName, Ancestors: ancestors, SubTeams: subTeams}, nil
+}
+
+func (s *TeamService) GetStats(teamName string, includeSubTeams bool) (*entity.TeamStats, error) {
+	if _, err := s.GetTeam(teamName); err != nil {
+		return nil, err
+	}
+
+	teamNames := []string{teamName}
+	if includeSubTeams {
+		subTeams, err := subTeamTree(s.teamRepo, teamName, map[string]bool{teamName: true})
+		if err != nil {
+			return nil, err
+		}
+		teamNames = append(teamNames, flattenTeams(subTeams)...)
+	}
+
+	stats, err := s.teamRepo.GetTeamStats(teamNames)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get stats for team %s: %v", teamName, err)
+		return nil, err
+	}
+	stats.TeamName = teamName
+	return stats, nil
+}
+
+func (s *TeamService) loadSubTeams(team *entity.Team, visited map[string]bool) error {
+	if len(visited) > config.MaxTeamDepth*config.MaxTeamMembers {
+		return nil
+	}
+
+	names, err := s.teamRepo.GetSubTeams(team.Name)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get sub-teams of team %s: %v", team.Name, err)
+		return err
+	}
+
+	for _, name := range names {
+		if visited[name] {
+			continue
+		}
+		visited[name] = true
+
+		child, err := s.teamRepo.GetTeam(name)
+		if err != nil {
+			logging.Printf("ERROR: Failed to get team %s: %v", name, err)
+			return err
+		}
+		if child == nil {
+			continue
+		}
+		if err := s.loadSubTeams(child, visited); err != nil {
+			return err
+		}
+		team.SubTeams = append(team.SubTeams, child)
+	}
+	return nil
+}
+
+func (s *PullRequestService) extraPools(team *entity.Team, policy *entity.TeamPolicy, securityTeam string) ([][]*entity.User, error) {
+	extra := make([][]*entity.User, 0)
+	if policy.HierarchyFallback {
+		ancestors, err := teamAncestors(s.teamRepo, team.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		visited := map[string]bool{team.Name: true}
+		for _, ancestor := range ancestors {
+			visited[ancestor] = true
+			subTeams, err := subTeamTree(s.teamRepo, ancestor, visited)
+			if err != nil {
+				return nil, err
+			}
+
+			members, err := s.activeMembers(append([]string{ancestor}, flattenTeams(subTeams)...))
+			if err != nil {
+				return nil, err
+			}
+			extra = append(extra, members)
+		}
+	}
+
+	if securityTeam != "" {
+		members, err := s.activeMembers([]string{securityTeam})
+		if err != nil {
+			return nil, err
+		}
+		extra = append(extra, members)
+	}
+	return extra, nil
+}
+
+func (s *PullRequestService) activeMembers(teamNames []string) ([]*entity.User, error) {
+	members := make([]*entity.User, 0)
+	for _, teamName := range teamNames {
+		users, err := s.userRepo.GetActiveUsersByTeam(teamName)
+		if err != nil {
+			logging.Printf("ERROR: Failed to get active users for team %s: %v", teamName, err)
+			return nil, err
+		}
+		members = append(members, users...)
+	}
+	return members, nil
+}
+
+func teamAncestors(teamRepo repo.TeamRepository, teamName string) ([]string, error) {
+	ancestors, err := teamRepo.GetTeamAncestors(teamName, config.MaxTeamDepth)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get ancestors of team %s: %v", teamName, err)
+		return nil, err
+	}
+	return ancestors, nil
+}
+
+func subTeamTree(teamRepo repo.TeamRepository, teamName string, visited map[string]bool) ([]*entity.TeamNode, error) {
+	names, err := teamRepo.GetSubTeams(teamName)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get sub-teams of team %s: %v", teamName, err)
+		return nil, err
+	}
+
+	nodes := make([]*entity.TeamNode, 0, len(names))
+	for _, name := range names {
+		if visited[name] {
+			continue
+		}
+		visited[name] = true
+
+		children, err := subTeamTree(teamRepo, name, visited)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, &entity.TeamNode{Name: name, SubTeams: children})
+	}
+	return nodes, nil
+}
+
+func flattenTeams(nodes []*entity.TeamNode) []string {
+	names := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		names = append(names, node.Name)
+		names = append(names, flattenTeams(node.SubTeams)...)
+	}
+	return names
+}
+
+func treeHeight(nodes []*entity.TeamNode) int {
+	height := 0
+	for _, node := range nodes {
+		if h := 1 + treeHeight(node.SubTeams); h > height {
+			height = h
+		}
+	}
+	return height
+}
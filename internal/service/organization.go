@@ -0,0 +1,77 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+)
+
+type OrganizationService struct {
+	orgRepo repo.OrganizationRepository
+	config  *config.TenantConfig
+}
+
+func NewOrganizationService(orgRepo repo.OrganizationRepository, tenantConfig *config.TenantConfig) *OrganizationService {
+	return &OrganizationService{
+		orgRepo: orgRepo,
+		config:  tenantConfig,
+	}
+}
+
+func (s *OrganizationService) ResolveOrganization(token, orgID string) (*entity.Organization, error) {
+	if len(orgID) > config.MaxStringLength {
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "organization", nil)
+	}
+
+	if token != "" {
+		org, err := s.orgRepo.GetOrganizationByToken(HashToken(token))
+		if err != nil {
+			logging.Printf("ERROR: Failed to resolve access token: %v", err)
+			return nil, err
+		}
+		if org == nil {
+			return nil, entity.NewError(entity.ErrorCodeUnauthorized, "invalid_token", nil)
+		}
+		if orgID != "" && orgID != org.ID {
+			return nil, entity.NewError(entity.ErrorCodeForbidden, "", nil)
+		}
+		return org, nil
+	}
+
+	if orgID != "" && !s.config.AllowHeader {
+		return nil, entity.NewError(entity.ErrorCodeUnauthorized, "token_required", nil)
+	}
+	if orgID == "" {
+		if s.config.DefaultOrganization == config.NoDefaultOrganization {
+			return nil, entity.NewError(entity.ErrorCodeUnauthorized, "credentials_required", nil)
+		}
+		orgID = s.config.DefaultOrganization
+	}
+
+	org, err := s.orgRepo.GetOrganization(orgID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get organization %s: %v", orgID, err)
+		return nil, err
+	}
+	if org == nil {
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "organization", nil)
+	}
+	return org, nil
+}
+
+func (s *OrganizationService) ListOrganizations() ([]*entity.Organization, error) {
+	organizations, err := s.orgRepo.ListOrganizations()
+	if err != nil {
+		logging.Printf("ERROR: Failed to list organizations: %v", err)
+		return nil, err
+	}
+	return organizations, nil
+}
+
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
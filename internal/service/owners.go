@@ -0,0 +1,79 @@
+package service
+
+import (
+	"pr-review/internal/codeowners"
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/i18n"
+	"pr-review/internal/logging"
+	"strings"
+)
+
+func (s *PullRequestService) getRepository(name string) (*entity.Repository, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	repository, err := s.repositoryRepo.GetRepository(name)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get repository %s: %v", name, err)
+		return nil, err
+	}
+	return repository, nil
+}
+
+func (s *PullRequestService) codeOwnerCandidates(repository *entity.Repository, paths []string) ([]*entity.User, error) {
+	if repository == nil || repository.CodeOwners == "" || len(paths) == 0 {
+		return nil, nil
+	}
+
+	file, err := codeowners.Parse(repository.CodeOwners)
+	if err != nil {
+		logging.Printf("ERROR: Failed to parse CODEOWNERS for repository %s: %v", repository.Name, err)
+		return nil, err
+	}
+
+	candidates := make([]*entity.User, 0)
+	for _, owner := range file.OwnersForPaths(paths) {
+		users, err := s.resolveOwner(owner)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, users...)
+	}
+	return candidates, nil
+}
+
+func (s *PullRequestService) resolveOwner(owner string) ([]*entity.User, error) {
+	if !strings.HasPrefix(owner, "@") {
+		return nil, nil
+	}
+
+	name := strings.TrimPrefix(owner, "@")
+	if slash := strings.Index(name, "/"); slash >= 0 {
+		teamName := name[slash+1:]
+		members, err := s.userRepo.GetActiveUsersByTeam(teamName)
+		if err != nil {
+			logging.Printf("ERROR: Failed to get active users for team %s: %v", teamName, err)
+			return nil, err
+		}
+		return members, nil
+	}
+
+	return s.activeUsers([]string{name})
+}
+
+func validateChangedFiles(paths []string) *entity.DomainError {
+	if len(paths) > config.MaxChangedFiles {
+		return entity.NewValidationError("changed_files", entity.RuleMaxItems, "max_entries", i18n.Params{"max": config.MaxChangedFiles})
+	}
+	for _, path := range paths {
+		if strings.TrimSpace(path) == "" {
+			return entity.NewValidationError("changed_files", entity.RuleRequired, "changed_files_empty", nil)
+		}
+		if len(path) > config.MaxPathLength {
+			return entity.NewValidationError("changed_files", entity.RuleMaxLength, "changed_files_length", i18n.Params{"max": config.MaxPathLength})
+		}
+	}
+	return nil
+}
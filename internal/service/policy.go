@@ -0,0 +1,186 @@
+package service
+
+import (
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/i18n"
+	"pr-review/internal/logging"
+)
+
+func defaultTeamPolicy(team *entity.Team) *entity.TeamPolicy {
+	strategy := team.SelectionStrategy
+	if strategy == "" {
+		strategy = entity.SelectionStrategyRandom
+	}
+	return &entity.TeamPolicy{
+		TeamName:               team.Name,
+		RequiredReviewers:      config.DefaultReviewers,
+		SelectionStrategy:      strategy,
+		AllowSelfMerge:         true,
+		RequiredApprovals:      0,
+		ReassignOnDeactivation: team.ReassignOnDeactivation,
+		CompositionRules:       []entity.CompositionRule{},
+	}
+}
+
+func (s *PullRequestService) teamPolicy(team *entity.Team) (*entity.TeamPolicy, error) {
+	policy, err := s.teamRepo.GetPolicy(team.Name)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get policy for team %s: %v", team.Name, err)
+		return nil, err
+	}
+	if policy == nil {
+		return defaultTeamPolicy(team), nil
+	}
+	return policy, nil
+}
+
+func (s *PullRequestService) authorPolicy(authorID string) (*entity.TeamPolicy, error) {
+	author, err := s.userRepo.GetUser(authorID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get author %s: %v", authorID, err)
+		return nil, err
+	}
+	if author == nil {
+		return defaultTeamPolicy(&entity.Team{}), nil
+	}
+
+	team, err := s.teamRepo.GetTeam(author.Team)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get team %s: %v", author.Team, err)
+		return nil, err
+	}
+	if team == nil {
+		return defaultTeamPolicy(&entity.Team{Name: author.Team}), nil
+	}
+	return s.teamPolicy(team)
+}
+
+func (s *TeamService) GetPolicy(teamName string) (*entity.TeamPolicy, error) {
+	team, err := s.GetTeam(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.teamRepo.GetPolicy(teamName)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get policy for team %s: %v", teamName, err)
+		return nil, err
+	}
+	if policy == nil {
+		return defaultTeamPolicy(team), nil
+	}
+	return policy, nil
+}
+
+func (s *TeamService) SetPolicy(policy *entity.TeamPolicy) (*entity.TeamPolicy, error) {
+	if policy.SelectionStrategy == "" {
+		policy.SelectionStrategy = entity.SelectionStrategyRandom
+	}
+	if policy.CompositionRules == nil {
+		policy.CompositionRules = []entity.CompositionRule{}
+	}
+	if derr := validateTeamPolicy(policy); derr != nil {
+		return nil, derr
+	}
+
+	if _, err := s.GetTeam(policy.TeamName); err != nil {
+		return nil, err
+	}
+	if policy.SecurityTeam != "" {
+		if _, err := s.GetTeam(policy.SecurityTeam); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.teamRepo.SavePolicy(policy); err != nil {
+		logging.Printf("ERROR: Failed to save policy for team %s: %v", policy.TeamName, err)
+		return nil, err
+	}
+	return policy, nil
+}
+
+func validateTeamPolicy(policy *entity.TeamPolicy) *entity.DomainError {
+	if policy.RequiredReviewers < 0 || policy.RequiredReviewers > config.MaxRequiredReviewers {
+		return entity.NewValidationError("required_reviewers", entity.RuleRange, "range", i18n.Params{"min": 0, "max": config.MaxRequiredReviewers})
+	}
+	if policy.RequiredApprovals < 0 || policy.RequiredApprovals > policy.RequiredReviewers {
+		return entity.NewValidationError("required_approvals", entity.RuleRange, "range", i18n.Params{"min": 0, "max": "required_reviewers"})
+	}
+	if !policy.SelectionStrategy.IsValid() {
+		return entity.NewValidationError("selection_strategy", entity.RuleEnum, "one_of", i18n.Params{"values": "random, working_hours, expertise"})
+	}
+	if len(policy.SecurityTeam) > config.MaxStringLength {
+		return entity.NewValidationError("security_team", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+	if policy.MaxOpenReviews < 0 || policy.MaxOpenReviews > config.MaxOpenReviewsLimit {
+		return entity.NewValidationError("max_open_reviews", entity.RuleRange, "range", i18n.Params{"min": 0, "max": config.MaxOpenReviewsLimit})
+	}
+	if len(policy.CompositionRules) > config.MaxCompositionRules {
+		return entity.NewValidationError("composition_rules", entity.RuleMaxItems, "max_entries", i18n.Params{"max": config.MaxCompositionRules})
+	}
+	for _, rule := range policy.CompositionRules {
+		if derr := validateCompositionRule(rule, policy.RequiredReviewers); derr != nil {
+			return derr
+		}
+	}
+	return nil
+}
+
+func validateCompositionRule(rule entity.CompositionRule, requiredReviewers int) *entity.DomainError {
+	for _, grade := range []entity.Grade{rule.AuthorGrade, rule.MinGrade, rule.MaxGrade} {
+		if grade != "" && !grade.IsValid() {
+			return entity.NewValidationError("composition_rules", entity.RuleEnum, "composition_grades", i18n.Params{"values": "junior, middle, senior, lead"})
+		}
+	}
+	if rule.MinGrade != "" && rule.MaxGrade != "" && rule.MinGrade.Rank() > rule.MaxGrade.Rank() {
+		return entity.NewValidationError("composition_rules.min_grade", entity.RuleConsistent, "composition_grade_order", nil)
+	}
+	if rule.AtLeast < 0 || rule.AtLeast > requiredReviewers {
+		return entity.NewValidationError("composition_rules.at_least", entity.RuleRange, "composition_at_least", i18n.Params{"min": 0, "max": "required_reviewers"})
+	}
+	if rule.AtMost != nil && *rule.AtMost < rule.AtLeast {
+		return entity.NewValidationError("composition_rules.at_most", entity.RuleConsistent, "composition_at_most", nil)
+	}
+	return nil
+}
+
+func securityReviewTeam(policy *entity.TeamPolicy, pr *entity.PullRequest) string {
+	if policy.SecurityTeam == "" || !pr.HasLabel(entity.LabelSecurity) {
+		return ""
+	}
+	return policy.SecurityTeam
+}
+
+func (s *PullRequestService) withinCapacity(pools [][]*entity.User, policy *entity.TeamPolicy, pr *entity.PullRequest) ([][]*entity.User, error) {
+	if policy.MaxOpenReviews <= 0 || pr.HasLabel(entity.LabelHotfix) {
+		return pools, nil
+	}
+
+	userIDs := make([]string, 0, countCandidates(pools))
+	for _, pool := range pools {
+		for _, user := range pool {
+			userIDs = append(userIDs, user.ID)
+		}
+	}
+
+	openReviews, err := s.prRepo.CountOpenReviews(userIDs)
+	if err != nil {
+		logging.Printf("ERROR: Failed to count open reviews: %v", err)
+		return nil, err
+	}
+
+	available := make([][]*entity.User, 0, len(pools))
+	for _, pool := range pools {
+		kept := make([]*entity.User, 0, len(pool))
+		for _, user := range pool {
+			if openReviews[user.ID] < policy.MaxOpenReviews {
+				kept = append(kept, user)
+			}
+		}
+		if len(kept) > 0 {
+			available = append(available, kept)
+		}
+	}
+	return available, nil
+}
@@ -2,33 +2,54 @@ package service
 
 import (
 	crand "crypto/rand"
+	"errors"
 	"math/big"
 	"pr-review/internal/config"
 	"pr-review/internal/entity"
+	"pr-review/internal/i18n"
 	"pr-review/internal/logging"
 	"pr-review/internal/repo"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 type PullRequestService struct {
-	prRepo   repo.PullRequestRepository
-	userRepo repo.UserRepository
-	teamRepo repo.TeamRepository
+	prRepo           repo.PullRequestRepository
+	userRepo         repo.UserRepository
+	teamRepo         repo.TeamRepository
+	availabilityRepo repo.AvailabilityRepository
+	affinityRepo     repo.AffinityRepository
+	repositoryRepo   repo.RepositoryRepository
+	expertiseRepo    repo.ExpertiseRepository
 }
 
 func NewPullRequestService(
 	prRepo repo.PullRequestRepository,
 	userRepo repo.UserRepository,
 	teamRepo repo.TeamRepository,
+	availabilityRepo repo.AvailabilityRepository,
+	affinityRepo repo.AffinityRepository,
+	repositoryRepo repo.RepositoryRepository,
+	expertiseRepo repo.ExpertiseRepository,
 ) *PullRequestService {
 	return &PullRequestService{
-		prRepo:   prRepo,
-		userRepo: userRepo,
-		teamRepo: teamRepo,
+		prRepo:           prRepo,
+		userRepo:         userRepo,
+		teamRepo:         teamRepo,
+		availabilityRepo: availabilityRepo,
+		affinityRepo:     affinityRepo,
+		repositoryRepo:   repositoryRepo,
+		expertiseRepo:    expertiseRepo,
 	}
 }
 
 func (s *PullRequestService) CreatePR(prID, prName, authorID string) (*entity.PullRequest, error) {
+	return s.CreatePullRequest(&entity.PullRequest{ID: prID, Name: prName, AuthorID: authorID})
+}
+
+func (s *PullRequestService) CreatePullRequest(draft *entity.PullRequest) (*entity.PullRequest, error) {
+	prID, prName, authorID := draft.ID, draft.Name, draft.AuthorID
 	if derr := s.validateField("pull_request_id", prID); derr != nil {
 		return nil, derr
 	}
@@ -38,24 +59,49 @@ func (s *PullRequestService) CreatePR(prID, prName, authorID string) (*entity.Pu
 	if derr := s.validateField("author_id", authorID); derr != nil {
 		return nil, derr
 	}
+	if derr := validateChangedFiles(draft.ChangedFiles); derr != nil {
+		return nil, derr
+	}
+	if derr := validateLabels(draft.Labels); derr != nil {
+		return nil, derr
+	}
+	if derr := validateDescription(draft.Description); derr != nil {
+		return nil, derr
+	}
+	if draft.Priority != "" && !draft.Priority.IsValid() {
+		return nil, entity.NewValidationError("priority", entity.RuleEnum, "one_of", i18n.Params{"values": "low, normal, high"})
+	}
 	exists, err := s.prRepo.PRExists(prID)
 	if err != nil {
 		logging.Printf("ERROR: Failed to check if PR exists %s: %v", prID, err)
 		return nil, err
 	}
 	if exists {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodePRExists,
-			Message: "PR id already exists",
-		}
+		return nil, entity.NewError(entity.ErrorCodePRExists, "", nil)
 	}
 
-	candidates, err := s.getAuthorAndCandidates(authorID)
+	repository, err := s.getRepository(draft.Repository)
 	if err != nil {
 		return nil, err
 	}
+	if draft.Repository != "" && repository == nil {
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "repository", nil)
+	}
+	owners, err := s.codeOwnerCandidates(repository, draft.ChangedFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	selection, err := s.getAuthorAndCandidates(draft, owners)
+	if err != nil {
+		return nil, err
+	}
+	selection.areas = entity.ExpertiseAreas(draft, config.ExpertisePathDepth)
 
-	reviewerIDs := s.selectReviewers(candidates, config.DefaultReviewers)
+	reviewerIDs, err := s.chooseReviewers(selection, selection.policy.RequiredReviewers)
+	if err != nil {
+		return nil, err
+	}
 	reviewersStr := reviewerIDs
 
 	now := time.Now()
@@ -65,6 +111,12 @@ func (s *PullRequestService) CreatePR(prID, prName, authorID string) (*entity.Pu
 		AuthorID:          authorID,
 		Status:            entity.StatusOpen,
 		AssignedReviewers: reviewersStr,
+		Repository:        draft.Repository,
+		ChangedFiles:      draft.ChangedFiles,
+		Labels:            draft.Labels,
+		Priority:          draft.Priority.OrDefault(),
+		Description:       draft.Description,
+		Version:           1,
 		CreatedAt:         &now,
 		MergedAt:          nil,
 	}
@@ -77,18 +129,28 @@ func (s *PullRequestService) CreatePR(prID, prName, authorID string) (*entity.Pu
 	return pr, nil
 }
 
-func (s *PullRequestService) MergePR(prID string) (*entity.PullRequest, error) {
+func (s *PullRequestService) GetPullRequest(prID string) (*entity.PullRequest, error) {
+	if derr := s.validateField("pull_request_id", prID); derr != nil {
+		return nil, derr
+	}
+
+	pr, err := s.prRepo.GetPR(prID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get PR %s: %v", prID, err)
+		return nil, err
+	}
+	if pr == nil {
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "pull_request", nil)
+	}
+	return pr, nil
+}
+
+func (s *PullRequestService) MergePR(prID, mergedBy string) (*entity.PullRequest, error) {
 	if prID == "" {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "pull_request_id cannot be empty",
-		}
+		return nil, entity.NewValidationError("pull_request_id", entity.RuleRequired, "required", nil)
 	}
 	if len(prID) > config.MaxStringLength {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "pull_request_id cannot exceed 255 characters",
-		}
+		return nil, entity.NewValidationError("pull_request_id", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
 	}
 
 	pr, err := s.prRepo.GetPR(prID)
@@ -97,24 +159,30 @@ func (s *PullRequestService) MergePR(prID string) (*entity.PullRequest, error) {
 		return nil, err
 	}
 	if pr == nil {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "PR not found",
-		}
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "pull_request", nil)
 	}
 
 	if pr.Status == entity.StatusMerged {
 		return pr, nil
 	}
 
+	if err := s.checkMergeAllowed(pr, mergedBy); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	pr.Status = entity.StatusMerged
 	pr.MergedAt = &now
 
-	if err := s.prRepo.UpdatePR(pr); err != nil {
+	updated, err := s.prRepo.UpdatePR(pr)
+	if err != nil {
 		logging.Printf("ERROR: Failed to update PR %s: %v", prID, err)
 		return nil, err
 	}
+	if !updated {
+		return nil, s.staleVersion(prID)
+	}
+	s.recordExpertise(pr, now)
 
 	return pr, nil
 }
@@ -133,40 +201,32 @@ func (s *PullRequestService) ReassignReviewer(prID, oldUserID string) (*entity.P
 		return nil, "", err
 	}
 	if pr == nil {
-		return nil, "", &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "PR not found",
-		}
+		return nil, "", entity.NewError(entity.ErrorCodeNotFound, "pull_request", nil)
 	}
 
 	if pr.Status == entity.StatusMerged {
-		return nil, "", &entity.DomainError{
-			Code:    entity.ErrorCodePRMerged,
-			Message: "cannot reassign on merged PR",
-		}
+		return nil, "", entity.NewError(entity.ErrorCodePRMerged, "reassign", nil)
 	}
 
 	reviewers := pr.AssignedReviewers
 
 	if !s.containsReviewer(reviewers, oldUserID) {
-		return nil, "", &entity.DomainError{
-			Code:    entity.ErrorCodeNotAssigned,
-			Message: "reviewer is not assigned to this PR",
-		}
+		return nil, "", entity.NewError(entity.ErrorCodeNotAssigned, "", nil)
 	}
-	candidates, err := s.buildReplacementCandidates(pr, oldUserID, reviewers)
+	selection, err := s.buildReplacementCandidates(pr, oldUserID, reviewers)
 	if err != nil {
 		return nil, "", err
 	}
 
-	if len(candidates) == 0 {
-		return nil, "", &entity.DomainError{
-			Code:    entity.ErrorCodeNoCandidate,
-			Message: "no active replacement candidate in team",
-		}
+	if countCandidates(selection.pools) == 0 {
+		return nil, "", entity.NewError(entity.ErrorCodeNoCandidate, "replacement", nil)
 	}
 
-	newUserID := s.selectReviewers(candidates, 1)[0]
+	picked, err := s.chooseReviewers(selection, config.ReplacementReviewerCount)
+	if err != nil {
+		return nil, "", err
+	}
+	newUserID := picked[0]
 
 	if err := s.applyReplacement(pr, oldUserID, newUserID, reviewers); err != nil {
 		return nil, "", err
@@ -175,6 +235,188 @@ func (s *PullRequestService) ReassignReviewer(prID, oldUserID string) (*entity.P
 	return pr, newUserID, nil
 }
 
+func (s *PullRequestService) UpdatePullRequest(prID string, changes entity.PullRequestChanges, version int) (*entity.PullRequest, error) {
+	if derr := s.validateField("pull_request_id", prID); derr != nil {
+		return nil, derr
+	}
+	if version <= 0 {
+		return nil, entity.NewValidationError("version", entity.RuleMinimum, "positive", nil)
+	}
+	if changes.Name != nil {
+		if derr := s.validateField("pull_request_name", *changes.Name); derr != nil {
+			return nil, derr
+		}
+	}
+	if changes.Description != nil {
+		if derr := validateDescription(*changes.Description); derr != nil {
+			return nil, derr
+		}
+	}
+	if changes.AuthorID != nil {
+		if derr := s.validateField("author_id", *changes.AuthorID); derr != nil {
+			return nil, derr
+		}
+	}
+	if changes.Labels != nil {
+		if derr := validateLabels(*changes.Labels); derr != nil {
+			return nil, derr
+		}
+	}
+
+	pr, err := s.prRepo.GetPR(prID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get PR %s: %v", prID, err)
+		return nil, err
+	}
+	if pr == nil {
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "pull_request", nil)
+	}
+	if pr.Status == entity.StatusMerged {
+		return nil, entity.NewError(entity.ErrorCodePRMerged, "update", nil)
+	}
+	if pr.Version != version {
+		return nil, versionConflict(pr.Version)
+	}
+
+	if changes.Name != nil {
+		pr.Name = *changes.Name
+	}
+	if changes.Description != nil {
+		pr.Description = *changes.Description
+	}
+	if changes.Labels != nil {
+		pr.Labels = *changes.Labels
+	}
+	if changes.AuthorID != nil && *changes.AuthorID != pr.AuthorID {
+		if err := s.changeAuthor(pr, *changes.AuthorID); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.prRepo.UpdatePRMetadata(pr, version)
+	if err != nil {
+		logging.Printf("ERROR: Failed to update PR %s: %v", prID, err)
+		return nil, err
+	}
+	if !updated {
+		return nil, s.staleVersion(prID)
+	}
+
+	return pr, nil
+}
+
+func (s *PullRequestService) changeAuthor(pr *entity.PullRequest, authorID string) error {
+	author, err := s.userRepo.GetUser(authorID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get author %s: %v", authorID, err)
+		return err
+	}
+	if author == nil {
+		return entity.NewError(entity.ErrorCodeNotFound, "author", nil)
+	}
+
+	pr.AuthorID = authorID
+	if !s.containsReviewer(pr.AssignedReviewers, authorID) {
+		return nil
+	}
+
+	remaining := make([]string, 0, len(pr.AssignedReviewers))
+	for _, reviewer := range pr.AssignedReviewers {
+		if reviewer != authorID {
+			remaining = append(remaining, reviewer)
+		}
+	}
+
+	selection, err := s.buildReplacementCandidates(pr, authorID, pr.AssignedReviewers)
+	if err != nil {
+		return err
+	}
+	picked, err := s.chooseReviewers(selection, config.ReplacementReviewerCount)
+	if err != nil && !isNoCandidate(err) {
+		return err
+	}
+	pr.AssignedReviewers = append(remaining, picked...)
+	return nil
+}
+
+func versionConflict(current int) *entity.DomainError {
+	return entity.NewError(entity.ErrorCodeVersionConflict, "", i18n.Params{"version": current})
+}
+
+func (s *PullRequestService) staleVersion(prID string) error {
+	current, err := s.prRepo.GetPR(prID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get PR %s: %v", prID, err)
+		return err
+	}
+	if current == nil {
+		return entity.NewError(entity.ErrorCodeNotFound, "pull_request", nil)
+	}
+	return versionConflict(current.Version)
+}
+
+func (s *PullRequestService) ApprovePR(prID, reviewerID string) (*entity.PullRequest, error) {
+	if derr := s.validateField("pull_request_id", prID); derr != nil {
+		return nil, derr
+	}
+	if derr := s.validateField("user_id", reviewerID); derr != nil {
+		return nil, derr
+	}
+
+	pr, err := s.prRepo.GetPR(prID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get PR %s: %v", prID, err)
+		return nil, err
+	}
+	if pr == nil {
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "pull_request", nil)
+	}
+	if pr.Status == entity.StatusMerged {
+		return nil, entity.NewError(entity.ErrorCodePRMerged, "approve", nil)
+	}
+	if !s.containsReviewer(pr.AssignedReviewers, reviewerID) {
+		return nil, entity.NewError(entity.ErrorCodeNotAssigned, "", nil)
+	}
+
+	if err := s.prRepo.AddApproval(prID, reviewerID); err != nil {
+		logging.Printf("ERROR: Failed to approve PR %s by %s: %v", prID, reviewerID, err)
+		return nil, err
+	}
+	return pr, nil
+}
+
+func (s *PullRequestService) checkMergeAllowed(pr *entity.PullRequest, mergedBy string) error {
+	if len(mergedBy) > config.MaxStringLength {
+		return entity.NewValidationError("merged_by", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+
+	policy, err := s.authorPolicy(pr.AuthorID)
+	if err != nil {
+		return err
+	}
+
+	if !policy.AllowSelfMerge {
+		if mergedBy == "" {
+			return entity.NewError(entity.ErrorCodeMergeBlocked, "merged_by_required", nil)
+		}
+		if mergedBy == pr.AuthorID {
+			return entity.NewError(entity.ErrorCodeMergeBlocked, "self_merge", nil)
+		}
+	}
+
+	if policy.RequiredApprovals > 0 {
+		approvals, err := s.prRepo.CountApprovals(pr.ID)
+		if err != nil {
+			logging.Printf("ERROR: Failed to count approvals for PR %s: %v", pr.ID, err)
+			return err
+		}
+		if approvals < policy.RequiredApprovals {
+			return entity.NewError(entity.ErrorCodeMergeBlocked, "approvals", i18n.Params{"required": policy.RequiredApprovals, "approvals": approvals})
+		}
+	}
+	return nil
+}
+
 func (s *PullRequestService) applyReplacement(pr *entity.PullRequest, oldUserID, newUserID string, reviewers []string) error {
 	newReviewers := make([]string, 0, len(reviewers))
 	for _, reviewer := range reviewers {
@@ -185,25 +427,101 @@ func (s *PullRequestService) applyReplacement(pr *entity.PullRequest, oldUserID,
 	newReviewers = append(newReviewers, newUserID)
 	pr.AssignedReviewers = newReviewers
 
-	if err := s.prRepo.UpdatePR(pr); err != nil {
+	updated, err := s.prRepo.UpdatePR(pr)
+	if err != nil {
 		return err
 	}
+	if !updated {
+		return s.staleVersion(pr.ID)
+	}
 	return nil
+}
+
+func (s *PullRequestService) reassignOnDeactivationDefault(teamName string) (bool, error) {
+	team, err := s.teamRepo.GetTeam(teamName)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get team %s: %v", teamName, err)
+		return false, err
+	}
+	if team == nil {
+		return false, nil
+	}
 
+	policy, err := s.teamPolicy(team)
+	if err != nil {
+		return false, err
+	}
+	return policy.ReassignOnDeactivation, nil
 }
 
-func (s *PullRequestService) GetReviewPRs(userID string) ([]*entity.PullRequest, error) {
-	if userID == "" {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "user_id cannot be empty",
+func (s *PullRequestService) handOverReviews(user *entity.User, apply func([]entity.ReviewerReassignment) error) (*entity.ReassignmentReport, error) {
+	for attempt := 1; ; attempt++ {
+		report, err := s.planReviewHandover(user)
+		if err != nil {
+			return nil, err
+		}
+
+		err = apply(report.Reassignments)
+		if errors.Is(err, repo.ErrReviewerChanged) && attempt < config.HandoverAttempts {
+			continue
 		}
+		if err != nil {
+			return nil, err
+		}
+		return report, nil
 	}
-	if len(userID) > config.MaxStringLength {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "user_id cannot exceed 255 characters",
+}
+
+func (s *PullRequestService) planReviewHandover(user *entity.User) (*entity.ReassignmentReport, error) {
+	prs, err := s.prRepo.GetPRsByReviewer(user.ID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get PRs for reviewer %s: %v", user.ID, err)
+		return nil, err
+	}
+
+	report := &entity.ReassignmentReport{
+		Reassignments: make([]entity.ReviewerReassignment, 0),
+		UnreplacedPRs: make([]string, 0),
+	}
+	for _, pr := range prs {
+		if pr.Status != entity.StatusOpen {
+			continue
+		}
+
+		selection, err := s.buildReplacementCandidates(pr, user.ID, pr.AssignedReviewers)
+		if err != nil {
+			return nil, err
+		}
+		if countCandidates(selection.pools) == 0 {
+			report.UnreplacedPRs = append(report.UnreplacedPRs, pr.ID)
+			continue
+		}
+
+		picked, err := s.chooseReviewers(selection, config.ReplacementReviewerCount)
+		if isNoCandidate(err) {
+			report.UnreplacedPRs = append(report.UnreplacedPRs, pr.ID)
+			continue
 		}
+		if err != nil {
+			return nil, err
+		}
+
+		report.Reassignments = append(report.Reassignments, entity.ReviewerReassignment{
+			PullRequestID: pr.ID,
+			OldReviewerID: user.ID,
+			NewReviewerID: picked[0],
+		})
+	}
+
+	return report, nil
+}
+
+func (s *PullRequestService) GetReviewPRs(userID string, filter entity.ReviewQueueFilter) ([]*entity.PullRequest, error) {
+	if userID == "" {
+		return nil, entity.NewValidationError("user_id", entity.RuleRequired, "required", nil)
+	}
+	if len(userID) > config.MaxStringLength {
+		return nil, entity.NewValidationError("user_id", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
 	}
 
 	user, err := s.userRepo.GetUser(userID)
@@ -211,10 +529,7 @@ func (s *PullRequestService) GetReviewPRs(userID string) ([]*entity.PullRequest,
 		return nil, err
 	}
 	if user == nil {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "user not found",
-		}
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "user", nil)
 	}
 
 	prs, err := s.prRepo.GetPRsByReviewer(userID)
@@ -223,10 +538,16 @@ func (s *PullRequestService) GetReviewPRs(userID string) ([]*entity.PullRequest,
 		return nil, err
 	}
 
-	return prs, nil
+	filtered := make([]*entity.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		if filter.Matches(pr) {
+			filtered = append(filtered, pr)
+		}
+	}
+	return filtered, nil
 }
 
-func (s *PullRequestService) selectReviewers(candidates []*entity.User, n int) []string {
+func (s *PullRequestService) selectReviewers(candidates []*entity.User, n int, weights map[string]int) []string {
 	if len(candidates) == 0 {
 		return []string{}
 	}
@@ -235,27 +556,31 @@ func (s *PullRequestService) selectReviewers(candidates []*entity.User, n int) [
 		n = len(candidates)
 	}
 
-	indices := make([]int, len(candidates))
-	for i := range indices {
-		indices[i] = i
+	shuffled := s.weightedShuffle(candidates, weights)
+
+	result := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		result = append(result, shuffled[i].ID)
 	}
 
-	for i := len(indices) - 1; i > 0; i-- {
+	return result
+}
+
+func (s *PullRequestService) shuffleCandidates(candidates []*entity.User) []*entity.User {
+	shuffled := make([]*entity.User, len(candidates))
+	copy(shuffled, candidates)
+
+	for i := len(shuffled) - 1; i > 0; i-- {
 		jBig, err := crand.Int(crand.Reader, big.NewInt(int64(i+1)))
 		if err != nil {
 			logging.Printf("ERROR: failed to generate crypto random: %v", err)
 			break
 		}
 		j := int(jBig.Int64())
-		indices[i], indices[j] = indices[j], indices[i]
-	}
-
-	result := make([]string, 0, n)
-	for i := 0; i < n; i++ {
-		result = append(result, candidates[indices[i]].ID)
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
 	}
 
-	return result
+	return shuffled
 }
 
 func (s *PullRequestService) containsReviewer(reviewers []string, userID string) bool {
@@ -269,60 +594,186 @@ func (s *PullRequestService) containsReviewer(reviewers []string, userID string)
 
 func (s *PullRequestService) validateField(fieldName, value string) *entity.DomainError {
 	if value == "" {
-		return &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: fieldName + " cannot be empty",
-		}
+		return entity.NewValidationError(fieldName, entity.RuleRequired, "required", nil)
 	}
 	if len(value) > config.MaxStringLength {
-		return &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: fieldName + " cannot exceed 255 characters",
+		return entity.NewValidationError(fieldName, entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+	return nil
+}
+
+func validateDescription(description string) *entity.DomainError {
+	if utf8.RuneCountInString(description) > config.MaxDescriptionLength {
+		return entity.NewValidationError("description", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxDescriptionLength})
+	}
+	return nil
+}
+
+func validateLabels(labels []string) *entity.DomainError {
+	if len(labels) > config.MaxLabels {
+		return entity.NewValidationError("labels", entity.RuleMaxItems, "max_entries", i18n.Params{"max": config.MaxLabels})
+	}
+	for _, label := range labels {
+		if strings.TrimSpace(label) == "" {
+			return entity.NewValidationError("labels", entity.RuleRequired, "labels_empty", nil)
+		}
+		if len(label) > config.MaxLabelLength {
+			return entity.NewValidationError("labels", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxLabelLength})
 		}
 	}
 	return nil
 }
 
-func (s *PullRequestService) buildReplacementCandidates(pr *entity.PullRequest, oldUserID string, reviewers []string) ([]*entity.User, error) {
-	oldUser, err := s.userRepo.GetUser(oldUserID)
+func (s *PullRequestService) buildReplacementCandidates(pr *entity.PullRequest, oldUserID string, reviewers []string) (*reviewerSelection, error) {
+	author, err := s.userRepo.GetUserIncludingDeleted(pr.AuthorID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get author %s: %v", pr.AuthorID, err)
+		return nil, err
+	}
+	if author == nil {
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "author", nil)
+	}
+
+	team, err := s.teamRepo.GetTeam(author.Team)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get team %s: %v", author.Team, err)
+		return nil, err
+	}
+	if team == nil {
+		team = &entity.Team{Name: author.Team}
+	}
+
+	policy, err := s.teamPolicy(team)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, weights, err := s.authorAffinities(pr.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, err := s.getRepository(pr.Repository)
+	if err != nil {
+		return nil, err
+	}
+	owners, err := s.codeOwnerCandidates(repository, pr.ChangedFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	securityTeam := securityReviewTeam(policy, pr)
+	extra, err := s.extraPools(team, policy, securityTeam)
+	if err != nil {
+		return nil, err
+	}
+	exclude := append([]string{oldUserID, pr.AuthorID}, reviewers...)
+	pools, err := s.reviewerPools(team, append(exclude, blocked...), owners, extra)
+	if err != nil {
+		return nil, err
+	}
+	pools, err = s.withinCapacity(pools, policy, pr)
+	if err != nil {
+		return nil, err
+	}
+	assigned := make([]string, 0, len(reviewers))
+	for _, reviewer := range reviewers {
+		if reviewer != oldUserID {
+			assigned = append(assigned, reviewer)
+		}
+	}
+
+	areas := entity.ExpertiseAreas(pr, config.ExpertisePathDepth)
+	return &reviewerSelection{author: author, policy: policy, pools: pools, weights: weights, assigned: assigned, areas: areas, securityTeam: securityTeam}, nil
+}
+
+func (s *PullRequestService) reviewerPools(team *entity.Team, exclude []string, preferred []*entity.User, extra [][]*entity.User) ([][]*entity.User, error) {
+	awayIDs, err := s.availabilityRepo.GetAwayUserIDs(time.Now().UTC())
 	if err != nil {
-		logging.Printf("ERROR: Failed to get user %s: %v", oldUserID, err)
+		logging.Printf("ERROR: Failed to get away users: %v", err)
 		return nil, err
 	}
-	if oldUser == nil {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "user not found",
+
+	skip := make(map[string]bool, len(exclude)+len(awayIDs))
+	for _, id := range exclude {
+		skip[id] = true
+	}
+	for _, id := range awayIDs {
+		skip[id] = true
+	}
+
+	teamNames := append([]string{team.Name}, team.FallbackTeams...)
+	pools := make([][]*entity.User, 0, len(teamNames)+len(extra)+2)
+	pools = appendPool(pools, preferred, skip)
+	for _, teamName := range teamNames {
+		members, err := s.userRepo.GetActiveUsersByTeam(teamName)
+		if err != nil {
+			logging.Printf("ERROR: Failed to get active users for team %s: %v", teamName, err)
+			return nil, err
 		}
+		pools = appendPool(pools, members, skip)
 	}
 
-	activeMembers, err := s.userRepo.GetActiveUsersByTeam(oldUser.Team)
+	shared, err := s.activeUsers(team.SharedReviewers)
 	if err != nil {
-		logging.Printf("ERROR: Failed to get active users for team %s: %v", oldUser.Team, err)
 		return nil, err
 	}
+	pools = appendPool(pools, shared, skip)
+
+	for _, users := range extra {
+		pools = appendPool(pools, users, skip)
+	}
+
+	return pools, nil
+}
+
+func (s *PullRequestService) activeUsers(userIDs []string) ([]*entity.User, error) {
+	users := make([]*entity.User, 0, len(userIDs))
+	for _, userID := range userIDs {
+		user, err := s.userRepo.GetUser(userID)
+		if err != nil {
+			logging.Printf("ERROR: Failed to get user %s: %v", userID, err)
+			return nil, err
+		}
+		if user != nil && user.IsActive {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
 
-	candidates := make([]*entity.User, 0)
-	for _, member := range activeMembers {
-		if member.ID == oldUserID || member.ID == pr.AuthorID || s.containsReviewer(reviewers, member.ID) {
+func appendPool(pools [][]*entity.User, users []*entity.User, skip map[string]bool) [][]*entity.User {
+	pool := make([]*entity.User, 0, len(users))
+	for _, user := range users {
+		if skip[user.ID] {
 			continue
 		}
-		candidates = append(candidates, member)
+		skip[user.ID] = true
+		pool = append(pool, user)
 	}
-	return candidates, nil
+	if len(pool) == 0 {
+		return pools
+	}
+	return append(pools, pool)
 }
 
-func (s *PullRequestService) getAuthorAndCandidates(authorID string) ([]*entity.User, error) {
+func countCandidates(pools [][]*entity.User) int {
+	count := 0
+	for _, pool := range pools {
+		count += len(pool)
+	}
+	return count
+}
+
+func (s *PullRequestService) getAuthorAndCandidates(draft *entity.PullRequest, owners []*entity.User) (*reviewerSelection, error) {
+	authorID := draft.AuthorID
 	author, err := s.userRepo.GetUser(authorID)
 	if err != nil {
 		logging.Printf("ERROR: Failed to get author %s: %v", authorID, err)
 		return nil, err
 	}
 	if author == nil {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "author not found",
-		}
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "author", nil)
 	}
 
 	team, err := s.teamRepo.GetTeam(author.Team)
@@ -331,24 +782,32 @@ func (s *PullRequestService) getAuthorAndCandidates(authorID string) ([]*entity.
 		return nil, err
 	}
 	if team == nil {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "team not found",
-		}
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "team", nil)
 	}
 
-	activeMembers, err := s.userRepo.GetActiveUsersByTeam(author.Team)
+	policy, err := s.teamPolicy(team)
 	if err != nil {
-		logging.Printf("ERROR: Failed to get active users for team %s: %v", author.Team, err)
 		return nil, err
 	}
 
-	candidates := make([]*entity.User, 0)
-	for _, member := range activeMembers {
-		if member.ID != authorID {
-			candidates = append(candidates, member)
-		}
+	blocked, weights, err := s.authorAffinities(authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	securityTeam := securityReviewTeam(policy, draft)
+	extra, err := s.extraPools(team, policy, securityTeam)
+	if err != nil {
+		return nil, err
+	}
+	pools, err := s.reviewerPools(team, append([]string{authorID}, blocked...), owners, extra)
+	if err != nil {
+		return nil, err
+	}
+	pools, err = s.withinCapacity(pools, policy, draft)
+	if err != nil {
+		return nil, err
 	}
 
-	return candidates, nil
+	return &reviewerSelection{author: author, policy: policy, pools: pools, weights: weights, securityTeam: securityTeam}, nil
 }
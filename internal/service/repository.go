@@ -0,0 +1,89 @@
+package service
+
+import (
+	"pr-review/internal/codeowners"
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/i18n"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+)
+
+type RepositoryService struct {
+	repositoryRepo repo.RepositoryRepository
+	teamRepo       repo.TeamRepository
+}
+
+func NewRepositoryService(repositoryRepo repo.RepositoryRepository, teamRepo repo.TeamRepository) *RepositoryService {
+	return &RepositoryService{
+		repositoryRepo: repositoryRepo,
+		teamRepo:       teamRepo,
+	}
+}
+
+func (s *RepositoryService) SaveRepository(repository *entity.Repository) (*entity.Repository, error) {
+	if derr := validateRepositoryName(repository.Name); derr != nil {
+		return nil, derr
+	}
+	if repository.TeamName != "" {
+		team, err := s.teamRepo.GetTeam(repository.TeamName)
+		if err != nil {
+			logging.Printf("ERROR: Failed to get team %s: %v", repository.TeamName, err)
+			return nil, err
+		}
+		if team == nil {
+			return nil, entity.NewError(entity.ErrorCodeNotFound, "team", nil)
+		}
+	}
+
+	if err := s.repositoryRepo.SaveRepository(repository); err != nil {
+		logging.Printf("ERROR: Failed to save repository %s: %v", repository.Name, err)
+		return nil, err
+	}
+	return s.GetRepository(repository.Name)
+}
+
+func (s *RepositoryService) GetRepository(name string) (*entity.Repository, error) {
+	if derr := validateRepositoryName(name); derr != nil {
+		return nil, derr
+	}
+
+	repository, err := s.repositoryRepo.GetRepository(name)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get repository %s: %v", name, err)
+		return nil, err
+	}
+	if repository == nil {
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "repository", nil)
+	}
+	return repository, nil
+}
+
+func (s *RepositoryService) UploadCodeOwners(name, content string) (*entity.Repository, error) {
+	if len(content) > config.MaxCodeOwnersSize {
+		return nil, entity.NewValidationError("content", entity.RuleMaxLength, "codeowners_size", nil)
+	}
+	if _, err := codeowners.Parse(content); err != nil {
+		return nil, entity.NewValidationError("content", entity.RuleFormat, "codeowners_invalid", i18n.Params{"reason": err.Error()})
+	}
+
+	if _, err := s.GetRepository(name); err != nil {
+		return nil, err
+	}
+
+	if err := s.repositoryRepo.SetCodeOwners(name, content); err != nil {
+		logging.Printf("ERROR: Failed to save CODEOWNERS for repository %s: %v", name, err)
+		return nil, err
+	}
+	return s.GetRepository(name)
+}
+
+func validateRepositoryName(name string) *entity.DomainError {
+	if name == "" {
+		return entity.NewValidationError("repository_name", entity.RuleRequired, "required", nil)
+	}
+	if len(name) > config.MaxStringLength {
+		return entity.NewValidationError("repository_name", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+	return nil
+}
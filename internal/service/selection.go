@@ -0,0 +1,141 @@
+package service
+
+import (
+	crand "crypto/rand"
+	"math/big"
+	"pr-review/internal/entity"
+	"pr-review/internal/logging"
+	"sort"
+	"time"
+)
+
+func (s *PullRequestService) pickReviewers(selection *reviewerSelection, candidates []*entity.User, n int) ([]string, error) {
+	switch selection.policy.SelectionStrategy {
+	case entity.SelectionStrategyWorkingHours:
+		return s.selectByWorkingHours(candidates, n, time.Now(), selection.weights)
+	case entity.SelectionStrategyExpertise:
+		return s.selectByExpertise(candidates, n, selection.areas, time.Now(), selection.weights)
+	default:
+		return s.selectReviewers(candidates, n, selection.weights), nil
+	}
+}
+
+func (s *PullRequestService) pickFromPools(selection *reviewerSelection, n int) ([]string, error) {
+	selected := make([]string, 0, n)
+	for _, pool := range selection.pools {
+		if len(selected) >= n {
+			break
+		}
+		picked, err := s.pickReviewers(selection, pool, n-len(selected))
+		if err != nil {
+			return nil, err
+		}
+		selected = append(selected, picked...)
+	}
+	return selected, nil
+}
+
+func (s *PullRequestService) selectByWorkingHours(candidates []*entity.User, n int, at time.Time, weights map[string]int) ([]string, error) {
+	if n > len(candidates) {
+		n = len(candidates)
+	}
+
+	userIDs := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		userIDs[i] = candidate.ID
+	}
+
+	schedules, err := s.userRepo.GetWorkSchedules(userIDs)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get work schedules: %v", err)
+		return nil, err
+	}
+
+	shuffled := s.weightedShuffle(candidates, weights)
+	waits := make(map[string]time.Duration, len(shuffled))
+	for _, candidate := range shuffled {
+		if schedule, ok := schedules[candidate.ID]; ok {
+			waits[candidate.ID] = schedule.UntilWorkingHours(at)
+		}
+	}
+
+	rank := func(userID string) int {
+		wait, scheduled := waits[userID]
+		switch {
+		case !scheduled:
+			return 1
+		case wait == 0:
+			return 0
+		default:
+			return 2
+		}
+	}
+	sort.SliceStable(shuffled, func(i, j int) bool {
+		ri, rj := rank(shuffled[i].ID), rank(shuffled[j].ID)
+		if ri != rj {
+			return ri < rj
+		}
+		return waits[shuffled[i].ID] < waits[shuffled[j].ID]
+	})
+
+	result := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		result = append(result, shuffled[i].ID)
+	}
+	return result, nil
+}
+
+func (s *PullRequestService) authorAffinities(authorID string) ([]string, map[string]int, error) {
+	affinities, err := s.affinityRepo.ListAffinities(authorID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get affinities for author %s: %v", authorID, err)
+		return nil, nil, err
+	}
+
+	blocked := make([]string, 0)
+	weights := make(map[string]int, len(affinities))
+	for _, affinity := range affinities {
+		if affinity.Blocked {
+			blocked = append(blocked, affinity.ReviewerID)
+			continue
+		}
+		weights[affinity.ReviewerID] = affinity.Weight
+	}
+	return blocked, weights, nil
+}
+
+func (s *PullRequestService) weightedShuffle(candidates []*entity.User, weights map[string]int) []*entity.User {
+	if len(weights) == 0 {
+		return s.shuffleCandidates(candidates)
+	}
+
+	remaining := make([]*entity.User, len(candidates))
+	copy(remaining, candidates)
+
+	total := int64(0)
+	for _, candidate := range remaining {
+		total += int64(1 + weights[candidate.ID])
+	}
+
+	shuffled := make([]*entity.User, 0, len(candidates))
+	for len(remaining) > 0 {
+		pickBig, err := crand.Int(crand.Reader, big.NewInt(total))
+		if err != nil {
+			logging.Printf("ERROR: failed to generate crypto random: %v", err)
+			return append(shuffled, remaining...)
+		}
+
+		pick := pickBig.Int64()
+		for i, candidate := range remaining {
+			weight := int64(1 + weights[candidate.ID])
+			if pick < weight {
+				shuffled = append(shuffled, candidate)
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				total -= weight
+				break
+			}
+			pick -= weight
+		}
+	}
+	return shuffled
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/i18n"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+	"time"
+)
+
+type EscalationPublisher interface {
+	PublishEscalation(breach *entity.SLABreach) error
+}
+
+type LogEscalationPublisher struct{}
+
+func (LogEscalationPublisher) PublishEscalation(breach *entity.SLABreach) error {
+	logging.Printf("ESCALATION: %s SLA breached for PR %s (team %s, reviewer %q, deadline %s)",
+		breach.Kind, breach.PullRequestID, breach.TeamName, breach.ReviewerID, breach.Deadline.Format(time.RFC3339))
+	return nil
+}
+
+type SLAService struct {
+	slaRepo   repo.SLARepository
+	teamRepo  repo.TeamRepository
+	prService *PullRequestService
+	publisher EscalationPublisher
+}
+
+func NewSLAService(
+	slaRepo repo.SLARepository,
+	teamRepo repo.TeamRepository,
+	prService *PullRequestService,
+	publisher EscalationPublisher,
+) *SLAService {
+	return &SLAService{
+		slaRepo:   slaRepo,
+		teamRepo:  teamRepo,
+		prService: prService,
+		publisher: publisher,
+	}
+}
+
+func (s *SLAService) GetSettings(teamName string) (*entity.SLASettings, error) {
+	if derr := s.prService.validateField("team_name", teamName); derr != nil {
+		return nil, derr
+	}
+
+	settings, err := s.slaRepo.GetSettings(teamName)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get SLA settings for team %s: %v", teamName, err)
+		return nil, err
+	}
+	if settings != nil {
+		return settings, nil
+	}
+
+	exists, err := s.teamRepo.TeamExists(teamName)
+	if err != nil {
+		logging.Printf("ERROR: Failed to check if team exists: %v", err)
+		return nil, err
+	}
+	if !exists {
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "team", nil)
+	}
+	return &entity.SLASettings{TeamName: teamName}, nil
+}
+
+func (s *SLAService) SetSettings(settings *entity.SLASettings) (*entity.SLASettings, error) {
+	if derr := s.prService.validateField("team_name", settings.TeamName); derr != nil {
+		return nil, derr
+	}
+	if settings.FirstReviewMinutes < 0 || settings.FirstReviewMinutes > config.MaxSLAMinutes {
+		return nil, entity.NewValidationError("time_to_first_review_minutes", entity.RuleRange, "range", i18n.Params{"min": 0, "max": config.MaxSLAMinutes})
+	}
+	if settings.MergeMinutes < 0 || settings.MergeMinutes > config.MaxSLAMinutes {
+		return nil, entity.NewValidationError("time_to_merge_minutes", entity.RuleRange, "range", i18n.Params{"min": 0, "max": config.MaxSLAMinutes})
+	}
+
+	exists, err := s.teamRepo.TeamExists(settings.TeamName)
+	if err != nil {
+		logging.Printf("ERROR: Failed to check if team exists: %v", err)
+		return nil, err
+	}
+	if !exists {
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "team", nil)
+	}
+
+	if err := s.slaRepo.SaveSettings(settings); err != nil {
+		logging.Printf("ERROR: Failed to save SLA settings for team %s: %v", settings.TeamName, err)
+		return nil, err
+	}
+	return settings, nil
+}
+
+func (s *SLAService) ListBreaches(filter entity.SLABreachFilter) ([]*entity.SLABreach, error) {
+	if len(filter.TeamName) > config.MaxStringLength {
+		return nil, entity.NewValidationError("team_name", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+	if filter.Kind != "" && filter.Kind != entity.SLABreachFirstReview && filter.Kind != entity.SLABreachMerge {
+		return nil, entity.NewValidationError("kind", entity.RuleEnum, "one_of", i18n.Params{"values": "FIRST_REVIEW, MERGE"})
+	}
+
+	breaches, err := s.slaRepo.ListBreaches(filter)
+	if err != nil {
+		logging.Printf("ERROR: Failed to list SLA breaches: %v", err)
+		return nil, err
+	}
+	return breaches, nil
+}
+
+func (s *SLAService) CheckBreaches(now time.Time) ([]*entity.SLABreach, error) {
+	if err := s.slaRepo.ResolveBreaches(now); err != nil {
+		return nil, err
+	}
+
+	allSettings, err := s.slaRepo.ListSettings()
+	if err != nil {
+		logging.Printf("ERROR: Failed to list SLA settings: %v", err)
+		return nil, err
+	}
+
+	detected := make([]*entity.SLABreach, 0)
+	for _, settings := range allSettings {
+		if settings.FirstReviewMinutes > 0 {
+			limit := time.Duration(settings.FirstReviewMinutes) * time.Minute
+			candidates, err := s.slaRepo.FindReviewBreaches(settings.TeamName, now.Add(-limit))
+			if err != nil {
+				return nil, err
+			}
+			breaches, err := s.escalate(candidates, limit, now, settings.AutoReassign)
+			if err != nil {
+				return nil, err
+			}
+			detected = append(detected, breaches...)
+		}
+
+		if settings.MergeMinutes > 0 {
+			limit := time.Duration(settings.MergeMinutes) * time.Minute
+			candidates, err := s.slaRepo.FindMergeBreaches(settings.TeamName, now.Add(-limit))
+			if err != nil {
+				return nil, err
+			}
+			breaches, err := s.escalate(candidates, limit, now, false)
+			if err != nil {
+				return nil, err
+			}
+			detected = append(detected, breaches...)
+		}
+	}
+
+	return detected, nil
+}
+
+func (s *SLAService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			breaches, err := s.CheckBreaches(time.Now().UTC())
+			if err != nil {
+				logging.Printf("ERROR: Failed to check SLA breaches: %v", err)
+				continue
+			}
+			if len(breaches) > 0 {
+				logging.Printf("Detected %d new SLA breaches", len(breaches))
+			}
+		}
+	}
+}
+
+func (s *SLAService) escalate(candidates []*entity.SLABreach, limit time.Duration, now time.Time, autoReassign bool) ([]*entity.SLABreach, error) {
+	for _, breach := range candidates {
+		breach.Deadline = breach.StartedAt.Add(limit)
+		breach.DetectedAt = now
+
+		if err := s.slaRepo.RecordBreach(breach); err != nil {
+			return nil, err
+		}
+
+		if err := s.publisher.PublishEscalation(breach); err != nil {
+			logging.Printf("ERROR: Failed to publish escalation for PR %s: %v", breach.PullRequestID, err)
+		}
+
+		if autoReassign && breach.Kind == entity.SLABreachFirstReview {
+			if err := s.reassignStale(breach); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return candidates, nil
+}
+
+func (s *SLAService) reassignStale(breach *entity.SLABreach) error {
+	_, newReviewerID, err := s.prService.ReassignReviewer(breach.PullRequestID, breach.ReviewerID)
+	if err != nil {
+		var domainErr *entity.DomainError
+		if errors.As(err, &domainErr) {
+			logging.Printf("Could not reassign stale reviewer %s on PR %s: %s",
+				breach.ReviewerID, breach.PullRequestID, domainErr.Message)
+			return nil
+		}
+		return err
+	}
+
+	if err := s.slaRepo.MarkReassigned(breach.ID, newReviewerID); err != nil {
+		return err
+	}
+	breach.ReassignedTo = newReviewerID
+	return nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/logging"
+	"pr-review/internal/repo"
+	"pr-review/internal/snapshot"
+	"time"
+)
+
+const seedTable = "organizations"
+
+type SnapshotService struct {
+	snapshotRepo repo.SnapshotRepository
+}
+
+func NewSnapshotService(snapshotRepo repo.SnapshotRepository) *SnapshotService {
+	return &SnapshotService{
+		snapshotRepo: snapshotRepo,
+	}
+}
+
+func (s *SnapshotService) Create(w io.Writer) (*entity.SnapshotManifest, error) {
+	version, err := s.checkSchemaVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := s.snapshotRepo.Dump(snapshot.Tables)
+	if err != nil {
+		logging.Printf("ERROR: Failed to dump tables: %v", err)
+		return nil, err
+	}
+
+	manifest := &entity.SnapshotManifest{
+		SchemaVersion: version,
+		CreatedAt:     time.Now().UTC(),
+		Tables:        tables,
+	}
+	if err := snapshot.Write(w, manifest); err != nil {
+		logging.Printf("ERROR: Failed to write snapshot: %v", err)
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func (s *SnapshotService) Restore(r io.Reader) (*entity.SnapshotManifest, error) {
+	manifest, err := snapshot.Read(r)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.SchemaVersion != config.SchemaVersion {
+		return nil, fmt.Errorf("%w: snapshot has schema version %d, this build supports %d",
+			snapshot.ErrSchemaMismatch, manifest.SchemaVersion, config.SchemaVersion)
+	}
+
+	tables, err := orderTables(manifest.Tables)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.checkSchemaVersion(); err != nil {
+		return nil, err
+	}
+
+	counts, err := s.snapshotRepo.CountRows(snapshot.Tables)
+	if err != nil {
+		logging.Printf("ERROR: Failed to count rows in target database: %v", err)
+		return nil, err
+	}
+	for _, table := range snapshot.Tables {
+		if table != seedTable && counts[table] > 0 {
+			return nil, fmt.Errorf("%w: table %s has %d rows", snapshot.ErrDatabaseNotEmpty, table, counts[table])
+		}
+	}
+
+	if err := s.snapshotRepo.Restore(tables); err != nil {
+		logging.Printf("ERROR: Failed to restore snapshot: %v", err)
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func (s *SnapshotService) checkSchemaVersion() (int, error) {
+	version, err := s.snapshotRepo.SchemaVersion()
+	if err != nil {
+		logging.Printf("ERROR: Failed to get schema version: %v", err)
+		return 0, err
+	}
+	if version != config.SchemaVersion {
+		return 0, fmt.Errorf("%w: database has schema version %d, this build supports %d",
+			snapshot.ErrSchemaMismatch, version, config.SchemaVersion)
+	}
+	return version, nil
+}
+
+func orderTables(tables []*entity.SnapshotTable) ([]*entity.SnapshotTable, error) {
+	byName := make(map[string]*entity.SnapshotTable, len(tables))
+	for _, table := range tables {
+		byName[table.Name] = table
+	}
+
+	ordered := make([]*entity.SnapshotTable, 0, len(snapshot.Tables))
+	for _, name := range snapshot.Tables {
+		table, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: table %s is missing from the snapshot", snapshot.ErrUnsupportedFormat, name)
+		}
+		if len(table.Columns) == 0 {
+			return nil, fmt.Errorf("%w: table %s has no columns", snapshot.ErrUnsupportedFormat, name)
+		}
+		ordered = append(ordered, table)
+		delete(byName, name)
+	}
+	for name := range byName {
+		return nil, fmt.Errorf("%w: unknown table %s", snapshot.ErrUnsupportedFormat, name)
+	}
+	return ordered, nil
+}
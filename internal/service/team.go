@@ -3,6 +3,7 @@ package service
 import (
 	"pr-review/internal/config"
 	"pr-review/internal/entity"
+	"pr-review/internal/i18n"
 	"pr-review/internal/logging"
 	"pr-review/internal/repo"
 )
@@ -21,29 +22,29 @@ func NewTeamService(teamRepo repo.TeamRepository, userRepo repo.UserRepository)
 
 func (s *TeamService) AddTeam(team *entity.Team) error {
 	if team.Name == "" {
-		return &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "team_name cannot be empty",
-		}
+		return entity.NewValidationError("team_name", entity.RuleRequired, "required", nil)
 	}
 	if len(team.Name) > config.MaxStringLength {
-		return &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "team_name cannot exceed 255 characters",
-		}
+		return entity.NewValidationError("team_name", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
 	}
 
 	if len(team.Members) == 0 {
-		return &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "team must have at least one member",
-		}
+		return entity.NewValidationError("members", entity.RuleMinItems, "min_members", nil)
 	}
 
+	if team.SelectionStrategy == "" {
+		team.SelectionStrategy = entity.SelectionStrategyRandom
+	}
+	if !team.SelectionStrategy.IsValid() {
+		return entity.NewValidationError("selection_strategy", entity.RuleEnum, "one_of", i18n.Params{"values": "random, working_hours, expertise"})
+	}
+
+	memberIDs := make(map[string]bool, len(team.Members))
 	for _, member := range team.Members {
 		if derr := s.validateTeamMember(&member, team.Name); derr != nil {
 			return derr
 		}
+		memberIDs[member.ID] = true
 	}
 
 	exists, err := s.teamRepo.TeamExists(team.Name)
@@ -52,28 +53,105 @@ func (s *TeamService) AddTeam(team *entity.Team) error {
 		return err
 	}
 	if exists {
-		return &entity.DomainError{
-			Code:    entity.ErrorCodeTeamExists,
-			Message: "team_name already exists",
-		}
+		return entity.NewError(entity.ErrorCodeTeamExists, "", nil)
+	}
+
+	if err := s.validateReviewerPools(team.Name, team.FallbackTeams, team.SharedReviewers, memberIDs); err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(team.Members))
+	for _, member := range team.Members {
+		ids = append(ids, member.ID)
+	}
+	deleted, err := s.userRepo.GetDeletedUserIDs(ids)
+	if err != nil {
+		logging.Printf("ERROR: Failed to check deleted users for team %s: %v", team.Name, err)
+		return err
+	}
+	if len(deleted) > 0 {
+		return entity.NewError(entity.ErrorCodeNotFound, "user_deleted", i18n.Params{"user_id": deleted[0]})
 	}
 
 	if err := s.teamRepo.CreateTeam(team); err != nil {
 		logging.Printf("ERROR: Failed to create team %s: %v", team.Name, err)
 		return err
 	}
+	return nil
+}
 
-	for _, member := range team.Members {
-		user := &entity.User{
-			ID:       member.ID,
-			Name:     member.Name,
-			Team:     team.Name,
-			IsActive: member.IsActive,
+func (s *TeamService) SetReviewerPools(teamName string, fallbackTeams, sharedReviewers []string) (*entity.Team, error) {
+	team, err := s.GetTeam(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateReviewerPools(teamName, fallbackTeams, sharedReviewers, nil); err != nil {
+		return nil, err
+	}
+
+	if err := s.teamRepo.SetReviewerPools(teamName, fallbackTeams, sharedReviewers); err != nil {
+		logging.Printf("ERROR: Failed to set reviewer pools for team %s: %v", teamName, err)
+		return nil, err
+	}
+
+	team.FallbackTeams = fallbackTeams
+	team.SharedReviewers = sharedReviewers
+	return team, nil
+}
+
+func (s *TeamService) validateReviewerPools(teamName string, fallbackTeams, sharedReviewers []string, memberIDs map[string]bool) error {
+	if len(fallbackTeams) > config.MaxFallbackTeams {
+		return entity.NewValidationError("fallback_teams", entity.RuleMaxItems, "max_count", i18n.Params{"max": config.MaxFallbackTeams})
+	}
+	if len(sharedReviewers) > config.MaxTeamMembers {
+		return entity.NewValidationError("shared_reviewers", entity.RuleMaxItems, "max_count", i18n.Params{"max": config.MaxTeamMembers})
+	}
+
+	seenTeams := make(map[string]bool, len(fallbackTeams))
+	for _, fallback := range fallbackTeams {
+		if fallback == "" || len(fallback) > config.MaxStringLength {
+			return entity.NewValidationError("fallback_teams", entity.RuleMaxLength, "fallback_name", i18n.Params{"max": config.MaxStringLength})
+		}
+		if fallback == teamName {
+			return entity.NewValidationError("fallback_teams", entity.RuleConsistent, "own_fallback", nil)
+		}
+		if seenTeams[fallback] {
+			return entity.NewValidationError("fallback_teams", entity.RuleUniqueItems, "duplicate_fallback", i18n.Params{"team": fallback})
+		}
+		seenTeams[fallback] = true
+
+		exists, err := s.teamRepo.TeamExists(fallback)
+		if err != nil {
+			logging.Printf("ERROR: Failed to check if team exists: %v", err)
+			return err
+		}
+		if !exists {
+			return entity.NewError(entity.ErrorCodeNotFound, "fallback_team", i18n.Params{"team": fallback})
 		}
-		if err := s.userRepo.CreateOrUpdateUser(user); err != nil {
-			logging.Printf("ERROR: Failed to create/update user %s: %v", user.ID, err)
+	}
+
+	seenUsers := make(map[string]bool, len(sharedReviewers))
+	for _, userID := range sharedReviewers {
+		if userID == "" || len(userID) > config.MaxStringLength {
+			return entity.NewValidationError("shared_reviewers", entity.RuleMaxLength, "shared_reviewer_id", i18n.Params{"max": config.MaxStringLength})
+		}
+		if seenUsers[userID] {
+			return entity.NewValidationError("shared_reviewers", entity.RuleUniqueItems, "duplicate_shared_reviewer", i18n.Params{"user_id": userID})
+		}
+		seenUsers[userID] = true
+
+		if memberIDs[userID] {
+			continue
+		}
+		user, err := s.userRepo.GetUser(userID)
+		if err != nil {
+			logging.Printf("ERROR: Failed to get user %s: %v", userID, err)
 			return err
 		}
+		if user == nil {
+			return entity.NewError(entity.ErrorCodeNotFound, "shared_reviewer", i18n.Params{"user_id": userID})
+		}
 	}
 
 	return nil
@@ -81,35 +159,32 @@ func (s *TeamService) AddTeam(team *entity.Team) error {
 
 func (s *TeamService) validateTeamMember(member *entity.User, teamName string) *entity.DomainError {
 	if member.ID == "" {
-		return &entity.DomainError{Code: entity.ErrorCodeNotFound, Message: "member user_id cannot be empty"}
+		return entity.NewValidationError("members.user_id", entity.RuleRequired, "member_required", i18n.Params{"attribute": "user_id"})
 	}
 	if len(member.ID) > config.MaxStringLength {
-		return &entity.DomainError{Code: entity.ErrorCodeNotFound, Message: "member user_id cannot exceed 255 characters"}
+		return entity.NewValidationError("members.user_id", entity.RuleMaxLength, "member_max_length", i18n.Params{"attribute": "user_id", "max": config.MaxStringLength})
 	}
 	if member.Name == "" {
-		return &entity.DomainError{Code: entity.ErrorCodeNotFound, Message: "member username cannot be empty"}
+		return entity.NewValidationError("members.username", entity.RuleRequired, "member_required", i18n.Params{"attribute": "username"})
 	}
 	if len(member.Name) > config.MaxStringLength {
-		return &entity.DomainError{Code: entity.ErrorCodeNotFound, Message: "member username cannot exceed 255 characters"}
+		return entity.NewValidationError("members.username", entity.RuleMaxLength, "member_max_length", i18n.Params{"attribute": "username", "max": config.MaxStringLength})
 	}
 	if member.Team != teamName {
-		return &entity.DomainError{Code: entity.ErrorCodeNotFound, Message: "member team_name must match team name"}
+		return entity.NewValidationError("members.team_name", entity.RuleConsistent, "member_team", nil)
+	}
+	if member.Grade != "" && !member.Grade.IsValid() {
+		return entity.NewValidationError("members.grade", entity.RuleEnum, "member_grade", i18n.Params{"values": "junior, middle, senior, lead"})
 	}
 	return nil
 }
 
 func (s *TeamService) GetTeam(teamName string) (*entity.Team, error) {
 	if teamName == "" {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "team_name cannot be empty",
-		}
+		return nil, entity.NewValidationError("team_name", entity.RuleRequired, "required", nil)
 	}
 	if len(teamName) > config.MaxStringLength {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "team_name cannot exceed 255 characters",
-		}
+		return nil, entity.NewValidationError("team_name", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
 	}
 
 	team, err := s.teamRepo.GetTeam(teamName)
@@ -118,10 +193,7 @@ func (s *TeamService) GetTeam(teamName string) (*entity.Team, error) {
 		return nil, err
 	}
 	if team == nil {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "team not found",
-		}
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "team", nil)
 	}
 	return team, nil
 }
@@ -3,8 +3,10 @@ package service
 import (
 	"pr-review/internal/config"
 	"pr-review/internal/entity"
+	"pr-review/internal/i18n"
 	"pr-review/internal/logging"
 	"pr-review/internal/repo"
+	"time"
 )
 
 type UserService struct {
@@ -19,52 +21,154 @@ func NewUserService(userRepo repo.UserRepository, prService *PullRequestService)
 	}
 }
 
-func (s *UserService) SetIsActive(userID string, isActive bool) (*entity.User, error) {
+func (s *UserService) SetIsActive(userID string, isActive bool, reassign *bool) (*entity.User, *entity.ReassignmentReport, error) {
 	if userID == "" {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "user_id cannot be empty",
-		}
+		return nil, nil, entity.NewValidationError("user_id", entity.RuleRequired, "required", nil)
 	}
 	if len(userID) > config.MaxStringLength {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "user_id cannot exceed 255 characters",
+		return nil, nil, entity.NewValidationError("user_id", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+
+	user, err := s.userRepo.GetUser(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, entity.NewError(entity.ErrorCodeNotFound, "user", nil)
+	}
+
+	user.IsActive = isActive
+
+	shouldReassign, err := s.shouldReassign(user, reassign)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !shouldReassign {
+		if err := s.userRepo.UpdateUser(user); err != nil {
+			logging.Printf("ERROR: Failed to update user %s: %v", userID, err)
+			return nil, nil, err
 		}
+		return user, nil, nil
+	}
+
+	report, err := s.prService.handOverReviews(user, func(reassignments []entity.ReviewerReassignment) error {
+		return s.userRepo.UpdateUserWithReassignments(user, reassignments)
+	})
+	if err != nil {
+		logging.Printf("ERROR: Failed to deactivate user %s with reassignments: %v", userID, err)
+		return nil, nil, err
+	}
+
+	return user, report, nil
+}
+
+func (s *UserService) shouldReassign(user *entity.User, reassign *bool) (bool, error) {
+	if user.IsActive {
+		return false, nil
+	}
+	if reassign != nil {
+		return *reassign, nil
+	}
+	return s.prService.reassignOnDeactivationDefault(user.Team)
+}
+
+func (s *UserService) SetWorkSchedule(userID string, schedule *entity.WorkSchedule) (*entity.WorkSchedule, error) {
+	if userID == "" {
+		return nil, entity.NewValidationError("user_id", entity.RuleRequired, "required", nil)
+	}
+	if len(userID) > config.MaxStringLength {
+		return nil, entity.NewValidationError("user_id", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+	if derr := s.validateWorkSchedule(schedule); derr != nil {
+		return nil, derr
 	}
 
 	user, err := s.userRepo.GetUser(userID)
 	if err != nil {
+		logging.Printf("ERROR: Failed to get user %s: %v", userID, err)
 		return nil, err
 	}
 	if user == nil {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "user not found",
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "user", nil)
+	}
+
+	if err := s.userRepo.SetWorkSchedule(userID, schedule); err != nil {
+		logging.Printf("ERROR: Failed to set work schedule for user %s: %v", userID, err)
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (s *UserService) validateWorkSchedule(schedule *entity.WorkSchedule) *entity.DomainError {
+	if schedule == nil {
+		return entity.NewValidationError("schedule", entity.RuleRequired, "required", nil)
+	}
+	if schedule.TimeZone == "" {
+		schedule.TimeZone = "UTC"
+	}
+	if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+		return entity.NewValidationError("time_zone", entity.RuleFormat, "time_zone", i18n.Params{"time_zone": schedule.TimeZone})
+	}
+
+	start, err := time.Parse(entity.ClockLayout, schedule.Start)
+	if err != nil {
+		return entity.NewValidationError("work_start", entity.RuleFormat, "clock", nil)
+	}
+	end, err := time.Parse(entity.ClockLayout, schedule.End)
+	if err != nil {
+		return entity.NewValidationError("work_end", entity.RuleFormat, "clock", nil)
+	}
+	if end.Equal(start) {
+		return entity.NewValidationError("work_end", entity.RuleConsistent, "work_end", nil)
+	}
+
+	if len(schedule.Days) == 0 {
+		return entity.NewValidationError("work_days", entity.RuleRequired, "required", nil)
+	}
+	seen := make(map[time.Weekday]bool, len(schedule.Days))
+	for _, day := range schedule.Days {
+		if day < time.Sunday || day > time.Saturday {
+			return entity.NewValidationError("work_days", entity.RuleEnum, "work_days_range", nil)
+		}
+		if seen[day] {
+			return entity.NewValidationError("work_days", entity.RuleUniqueItems, "duplicates", nil)
 		}
+		seen[day] = true
 	}
+	return nil
+}
 
-	user.IsActive = isActive
-	if err := s.userRepo.UpdateUser(user); err != nil {
-		logging.Printf("ERROR: Failed to update user %s: %v", userID, err)
+func (s *UserService) GetReviewPRs(userID string, filter entity.ReviewQueueFilter) ([]*entity.PullRequest, error) {
+	if userID == "" {
+		return nil, entity.NewValidationError("user_id", entity.RuleRequired, "required", nil)
+	}
+	if len(userID) > config.MaxStringLength {
+		return nil, entity.NewValidationError("user_id", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+
+	user, err := s.userRepo.GetUser(userID)
+	if err != nil {
+		logging.Printf("ERROR: Failed to get user %s: %v", userID, err)
 		return nil, err
 	}
+	if user == nil {
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "user", nil)
+	}
 
-	return user, nil
+	if filter.Priority != "" && !filter.Priority.IsValid() {
+		return nil, entity.NewValidationError("priority", entity.RuleEnum, "one_of", i18n.Params{"values": "low, normal, high"})
+	}
+
+	return s.prService.GetReviewPRs(userID, filter)
 }
 
-func (s *UserService) GetReviewPRs(userID string) ([]*entity.PullRequest, error) {
+func (s *UserService) GetUser(userID string) (*entity.User, error) {
 	if userID == "" {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "user_id cannot be empty",
-		}
+		return nil, entity.NewValidationError("user_id", entity.RuleRequired, "required", nil)
 	}
 	if len(userID) > config.MaxStringLength {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "user_id cannot exceed 255 characters",
-		}
+		return nil, entity.NewValidationError("user_id", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
 	}
 
 	user, err := s.userRepo.GetUser(userID)
@@ -73,11 +177,79 @@ func (s *UserService) GetReviewPRs(userID string) ([]*entity.PullRequest, error)
 		return nil, err
 	}
 	if user == nil {
-		return nil, &entity.DomainError{
-			Code:    entity.ErrorCodeNotFound,
-			Message: "user not found",
-		}
+		return nil, entity.NewError(entity.ErrorCodeNotFound, "user", nil)
+	}
+	return user, nil
+}
+
+func (s *UserService) ListUsers(filter entity.UserFilter) (*entity.UserPage, error) {
+	if len(filter.TeamName) > config.MaxStringLength {
+		return nil, entity.NewValidationError("team_name", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+	if len(filter.UsernamePrefix) > config.MaxStringLength {
+		return nil, entity.NewValidationError("username_prefix", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+	if filter.Limit == 0 {
+		filter.Limit = config.DefaultPageSize
+	}
+	if filter.Limit < 0 || filter.Limit > config.MaxPageSize {
+		return nil, entity.NewValidationError("limit", entity.RuleRange, "range", i18n.Params{"min": 1, "max": config.MaxPageSize})
+	}
+	if filter.Offset < 0 {
+		return nil, entity.NewValidationError("offset", entity.RuleMinimum, "negative", nil)
+	}
+
+	limit := filter.Limit
+	filter.Limit++
+	users, err := s.userRepo.ListUsers(filter)
+	if err != nil {
+		logging.Printf("ERROR: Failed to list users: %v", err)
+		return nil, err
+	}
+
+	page := &entity.UserPage{Users: users, Limit: limit, Offset: filter.Offset}
+	if len(users) > limit {
+		page.Users = users[:limit]
+		next := filter.Offset + limit
+		page.NextOffset = &next
+	}
+	return page, nil
+}
+
+func (s *UserService) UpdateUsername(userID, username string) (*entity.User, error) {
+	user, err := s.GetUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	if username == "" {
+		return nil, entity.NewValidationError("username", entity.RuleRequired, "required", nil)
+	}
+	if len(username) > config.MaxStringLength {
+		return nil, entity.NewValidationError("username", entity.RuleMaxLength, "max_length", i18n.Params{"max": config.MaxStringLength})
+	}
+
+	if err := s.userRepo.UpdateUsername(userID, username); err != nil {
+		logging.Printf("ERROR: Failed to update username for user %s: %v", userID, err)
+		return nil, err
+	}
+
+	user.Name = username
+	return user, nil
+}
+
+func (s *UserService) DeleteUser(userID string) (*entity.ReassignmentReport, error) {
+	user, err := s.GetUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.prService.handOverReviews(user, func(reassignments []entity.ReviewerReassignment) error {
+		return s.userRepo.DeleteUser(userID, reassignments, time.Now())
+	})
+	if err != nil {
+		logging.Printf("ERROR: Failed to delete user %s: %v", userID, err)
+		return nil, err
 	}
 
-	return s.prService.GetReviewPRs(userID)
+	return report, nil
 }
@@ -0,0 +1,178 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"pr-review/internal/entity"
+)
+
+const (
+	FormatVersion = 1
+
+	manifestName = "manifest.json"
+	tablesDir    = "tables"
+	tableExt     = ".copy"
+)
+
+var Tables = []string{
+	"organizations",
+	"organization_tokens",
+	"teams",
+	"users",
+	"team_policies",
+	"team_sla",
+	"team_fallbacks",
+	"team_shared_reviewers",
+	"repositories",
+	"pull_requests",
+	"assigned_reviewers",
+	"pull_request_approvals",
+	"pull_request_files",
+	"pull_request_labels",
+	"sla_breaches",
+	"user_availability",
+	"user_schedules",
+	"digest_preferences",
+	"reviewer_affinities",
+	"reviewer_expertise",
+}
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported snapshot format")
+	ErrChecksumMismatch  = errors.New("snapshot checksum mismatch")
+	ErrSchemaMismatch    = errors.New("snapshot schema version mismatch")
+	ErrDatabaseNotEmpty  = errors.New("target database is not empty")
+)
+
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func Write(w io.Writer, manifest *entity.SnapshotManifest) error {
+	manifest.FormatVersion = FormatVersion
+	for _, table := range manifest.Tables {
+		table.SHA256 = Checksum(table.Data)
+	}
+
+	header, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(w)
+	archive := tar.NewWriter(gz)
+
+	if err := writeEntry(archive, manifestName, header, manifest.CreatedAt); err != nil {
+		return err
+	}
+	for _, table := range manifest.Tables {
+		if err := writeEntry(archive, tablePath(table.Name), table.Data, manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func writeEntry(archive *tar.Writer, name string, data []byte, modTime time.Time) error {
+	err := archive.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = archive.Write(data)
+	return err
+}
+
+func Read(r io.Reader) (*entity.SnapshotManifest, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+	defer gz.Close()
+	archive := tar.NewReader(gz)
+
+	header, err := archive.Next()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+	if header.Name != manifestName {
+		return nil, fmt.Errorf("%w: archive must start with %s", ErrUnsupportedFormat, manifestName)
+	}
+
+	var manifest entity.SnapshotManifest
+	if err := json.NewDecoder(archive).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("%w: invalid manifest: %v", ErrUnsupportedFormat, err)
+	}
+	if manifest.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("%w: format version %d, expected %d", ErrUnsupportedFormat, manifest.FormatVersion, FormatVersion)
+	}
+
+	tables := make(map[string]*entity.SnapshotTable, len(manifest.Tables))
+	for _, table := range manifest.Tables {
+		if table == nil || table.Name == "" {
+			return nil, fmt.Errorf("%w: manifest contains a table without a name", ErrUnsupportedFormat)
+		}
+		if _, ok := tables[table.Name]; ok {
+			return nil, fmt.Errorf("%w: duplicate table %s", ErrUnsupportedFormat, table.Name)
+		}
+		tables[table.Name] = table
+	}
+
+	for {
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+		}
+
+		name := strings.TrimSuffix(path.Base(header.Name), tableExt)
+		table, ok := tables[name]
+		if !ok || header.Name != tablePath(name) {
+			return nil, fmt.Errorf("%w: unexpected entry %s", ErrUnsupportedFormat, header.Name)
+		}
+		if table.Data != nil {
+			return nil, fmt.Errorf("%w: duplicate entry %s", ErrUnsupportedFormat, header.Name)
+		}
+
+		data, err := io.ReadAll(archive)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+		}
+		if Checksum(data) != table.SHA256 {
+			return nil, fmt.Errorf("%w: table %s", ErrChecksumMismatch, name)
+		}
+		table.Data = data
+	}
+
+	for _, table := range manifest.Tables {
+		if table.Data == nil {
+			return nil, fmt.Errorf("%w: table %s is missing from the archive", ErrUnsupportedFormat, table.Name)
+		}
+	}
+	return &manifest, nil
+}
+
+func tablePath(name string) string {
+	return tablesDir + "/" + name + tableExt
+}
@@ -0,0 +1 @@
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS reassign_on_deactivation BOOLEAN NOT NULL DEFAULT false;
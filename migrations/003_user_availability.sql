@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS user_availability (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL,
+    away_from TIMESTAMP NOT NULL,
+    away_until TIMESTAMP NOT NULL,
+    reason VARCHAR(255),
+    handover BOOLEAN NOT NULL DEFAULT false,
+    handed_over_at TIMESTAMP,
+    returned_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (away_until > away_from),
+    FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_availability_user_id ON user_availability(user_id);
+CREATE INDEX IF NOT EXISTS idx_user_availability_window ON user_availability(away_from, away_until);
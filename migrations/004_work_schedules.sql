@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS user_schedules (
+    user_id VARCHAR(255) PRIMARY KEY,
+    time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    work_start VARCHAR(5) NOT NULL,
+    work_end VARCHAR(5) NOT NULL,
+    work_days INTEGER[] NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS selection_strategy VARCHAR(32) NOT NULL DEFAULT 'random';
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS team_sla (
+    team_name VARCHAR(255) PRIMARY KEY,
+    first_review_minutes INTEGER NOT NULL DEFAULT 0 CHECK (first_review_minutes >= 0),
+    merge_minutes INTEGER NOT NULL DEFAULT 0 CHECK (merge_minutes >= 0),
+    auto_reassign BOOLEAN NOT NULL DEFAULT false,
+    FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS sla_breaches (
+    id BIGSERIAL PRIMARY KEY,
+    kind VARCHAR(32) NOT NULL CHECK (kind IN ('FIRST_REVIEW', 'MERGE')),
+    pull_request_id VARCHAR(255) NOT NULL,
+    reviewer_id VARCHAR(255) NOT NULL DEFAULT '',
+    team_name VARCHAR(255) NOT NULL,
+    started_at TIMESTAMP NOT NULL,
+    deadline TIMESTAMP NOT NULL,
+    detected_at TIMESTAMP NOT NULL,
+    reassigned_to VARCHAR(255),
+    resolved_at TIMESTAMP,
+    FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sla_breaches_open
+    ON sla_breaches(pull_request_id, reviewer_id, kind) WHERE resolved_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_sla_breaches_team_name ON sla_breaches(team_name);
+CREATE INDEX IF NOT EXISTS idx_assigned_reviewers_assigned_at ON assigned_reviewers(assigned_at);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS digest_preferences (
+    user_id VARCHAR(255) PRIMARY KEY,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    frequency VARCHAR(16) NOT NULL DEFAULT 'daily' CHECK (frequency IN ('hourly', 'daily', 'weekly')),
+    email VARCHAR(255),
+    last_sent_at TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
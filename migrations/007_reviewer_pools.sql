@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS team_fallbacks (
+    team_name VARCHAR(255) NOT NULL,
+    fallback_team_name VARCHAR(255) NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (team_name, fallback_team_name),
+    FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE,
+    FOREIGN KEY (fallback_team_name) REFERENCES teams(team_name) ON DELETE CASCADE,
+    CHECK (team_name <> fallback_team_name)
+);
+
+CREATE TABLE IF NOT EXISTS team_shared_reviewers (
+    team_name VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    PRIMARY KEY (team_name, user_id),
+    FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS team_policies (
+    team_name VARCHAR(255) PRIMARY KEY,
+    required_reviewers INTEGER NOT NULL DEFAULT 2 CHECK (required_reviewers >= 0),
+    selection_strategy VARCHAR(32) NOT NULL DEFAULT 'random',
+    allow_self_merge BOOLEAN NOT NULL DEFAULT true,
+    required_approvals INTEGER NOT NULL DEFAULT 0 CHECK (required_approvals >= 0),
+    reassign_on_deactivation BOOLEAN NOT NULL DEFAULT false,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE
+);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_schema = current_schema() AND table_name = 'team_policies' AND column_name = 'org_id'
+    ) THEN
+        INSERT INTO team_policies (team_name, selection_strategy, reassign_on_deactivation)
+        SELECT team_name, selection_strategy, reassign_on_deactivation FROM teams
+        ON CONFLICT (team_name) DO NOTHING;
+    END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS pull_request_approvals (
+    pull_request_id VARCHAR(255) NOT NULL,
+    reviewer_id VARCHAR(255) NOT NULL,
+    approved_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (pull_request_id, reviewer_id),
+    FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    FOREIGN KEY (reviewer_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
@@ -0,0 +1,4 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS grade VARCHAR(16) NOT NULL DEFAULT 'middle'
+    CHECK (grade IN ('junior', 'middle', 'senior', 'lead'));
+
+ALTER TABLE team_policies ADD COLUMN IF NOT EXISTS composition_rules JSONB NOT NULL DEFAULT '[]';
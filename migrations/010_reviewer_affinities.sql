@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS reviewer_affinities (
+    author_id VARCHAR(255) NOT NULL,
+    reviewer_id VARCHAR(255) NOT NULL,
+    weight INTEGER NOT NULL DEFAULT 0 CHECK (weight >= 0),
+    blocked BOOLEAN NOT NULL DEFAULT false,
+    reason VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (author_id, reviewer_id),
+    FOREIGN KEY (author_id) REFERENCES users(user_id) ON DELETE CASCADE,
+    FOREIGN KEY (reviewer_id) REFERENCES users(user_id) ON DELETE CASCADE,
+    CHECK (author_id <> reviewer_id)
+);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS repositories (
+    repository_name VARCHAR(255) PRIMARY KEY,
+    team_name VARCHAR(255),
+    codeowners TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE SET NULL
+);
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS repository_name VARCHAR(255)
+    REFERENCES repositories(repository_name) ON DELETE SET NULL;
+
+CREATE TABLE IF NOT EXISTS pull_request_files (
+    pull_request_id VARCHAR(255) NOT NULL,
+    path VARCHAR(1024) NOT NULL,
+    PRIMARY KEY (pull_request_id, path),
+    FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_repository ON pull_requests(repository_name);
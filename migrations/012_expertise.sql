@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS pull_request_labels (
+    pull_request_id VARCHAR(255) NOT NULL,
+    label VARCHAR(64) NOT NULL,
+    PRIMARY KEY (pull_request_id, label),
+    FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS reviewer_expertise (
+    user_id VARCHAR(255) NOT NULL,
+    area VARCHAR(1100) NOT NULL,
+    score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, area),
+    FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviewer_expertise_area ON reviewer_expertise(area);
@@ -0,0 +1,9 @@
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS priority VARCHAR(16) NOT NULL DEFAULT 'normal'
+    CHECK (priority IN ('low', 'normal', 'high'));
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_labels_label ON pull_request_labels(label);
+
+ALTER TABLE team_policies ADD COLUMN IF NOT EXISTS security_team VARCHAR(255)
+    REFERENCES teams(team_name) ON DELETE SET NULL;
+ALTER TABLE team_policies ADD COLUMN IF NOT EXISTS max_open_reviews INTEGER NOT NULL DEFAULT 0
+    CHECK (max_open_reviews >= 0);
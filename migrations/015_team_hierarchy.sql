@@ -0,0 +1,7 @@
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS parent_team VARCHAR(255)
+    REFERENCES teams(team_name) ON DELETE SET NULL
+    CHECK (parent_team <> team_name);
+
+CREATE INDEX IF NOT EXISTS idx_teams_parent_team ON teams(parent_team);
+
+ALTER TABLE team_policies ADD COLUMN IF NOT EXISTS hierarchy_fallback BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,148 @@
+CREATE TABLE IF NOT EXISTS organizations (
+    org_id VARCHAR(255) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO organizations (org_id, name) VALUES ('default', 'Default organization')
+ON CONFLICT (org_id) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS organization_tokens (
+    token_hash CHAR(64) PRIMARY KEY,
+    org_id VARCHAR(255) NOT NULL,
+    description VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP,
+    FOREIGN KEY (org_id) REFERENCES organizations(org_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_tokens_org_id ON organization_tokens(org_id);
+
+DO $$
+DECLARE
+    tenant_tables TEXT[] := ARRAY[
+        'teams', 'users', 'pull_requests', 'assigned_reviewers', 'user_availability',
+        'user_schedules', 'team_sla', 'sla_breaches', 'digest_preferences', 'team_fallbacks',
+        'team_shared_reviewers', 'team_policies', 'pull_request_approvals', 'reviewer_affinities',
+        'repositories', 'pull_request_files', 'pull_request_labels', 'reviewer_expertise'
+    ];
+    tbl TEXT;
+    con RECORD;
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_schema = current_schema() AND table_name = 'teams' AND column_name = 'org_id'
+    ) THEN
+        RETURN;
+    END IF;
+
+    FOREACH tbl IN ARRAY tenant_tables LOOP
+        EXECUTE format('ALTER TABLE %I ADD COLUMN org_id VARCHAR(255) NOT NULL DEFAULT %L', tbl, 'default');
+    END LOOP;
+
+    FOR con IN
+        SELECT conrelid::regclass AS tbl, conname FROM pg_constraint
+        WHERE contype = 'f' AND conrelid::regclass::text = ANY(tenant_tables)
+    LOOP
+        EXECUTE format('ALTER TABLE %s DROP CONSTRAINT %I', con.tbl, con.conname);
+    END LOOP;
+
+    FOR con IN
+        SELECT conrelid::regclass AS tbl, conname FROM pg_constraint
+        WHERE contype = 'p' AND conrelid::regclass::text = ANY(tenant_tables)
+            AND conrelid::regclass::text NOT IN ('user_availability', 'sla_breaches')
+    LOOP
+        EXECUTE format('ALTER TABLE %s DROP CONSTRAINT %I', con.tbl, con.conname);
+    END LOOP;
+
+    ALTER TABLE teams ADD PRIMARY KEY (org_id, team_name);
+    ALTER TABLE users ADD PRIMARY KEY (org_id, user_id);
+    ALTER TABLE pull_requests ADD PRIMARY KEY (org_id, pull_request_id);
+    ALTER TABLE assigned_reviewers ADD PRIMARY KEY (org_id, pull_request_id, reviewer_id);
+    ALTER TABLE user_schedules ADD PRIMARY KEY (org_id, user_id);
+    ALTER TABLE team_sla ADD PRIMARY KEY (org_id, team_name);
+    ALTER TABLE digest_preferences ADD PRIMARY KEY (org_id, user_id);
+    ALTER TABLE team_fallbacks ADD PRIMARY KEY (org_id, team_name, fallback_team_name);
+    ALTER TABLE team_shared_reviewers ADD PRIMARY KEY (org_id, team_name, user_id);
+    ALTER TABLE team_policies ADD PRIMARY KEY (org_id, team_name);
+    ALTER TABLE pull_request_approvals ADD PRIMARY KEY (org_id, pull_request_id, reviewer_id);
+    ALTER TABLE reviewer_affinities ADD PRIMARY KEY (org_id, author_id, reviewer_id);
+    ALTER TABLE repositories ADD PRIMARY KEY (org_id, repository_name);
+    ALTER TABLE pull_request_files ADD PRIMARY KEY (org_id, pull_request_id, path);
+    ALTER TABLE pull_request_labels ADD PRIMARY KEY (org_id, pull_request_id, label);
+    ALTER TABLE reviewer_expertise ADD PRIMARY KEY (org_id, user_id, area);
+
+    FOREACH tbl IN ARRAY tenant_tables LOOP
+        EXECUTE format('ALTER TABLE %I ALTER COLUMN org_id DROP DEFAULT', tbl);
+        EXECUTE format('ALTER TABLE %I ADD FOREIGN KEY (org_id) REFERENCES organizations(org_id) ON DELETE CASCADE', tbl);
+    END LOOP;
+
+    ALTER TABLE teams ADD FOREIGN KEY (org_id, parent_team)
+        REFERENCES teams(org_id, team_name) ON DELETE SET NULL (parent_team);
+    ALTER TABLE users ADD FOREIGN KEY (org_id, team_name)
+        REFERENCES teams(org_id, team_name) ON DELETE CASCADE;
+    ALTER TABLE pull_requests ADD FOREIGN KEY (org_id, author_id)
+        REFERENCES users(org_id, user_id) ON DELETE RESTRICT;
+    ALTER TABLE pull_requests ADD FOREIGN KEY (org_id, repository_name)
+        REFERENCES repositories(org_id, repository_name) ON DELETE SET NULL (repository_name);
+    ALTER TABLE assigned_reviewers ADD FOREIGN KEY (org_id, pull_request_id)
+        REFERENCES pull_requests(org_id, pull_request_id) ON DELETE CASCADE;
+    ALTER TABLE assigned_reviewers ADD FOREIGN KEY (org_id, reviewer_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE;
+    ALTER TABLE user_availability ADD FOREIGN KEY (org_id, user_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE;
+    ALTER TABLE user_schedules ADD FOREIGN KEY (org_id, user_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE;
+    ALTER TABLE team_sla ADD FOREIGN KEY (org_id, team_name)
+        REFERENCES teams(org_id, team_name) ON DELETE CASCADE;
+    ALTER TABLE sla_breaches ADD FOREIGN KEY (org_id, pull_request_id)
+        REFERENCES pull_requests(org_id, pull_request_id) ON DELETE CASCADE;
+    ALTER TABLE digest_preferences ADD FOREIGN KEY (org_id, user_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE;
+    ALTER TABLE team_fallbacks ADD FOREIGN KEY (org_id, team_name)
+        REFERENCES teams(org_id, team_name) ON DELETE CASCADE;
+    ALTER TABLE team_fallbacks ADD FOREIGN KEY (org_id, fallback_team_name)
+        REFERENCES teams(org_id, team_name) ON DELETE CASCADE;
+    ALTER TABLE team_shared_reviewers ADD FOREIGN KEY (org_id, team_name)
+        REFERENCES teams(org_id, team_name) ON DELETE CASCADE;
+    ALTER TABLE team_shared_reviewers ADD FOREIGN KEY (org_id, user_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE;
+    ALTER TABLE team_policies ADD FOREIGN KEY (org_id, team_name)
+        REFERENCES teams(org_id, team_name) ON DELETE CASCADE;
+    ALTER TABLE team_policies ADD FOREIGN KEY (org_id, security_team)
+        REFERENCES teams(org_id, team_name) ON DELETE SET NULL (security_team);
+    ALTER TABLE pull_request_approvals ADD FOREIGN KEY (org_id, pull_request_id)
+        REFERENCES pull_requests(org_id, pull_request_id) ON DELETE CASCADE;
+    ALTER TABLE pull_request_approvals ADD FOREIGN KEY (org_id, reviewer_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE;
+    ALTER TABLE reviewer_affinities ADD FOREIGN KEY (org_id, author_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE;
+    ALTER TABLE reviewer_affinities ADD FOREIGN KEY (org_id, reviewer_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE;
+    ALTER TABLE repositories ADD FOREIGN KEY (org_id, team_name)
+        REFERENCES teams(org_id, team_name) ON DELETE SET NULL (team_name);
+    ALTER TABLE pull_request_files ADD FOREIGN KEY (org_id, pull_request_id)
+        REFERENCES pull_requests(org_id, pull_request_id) ON DELETE CASCADE;
+    ALTER TABLE pull_request_labels ADD FOREIGN KEY (org_id, pull_request_id)
+        REFERENCES pull_requests(org_id, pull_request_id) ON DELETE CASCADE;
+    ALTER TABLE reviewer_expertise ADD FOREIGN KEY (org_id, user_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE;
+END $$;
+
+DROP INDEX IF EXISTS idx_sla_breaches_open;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sla_breaches_open
+    ON sla_breaches(org_id, pull_request_id, reviewer_id, kind) WHERE resolved_at IS NULL;
+
+DROP INDEX IF EXISTS idx_users_team_name;
+DROP INDEX IF EXISTS idx_pull_requests_author_id;
+DROP INDEX IF EXISTS idx_assigned_reviewers_reviewer_id;
+DROP INDEX IF EXISTS idx_teams_parent_team;
+DROP INDEX IF EXISTS idx_sla_breaches_team_name;
+DROP INDEX IF EXISTS idx_user_availability_user_id;
+
+CREATE INDEX IF NOT EXISTS idx_users_org_team_name ON users(org_id, team_name);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_org_author_id ON pull_requests(org_id, author_id);
+CREATE INDEX IF NOT EXISTS idx_assigned_reviewers_org_reviewer_id ON assigned_reviewers(org_id, reviewer_id);
+CREATE INDEX IF NOT EXISTS idx_teams_org_parent_team ON teams(org_id, parent_team);
+CREATE INDEX IF NOT EXISTS idx_sla_breaches_org_team_name ON sla_breaches(org_id, team_name);
+CREATE INDEX IF NOT EXISTS idx_user_availability_org_user_id ON user_availability(org_id, user_id);
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_users_org_username_prefix ON users(org_id, lower(username) text_pattern_ops);
@@ -0,0 +1,12 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+ALTER TABLE assigned_reviewers DROP CONSTRAINT IF EXISTS assigned_reviewers_org_id_reviewer_id_fkey;
+ALTER TABLE assigned_reviewers ADD CONSTRAINT assigned_reviewers_org_id_reviewer_id_fkey
+    FOREIGN KEY (org_id, reviewer_id) REFERENCES users(org_id, user_id) ON DELETE RESTRICT;
+
+ALTER TABLE pull_request_approvals DROP CONSTRAINT IF EXISTS pull_request_approvals_org_id_reviewer_id_fkey;
+ALTER TABLE pull_request_approvals ADD CONSTRAINT pull_request_approvals_org_id_reviewer_id_fkey
+    FOREIGN KEY (org_id, reviewer_id) REFERENCES users(org_id, user_id) ON DELETE RESTRICT;
+
+DROP INDEX IF EXISTS idx_users_org_team_name;
+CREATE INDEX IF NOT EXISTS idx_users_org_team_name ON users(org_id, team_name) WHERE deleted_at IS NULL;
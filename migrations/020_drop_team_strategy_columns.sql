@@ -0,0 +1,2 @@
+ALTER TABLE teams DROP COLUMN IF EXISTS selection_strategy;
+ALTER TABLE teams DROP COLUMN IF EXISTS reassign_on_deactivation;
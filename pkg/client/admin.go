@@ -0,0 +1,89 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"pr-review/pkg/client/api"
+)
+
+const (
+	ResourceTeams        = "teams"
+	ResourceUsers        = "users"
+	ResourcePullRequests = "pull_requests"
+)
+
+type importResponse struct {
+	Report *ImportReport `json:"report"`
+}
+
+func (c *Client) Import(ctx context.Context, data *BulkData, dryRun bool) (*ImportReport, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return c.importData(ctx, importParams("", dryRun), bytes.NewReader(payload), jsonContentType)
+}
+
+func (c *Client) ImportCSV(ctx context.Context, resource string, r io.Reader, dryRun bool) (*ImportReport, error) {
+	return c.importData(ctx, importParams(resource, dryRun), r, "text/csv")
+}
+
+func (c *Client) importData(ctx context.Context, params *api.ImportDataParams, body io.Reader, contentType string) (*ImportReport, error) {
+	var resp importResponse
+	err := c.do(nil, &resp, func(io.Reader) (*http.Response, error) {
+		return c.api.ImportDataWithBody(ctx, params, contentType, body)
+	})
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnprocessableEntity {
+		var rejected importResponse
+		if json.Unmarshal(apiErr.Body, &rejected) == nil && rejected.Report != nil {
+			return rejected.Report, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp.Report, nil
+}
+
+func importParams(resource string, dryRun bool) *api.ImportDataParams {
+	params := &api.ImportDataParams{}
+	if resource != "" {
+		r := api.ImportDataParamsResource(resource)
+		params.Resource = &r
+	}
+	if dryRun {
+		params.DryRun = &dryRun
+	}
+	return params
+}
+
+func (c *Client) Export(ctx context.Context) (*BulkData, error) {
+	var data BulkData
+	format := api.Json
+	err := c.do(nil, &data, func(io.Reader) (*http.Response, error) {
+		return c.api.ExportData(ctx, &api.ExportDataParams{Format: &format})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+func (c *Client) ExportCSV(ctx context.Context, resource string) ([]byte, error) {
+	var data []byte
+	format := api.Csv
+	r := api.ExportDataParamsResource(resource)
+	err := c.do(nil, &data, func(io.Reader) (*http.Response, error) {
+		return c.api.ExportData(ctx, &api.ExportDataParams{Format: &format, Resource: &r})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"pr-review/pkg/client/api"
+)
+
+func (c *Client) ListAffinities(ctx context.Context, authorID string) ([]*ReviewerAffinity, error) {
+	var resp struct {
+		Affinities []*ReviewerAffinity `json:"affinities"`
+	}
+	err := c.do(nil, &resp, func(io.Reader) (*http.Response, error) {
+		return c.api.ListAffinities(ctx, &api.ListAffinitiesParams{AuthorId: authorID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Affinities, nil
+}
+
+func (c *Client) SetAffinity(ctx context.Context, affinity *ReviewerAffinity) (*ReviewerAffinity, error) {
+	var resp struct {
+		Affinity *ReviewerAffinity `json:"affinity"`
+	}
+	err := c.do(affinity, &resp, func(body io.Reader) (*http.Response, error) {
+		return c.api.SetAffinityWithBody(ctx, jsonContentType, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Affinity, nil
+}
+
+func (c *Client) DeleteAffinity(ctx context.Context, authorID, reviewerID string) error {
+	req := map[string]string{"author_id": authorID, "reviewer_id": reviewerID}
+	return c.do(req, nil, func(body io.Reader) (*http.Response, error) {
+		return c.api.DeleteAffinityWithBody(ctx, jsonContentType, body)
+	})
+}
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"pr-review/pkg/client/api"
+)
+
+const (
+	DefaultBaseURL = "http://localhost:8080"
+	DefaultTimeout = 30 * time.Second
+
+	OrganizationHeader = "X-Organization-ID"
+
+	jsonContentType = "application/json"
+)
+
+type Config struct {
+	BaseURL      string
+	Token        string
+	Organization string
+	HTTPClient   *http.Client
+}
+
+type Client struct {
+	api          *api.Client
+	token        string
+	organization string
+}
+
+func New(cfg Config) (*Client, error) {
+	rawURL := cfg.BaseURL
+	if rawURL == "" {
+		rawURL = DefaultBaseURL
+	}
+	baseURL, err := url.Parse(strings.TrimRight(rawURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL: %w", err)
+	}
+	if baseURL.Scheme != "http" && baseURL.Scheme != "https" {
+		return nil, fmt.Errorf("invalid base URL %q: scheme must be http or https", rawURL)
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultTimeout}
+	}
+
+	c := &Client{
+		token:        cfg.Token,
+		organization: cfg.Organization,
+	}
+	c.api, err = api.NewClient(baseURL.String(), api.WithHTTPClient(httpClient), api.WithRequestEditorFn(c.authorize))
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Details    []FieldViolation
+	Body       []byte
+}
+
+type FieldViolation struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Message)
+	}
+	if len(e.Details) == 0 {
+		return fmt.Sprintf("%s: %s", e.Code, e.Message)
+	}
+	details := make([]string, len(e.Details))
+	for i, detail := range e.Details {
+		details[i] = detail.Field + ": " + detail.Message
+	}
+	return fmt.Sprintf("%s: %s (%s)", e.Code, e.Message, strings.Join(details, "; "))
+}
+
+func IsCode(err error, code string) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
+type errorResponse struct {
+	Error struct {
+		Code    string           `json:"code"`
+		Message string           `json:"message"`
+		Details []FieldViolation `json:"details"`
+	} `json:"error"`
+}
+
+func (c *Client) authorize(_ context.Context, req *http.Request) error {
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	if c.organization != "" {
+		req.Header.Set(OrganizationHeader, c.organization)
+	}
+	return nil
+}
+
+type operation func(body io.Reader) (*http.Response, error)
+
+func (c *Client) do(in, out any, call operation) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	resp, err := call(body)
+	if err != nil {
+		return err
+	}
+	return c.receive(resp, out)
+}
+
+func (c *Client) receive(resp *http.Response, out any) error {
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &APIError{StatusCode: resp.StatusCode, Body: data}
+		var payload errorResponse
+		if json.Unmarshal(data, &payload) == nil && payload.Error.Code != "" {
+			apiErr.Code = payload.Error.Code
+			apiErr.Message = payload.Error.Message
+			apiErr.Details = payload.Error.Details
+		} else {
+			apiErr.Message = strings.TrimSpace(string(data))
+			if apiErr.Message == "" {
+				apiErr.Message = http.StatusText(resp.StatusCode)
+			}
+		}
+		return apiErr
+	}
+
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if raw, ok := out.(*[]byte); ok {
+		*raw = data
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"pr-review/pkg/client/api"
+)
+
+type CreatePullRequestRequest struct {
+	PullRequestID   string   `json:"pull_request_id"`
+	PullRequestName string   `json:"pull_request_name"`
+	AuthorID        string   `json:"author_id"`
+	Repository      string   `json:"repository,omitempty"`
+	ChangedFiles    []string `json:"changed_files,omitempty"`
+	Labels          []string `json:"labels,omitempty"`
+	Priority        Priority `json:"priority,omitempty"`
+	Description     string   `json:"description,omitempty"`
+}
+
+type UpdatePullRequestRequest struct {
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName *string   `json:"pull_request_name,omitempty"`
+	Description     *string   `json:"description,omitempty"`
+	AuthorID        *string   `json:"author_id,omitempty"`
+	Labels          *[]string `json:"labels,omitempty"`
+	Version         *int      `json:"version,omitempty"`
+}
+
+type ReassignResult struct {
+	PR         *PullRequest `json:"pr"`
+	ReplacedBy string       `json:"replaced_by"`
+}
+
+type pullRequestResponse struct {
+	PR *PullRequest `json:"pr"`
+}
+
+func (c *Client) CreatePullRequest(ctx context.Context, req *CreatePullRequestRequest) (*PullRequest, error) {
+	return c.pullRequest(req, func(body io.Reader) (*http.Response, error) {
+		return c.api.CreatePullRequestWithBody(ctx, jsonContentType, body)
+	})
+}
+
+func (c *Client) UpdatePullRequest(ctx context.Context, req *UpdatePullRequestRequest) (*PullRequest, error) {
+	return c.pullRequest(req, func(body io.Reader) (*http.Response, error) {
+		return c.api.UpdatePullRequestWithBody(ctx, &api.UpdatePullRequestParams{}, jsonContentType, body)
+	})
+}
+
+func (c *Client) MergePullRequest(ctx context.Context, pullRequestID, mergedBy string) (*PullRequest, error) {
+	req := map[string]string{"pull_request_id": pullRequestID}
+	if mergedBy != "" {
+		req["merged_by"] = mergedBy
+	}
+	return c.pullRequest(req, func(body io.Reader) (*http.Response, error) {
+		return c.api.MergePullRequestWithBody(ctx, jsonContentType, body)
+	})
+}
+
+func (c *Client) ApprovePullRequest(ctx context.Context, pullRequestID, userID string) (*PullRequest, error) {
+	req := map[string]string{"pull_request_id": pullRequestID, "user_id": userID}
+	return c.pullRequest(req, func(body io.Reader) (*http.Response, error) {
+		return c.api.ApprovePullRequestWithBody(ctx, jsonContentType, body)
+	})
+}
+
+func (c *Client) ReassignReviewer(ctx context.Context, pullRequestID, oldUserID string) (*ReassignResult, error) {
+	req := map[string]string{"pull_request_id": pullRequestID, "old_user_id": oldUserID}
+	var result ReassignResult
+	err := c.do(req, &result, func(body io.Reader) (*http.Response, error) {
+		return c.api.ReassignReviewerWithBody(ctx, jsonContentType, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) pullRequest(req any, call operation) (*PullRequest, error) {
+	var resp pullRequestResponse
+	if err := c.do(req, &resp, call); err != nil {
+		return nil, err
+	}
+	return resp.PR, nil
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"pr-review/pkg/client/api"
+)
+
+type repositoryResponse struct {
+	Repository *Repository `json:"repository"`
+}
+
+func (c *Client) AddRepository(ctx context.Context, repositoryName, teamName string) (*Repository, error) {
+	req := map[string]string{"repository_name": repositoryName}
+	if teamName != "" {
+		req["team_name"] = teamName
+	}
+	return c.repository(req, func(body io.Reader) (*http.Response, error) {
+		return c.api.AddRepositoryWithBody(ctx, jsonContentType, body)
+	})
+}
+
+func (c *Client) GetRepository(ctx context.Context, repositoryName string) (*Repository, error) {
+	return c.repository(nil, func(io.Reader) (*http.Response, error) {
+		return c.api.GetRepository(ctx, &api.GetRepositoryParams{RepositoryName: repositoryName})
+	})
+}
+
+func (c *Client) UploadCodeOwners(ctx context.Context, repositoryName, content string) (*Repository, error) {
+	req := map[string]string{"repository_name": repositoryName, "content": content}
+	return c.repository(req, func(body io.Reader) (*http.Response, error) {
+		return c.api.UploadCodeOwnersWithBody(ctx, jsonContentType, body)
+	})
+}
+
+func (c *Client) repository(req any, call operation) (*Repository, error) {
+	var resp repositoryResponse
+	if err := c.do(req, &resp, call); err != nil {
+		return nil, err
+	}
+	return resp.Repository, nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"pr-review/pkg/client/api"
+)
+
+type SLABreachOptions struct {
+	TeamName string
+	Kind     string
+	OpenOnly bool
+}
+
+type slaSettingsResponse struct {
+	Settings *SLASettings `json:"settings"`
+}
+
+func (c *Client) GetSLASettings(ctx context.Context, teamName string) (*SLASettings, error) {
+	var resp slaSettingsResponse
+	err := c.do(nil, &resp, func(io.Reader) (*http.Response, error) {
+		return c.api.GetSLASettings(ctx, &api.GetSLASettingsParams{TeamName: teamName})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Settings, nil
+}
+
+func (c *Client) SetSLASettings(ctx context.Context, settings *SLASettings) (*SLASettings, error) {
+	var resp slaSettingsResponse
+	err := c.do(settings, &resp, func(body io.Reader) (*http.Response, error) {
+		return c.api.SetSLASettingsWithBody(ctx, jsonContentType, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Settings, nil
+}
+
+func (c *Client) GetSLABreaches(ctx context.Context, opts SLABreachOptions) ([]*SLABreach, error) {
+	params := &api.ListSLABreachesParams{}
+	if opts.TeamName != "" {
+		params.TeamName = &opts.TeamName
+	}
+	if opts.Kind != "" {
+		kind := api.ListSLABreachesParamsKind(opts.Kind)
+		params.Kind = &kind
+	}
+	if opts.OpenOnly {
+		params.OpenOnly = &opts.OpenOnly
+	}
+
+	var resp struct {
+		Breaches []*SLABreach `json:"breaches"`
+	}
+	err := c.do(nil, &resp, func(io.Reader) (*http.Response, error) {
+		return c.api.ListSLABreaches(ctx, params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Breaches, nil
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"pr-review/pkg/client/api"
+)
+
+type SetReviewerPoolsRequest struct {
+	TeamName        string   `json:"team_name"`
+	FallbackTeams   []string `json:"fallback_teams"`
+	SharedReviewers []string `json:"shared_reviewers"`
+}
+
+type SetTeamPolicyRequest struct {
+	TeamName               string            `json:"team_name"`
+	RequiredReviewers      *int              `json:"required_reviewers,omitempty"`
+	SelectionStrategy      SelectionStrategy `json:"selection_strategy,omitempty"`
+	AllowSelfMerge         *bool             `json:"allow_self_merge,omitempty"`
+	RequiredApprovals      int               `json:"required_approvals"`
+	ReassignOnDeactivation bool              `json:"reassign_on_deactivation"`
+	CompositionRules       []CompositionRule `json:"composition_rules,omitempty"`
+	SecurityTeam           string            `json:"security_team,omitempty"`
+	MaxOpenReviews         int               `json:"max_open_reviews"`
+	HierarchyFallback      bool              `json:"hierarchy_fallback"`
+}
+
+type teamResponse struct {
+	Team *Team `json:"team"`
+}
+
+type teamPolicyResponse struct {
+	Policy *TeamPolicy `json:"policy"`
+}
+
+func (c *Client) AddTeam(ctx context.Context, team *Team) (*Team, error) {
+	var resp teamResponse
+	err := c.do(team, &resp, func(body io.Reader) (*http.Response, error) {
+		return c.api.AddTeamWithBody(ctx, jsonContentType, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Team, nil
+}
+
+func (c *Client) GetTeam(ctx context.Context, teamName string) (*Team, error) {
+	var team Team
+	err := c.do(nil, &team, func(io.Reader) (*http.Response, error) {
+		return c.api.GetTeam(ctx, &api.GetTeamParams{TeamName: teamName})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+func (c *Client) SetReviewerPools(ctx context.Context, req *SetReviewerPoolsRequest) (*Team, error) {
+	var resp teamResponse
+	err := c.do(req, &resp, func(body io.Reader) (*http.Response, error) {
+		return c.api.SetReviewerPoolsWithBody(ctx, jsonContentType, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Team, nil
+}
+
+func (c *Client) GetTeamPolicy(ctx context.Context, teamName string) (*TeamPolicy, error) {
+	var resp teamPolicyResponse
+	err := c.do(nil, &resp, func(io.Reader) (*http.Response, error) {
+		return c.api.GetTeamPolicy(ctx, &api.GetTeamPolicyParams{TeamName: teamName})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Policy, nil
+}
+
+func (c *Client) SetTeamPolicy(ctx context.Context, req *SetTeamPolicyRequest) (*TeamPolicy, error) {
+	var resp teamPolicyResponse
+	err := c.do(req, &resp, func(body io.Reader) (*http.Response, error) {
+		return c.api.SetTeamPolicyWithBody(ctx, jsonContentType, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Policy, nil
+}
+
+func (c *Client) SetParentTeam(ctx context.Context, teamName, parentTeam string) (*Team, error) {
+	req := map[string]string{"team_name": teamName, "parent_team": parentTeam}
+	var resp teamResponse
+	err := c.do(req, &resp, func(body io.Reader) (*http.Response, error) {
+		return c.api.SetParentTeamWithBody(ctx, jsonContentType, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Team, nil
+}
+
+func (c *Client) GetTeamHierarchy(ctx context.Context, teamName string) (*TeamHierarchy, error) {
+	var resp struct {
+		Hierarchy *TeamHierarchy `json:"hierarchy"`
+	}
+	err := c.do(nil, &resp, func(io.Reader) (*http.Response, error) {
+		return c.api.GetTeamHierarchy(ctx, &api.GetTeamHierarchyParams{TeamName: teamName})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Hierarchy, nil
+}
+
+func (c *Client) GetTeamStats(ctx context.Context, teamName string, includeSubTeams bool) (*TeamStats, error) {
+	var resp struct {
+		Stats *TeamStats `json:"stats"`
+	}
+	params := &api.GetTeamStatsParams{TeamName: teamName}
+	if includeSubTeams {
+		params.IncludeSubTeams = &includeSubTeams
+	}
+	err := c.do(nil, &resp, func(io.Reader) (*http.Response, error) {
+		return c.api.GetTeamStats(ctx, params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Stats, nil
+}
@@ -0,0 +1,266 @@
+package client
+
+import (
+	"time"
+
+	"pr-review/pkg/client/api"
+)
+
+type Grade = api.Grade
+
+const (
+	GradeJunior = api.Junior
+	GradeMiddle = api.Middle
+	GradeSenior = api.Senior
+	GradeLead   = api.Lead
+)
+
+type SelectionStrategy string
+
+const (
+	SelectionStrategyRandom       SelectionStrategy = "random"
+	SelectionStrategyWorkingHours SelectionStrategy = "working_hours"
+	SelectionStrategyExpertise    SelectionStrategy = "expertise"
+)
+
+type Status = api.PullRequestStatus
+
+const (
+	StatusOpen   = api.PullRequestStatusOPEN
+	StatusMerged = api.PullRequestStatusMERGED
+)
+
+type Priority = api.PullRequestPriority
+
+const (
+	PriorityLow    = api.Low
+	PriorityNormal = api.Normal
+	PriorityHigh   = api.High
+)
+
+type TeamMember struct {
+	UserID   string `json:"user_id" yaml:"user_id"`
+	Username string `json:"username" yaml:"username"`
+	IsActive bool   `json:"is_active" yaml:"is_active"`
+	Grade    Grade  `json:"grade,omitempty" yaml:"grade,omitempty"`
+	TeamName string `json:"team_name,omitempty" yaml:"-"`
+}
+
+type Team struct {
+	TeamName               string            `json:"team_name" yaml:"team_name"`
+	Members                []TeamMember      `json:"members" yaml:"members"`
+	ReassignOnDeactivation bool              `json:"reassign_on_deactivation" yaml:"reassign_on_deactivation"`
+	SelectionStrategy      SelectionStrategy `json:"selection_strategy,omitempty" yaml:"selection_strategy,omitempty"`
+	FallbackTeams          []string          `json:"fallback_teams,omitempty" yaml:"fallback_teams,omitempty"`
+	SharedReviewers        []string          `json:"shared_reviewers,omitempty" yaml:"shared_reviewers,omitempty"`
+	ParentTeam             string            `json:"parent_team,omitempty" yaml:"parent_team,omitempty"`
+	SubTeams               []*Team           `json:"sub_teams,omitempty" yaml:"sub_teams,omitempty"`
+}
+
+type TeamNode struct {
+	TeamName string      `json:"team_name"`
+	SubTeams []*TeamNode `json:"sub_teams"`
+}
+
+type TeamHierarchy struct {
+	TeamName  string      `json:"team_name"`
+	Ancestors []string    `json:"ancestors"`
+	SubTeams  []*TeamNode `json:"sub_teams"`
+}
+
+type TeamStats struct {
+	TeamName              string   `json:"team_name"`
+	Teams                 []string `json:"teams"`
+	Members               int      `json:"members"`
+	ActiveMembers         int      `json:"active_members"`
+	OpenPullRequests      int      `json:"open_pull_requests"`
+	MergedPullRequests    int      `json:"merged_pull_requests"`
+	OpenReviewAssignments int      `json:"open_review_assignments"`
+}
+
+type CompositionRule struct {
+	AuthorGrade Grade `json:"author_grade,omitempty"`
+	MinGrade    Grade `json:"min_grade,omitempty"`
+	MaxGrade    Grade `json:"max_grade,omitempty"`
+	AtLeast     int   `json:"at_least"`
+	AtMost      *int  `json:"at_most,omitempty"`
+}
+
+type TeamPolicy struct {
+	TeamName               string            `json:"team_name"`
+	RequiredReviewers      int               `json:"required_reviewers"`
+	SelectionStrategy      SelectionStrategy `json:"selection_strategy"`
+	AllowSelfMerge         bool              `json:"allow_self_merge"`
+	RequiredApprovals      int               `json:"required_approvals"`
+	ReassignOnDeactivation bool              `json:"reassign_on_deactivation"`
+	CompositionRules       []CompositionRule `json:"composition_rules"`
+	SecurityTeam           string            `json:"security_team,omitempty"`
+	MaxOpenReviews         int               `json:"max_open_reviews"`
+	HierarchyFallback      bool              `json:"hierarchy_fallback"`
+}
+
+type User struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	TeamName string `json:"team_name"`
+	IsActive bool   `json:"is_active"`
+	Grade    Grade  `json:"grade,omitempty"`
+}
+
+type UserPage struct {
+	Users      []*User `json:"users"`
+	Limit      int     `json:"limit"`
+	Offset     int     `json:"offset"`
+	NextOffset *int    `json:"next_offset,omitempty"`
+}
+
+type PullRequest struct {
+	PullRequestID     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+	AuthorID          string     `json:"author_id"`
+	Status            Status     `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	Repository        string     `json:"repository,omitempty"`
+	ChangedFiles      []string   `json:"changed_files,omitempty"`
+	Labels            []string   `json:"labels,omitempty"`
+	Priority          Priority   `json:"priority,omitempty"`
+	Description       string     `json:"description,omitempty"`
+	Version           int        `json:"version"`
+	CreatedAt         *time.Time `json:"createdAt,omitempty"`
+	MergedAt          *time.Time `json:"mergedAt,omitempty"`
+}
+
+type PullRequestShort struct {
+	PullRequestID   string   `json:"pull_request_id"`
+	PullRequestName string   `json:"pull_request_name"`
+	AuthorID        string   `json:"author_id"`
+	Status          Status   `json:"status"`
+	Priority        Priority `json:"priority,omitempty"`
+	Labels          []string `json:"labels,omitempty"`
+}
+
+type ReviewerReassignment struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id"`
+}
+
+type ReassignmentReport struct {
+	Reassignments []ReviewerReassignment `json:"reassignments"`
+	UnreplacedPRs []string               `json:"unreplaced_pull_requests"`
+}
+
+type WorkSchedule struct {
+	TimeZone  string `json:"time_zone"`
+	WorkStart string `json:"work_start"`
+	WorkEnd   string `json:"work_end"`
+	WorkDays  []int  `json:"work_days"`
+}
+
+type DigestPreferences struct {
+	UserID     string     `json:"user_id"`
+	Enabled    bool       `json:"enabled"`
+	Frequency  string     `json:"frequency"`
+	Email      string     `json:"email,omitempty"`
+	LastSentAt *time.Time `json:"last_sent_at,omitempty"`
+}
+
+type AwayPeriod struct {
+	ID           int64      `json:"id"`
+	UserID       string     `json:"user_id"`
+	From         time.Time  `json:"from"`
+	Until        time.Time  `json:"until"`
+	Reason       string     `json:"reason,omitempty"`
+	Handover     bool       `json:"handover"`
+	HandedOverAt *time.Time `json:"handed_over_at,omitempty"`
+	ReturnedAt   *time.Time `json:"returned_at,omitempty"`
+}
+
+type ExpertiseScore struct {
+	UserID    string    `json:"user_id"`
+	Area      string    `json:"area"`
+	Score     float64   `json:"score"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type SLASettings struct {
+	TeamName           string `json:"team_name"`
+	FirstReviewMinutes int    `json:"time_to_first_review_minutes"`
+	MergeMinutes       int    `json:"time_to_merge_minutes"`
+	AutoReassign       bool   `json:"auto_reassign"`
+}
+
+type SLABreach struct {
+	ID            int64      `json:"id"`
+	Kind          string     `json:"kind"`
+	PullRequestID string     `json:"pull_request_id"`
+	ReviewerID    string     `json:"reviewer_id,omitempty"`
+	TeamName      string     `json:"team_name"`
+	StartedAt     time.Time  `json:"started_at"`
+	Deadline      time.Time  `json:"deadline"`
+	DetectedAt    time.Time  `json:"detected_at"`
+	ReassignedTo  string     `json:"reassigned_to,omitempty"`
+	ResolvedAt    *time.Time `json:"resolved_at,omitempty"`
+}
+
+type ReviewerAffinity struct {
+	AuthorID   string `json:"author_id"`
+	ReviewerID string `json:"reviewer_id"`
+	Weight     int    `json:"weight"`
+	Blocked    bool   `json:"blocked"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+type Repository struct {
+	RepositoryName string     `json:"repository_name"`
+	TeamName       string     `json:"team_name,omitempty"`
+	CodeOwners     string     `json:"codeowners"`
+	UpdatedAt      *time.Time `json:"updatedAt,omitempty"`
+}
+
+type BulkTeam struct {
+	TeamName               string            `json:"team_name"`
+	RequiredReviewers      *int              `json:"required_reviewers,omitempty"`
+	SelectionStrategy      SelectionStrategy `json:"selection_strategy,omitempty"`
+	AllowSelfMerge         *bool             `json:"allow_self_merge,omitempty"`
+	RequiredApprovals      int               `json:"required_approvals"`
+	ReassignOnDeactivation bool              `json:"reassign_on_deactivation"`
+	CompositionRules       []CompositionRule `json:"composition_rules,omitempty"`
+	SecurityTeam           string            `json:"security_team,omitempty"`
+	MaxOpenReviews         int               `json:"max_open_reviews"`
+	HierarchyFallback      bool              `json:"hierarchy_fallback"`
+	FallbackTeams          []string          `json:"fallback_teams,omitempty"`
+	SharedReviewers        []string          `json:"shared_reviewers,omitempty"`
+}
+
+type BulkPullRequest struct {
+	PullRequestID     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+	AuthorID          string     `json:"author_id"`
+	Status            Status     `json:"status,omitempty"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	CreatedAt         *time.Time `json:"createdAt,omitempty"`
+	MergedAt          *time.Time `json:"mergedAt,omitempty"`
+}
+
+type BulkData struct {
+	Teams        []BulkTeam        `json:"teams"`
+	Users        []User            `json:"users"`
+	PullRequests []BulkPullRequest `json:"pull_requests"`
+}
+
+type ImportError struct {
+	Resource string `json:"resource"`
+	Row      int    `json:"row"`
+	ID       string `json:"id,omitempty"`
+	Message  string `json:"message"`
+}
+
+type ImportReport struct {
+	DryRun       bool          `json:"dry_run"`
+	Applied      bool          `json:"applied"`
+	Teams        int           `json:"teams"`
+	Users        int           `json:"users"`
+	PullRequests int           `json:"pull_requests"`
+	Errors       []ImportError `json:"errors"`
+}
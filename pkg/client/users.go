@@ -0,0 +1,219 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"pr-review/pkg/client/api"
+)
+
+type ListUsersOptions struct {
+	TeamName       string
+	IsActive       *bool
+	UsernamePrefix string
+	Limit          int
+	Offset         int
+}
+
+type SetIsActiveRequest struct {
+	UserID          string `json:"user_id"`
+	IsActive        bool   `json:"is_active"`
+	ReassignReviews *bool  `json:"reassign_reviews,omitempty"`
+}
+
+type SetIsActiveResult struct {
+	User     *User               `json:"user"`
+	Handover *ReassignmentReport `json:"handover,omitempty"`
+}
+
+type SetAwayRequest struct {
+	UserID   string    `json:"user_id"`
+	From     time.Time `json:"from"`
+	Until    time.Time `json:"until"`
+	Reason   string    `json:"reason,omitempty"`
+	Handover bool      `json:"handover"`
+}
+
+type SetAwayResult struct {
+	Away     *AwayPeriod         `json:"away"`
+	Handover *ReassignmentReport `json:"handover,omitempty"`
+}
+
+type SetDigestPreferencesRequest struct {
+	UserID    string `json:"user_id"`
+	Enabled   *bool  `json:"enabled,omitempty"`
+	Frequency string `json:"frequency,omitempty"`
+	Email     string `json:"email,omitempty"`
+}
+
+type ReviewQueueOptions struct {
+	Labels   []string
+	Priority Priority
+}
+
+type userResponse struct {
+	User *User `json:"user"`
+}
+
+type digestPreferencesResponse struct {
+	Preferences *DigestPreferences `json:"preferences"`
+}
+
+func (c *Client) GetUser(ctx context.Context, userID string) (*User, error) {
+	var resp userResponse
+	err := c.do(nil, &resp, func(io.Reader) (*http.Response, error) {
+		return c.api.GetUser(ctx, &api.GetUserParams{UserId: userID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.User, nil
+}
+
+func (c *Client) ListUsers(ctx context.Context, opts ListUsersOptions) (*UserPage, error) {
+	params := &api.ListUsersParams{IsActive: opts.IsActive}
+	if opts.TeamName != "" {
+		params.TeamName = &opts.TeamName
+	}
+	if opts.UsernamePrefix != "" {
+		params.UsernamePrefix = &opts.UsernamePrefix
+	}
+	if opts.Limit > 0 {
+		params.Limit = &opts.Limit
+	}
+	if opts.Offset > 0 {
+		params.Offset = &opts.Offset
+	}
+
+	var page UserPage
+	err := c.do(nil, &page, func(io.Reader) (*http.Response, error) {
+		return c.api.ListUsers(ctx, params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+func (c *Client) UpdateUsername(ctx context.Context, userID, username string) (*User, error) {
+	req := map[string]string{"user_id": userID, "username": username}
+	var resp userResponse
+	err := c.do(req, &resp, func(body io.Reader) (*http.Response, error) {
+		return c.api.UpdateUsernameWithBody(ctx, jsonContentType, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.User, nil
+}
+
+func (c *Client) DeleteUser(ctx context.Context, userID string) (*ReassignmentReport, error) {
+	var resp struct {
+		UserID   string              `json:"user_id"`
+		Handover *ReassignmentReport `json:"handover"`
+	}
+	err := c.do(nil, &resp, func(io.Reader) (*http.Response, error) {
+		return c.api.DeleteUser(ctx, &api.DeleteUserParams{UserId: userID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Handover, nil
+}
+
+func (c *Client) SetIsActive(ctx context.Context, req *SetIsActiveRequest) (*SetIsActiveResult, error) {
+	var result SetIsActiveResult
+	err := c.do(req, &result, func(body io.Reader) (*http.Response, error) {
+		return c.api.SetUserIsActiveWithBody(ctx, jsonContentType, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) SetAway(ctx context.Context, req *SetAwayRequest) (*SetAwayResult, error) {
+	var result SetAwayResult
+	err := c.do(req, &result, func(body io.Reader) (*http.Response, error) {
+		return c.api.SetUserAwayWithBody(ctx, jsonContentType, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) SetSchedule(ctx context.Context, userID string, schedule *WorkSchedule) (*WorkSchedule, error) {
+	req := struct {
+		UserID string `json:"user_id"`
+		*WorkSchedule
+	}{UserID: userID, WorkSchedule: schedule}
+	var resp struct {
+		Schedule *WorkSchedule `json:"schedule"`
+	}
+	err := c.do(req, &resp, func(body io.Reader) (*http.Response, error) {
+		return c.api.SetUserScheduleWithBody(ctx, jsonContentType, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Schedule, nil
+}
+
+func (c *Client) GetReview(ctx context.Context, userID string, opts ReviewQueueOptions) ([]*PullRequestShort, error) {
+	params := &api.GetUserReviewParams{UserId: userID}
+	if len(opts.Labels) > 0 {
+		params.Label = &opts.Labels
+	}
+	if opts.Priority != "" {
+		params.Priority = &opts.Priority
+	}
+
+	var resp struct {
+		PullRequests []*PullRequestShort `json:"pull_requests"`
+	}
+	err := c.do(nil, &resp, func(io.Reader) (*http.Response, error) {
+		return c.api.GetUserReview(ctx, params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.PullRequests, nil
+}
+
+func (c *Client) GetDigestPreferences(ctx context.Context, userID string) (*DigestPreferences, error) {
+	var resp digestPreferencesResponse
+	err := c.do(nil, &resp, func(io.Reader) (*http.Response, error) {
+		return c.api.GetDigestPreferences(ctx, &api.GetDigestPreferencesParams{UserId: userID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Preferences, nil
+}
+
+func (c *Client) SetDigestPreferences(ctx context.Context, req *SetDigestPreferencesRequest) (*DigestPreferences, error) {
+	var resp digestPreferencesResponse
+	err := c.do(req, &resp, func(body io.Reader) (*http.Response, error) {
+		return c.api.SetDigestPreferencesWithBody(ctx, jsonContentType, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Preferences, nil
+}
+
+func (c *Client) GetExpertise(ctx context.Context, userID string) ([]*ExpertiseScore, error) {
+	var resp struct {
+		Scores []*ExpertiseScore `json:"scores"`
+	}
+	err := c.do(nil, &resp, func(io.Reader) (*http.Response, error) {
+		return c.api.GetUserExpertise(ctx, &api.GetUserExpertiseParams{UserId: userID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Scores, nil
+}
@@ -0,0 +1,85 @@
+package api_test
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"pr-review/pkg/client"
+	clientapi "pr-review/pkg/client/api"
+)
+
+func TestClientTypes_MatchSpec(t *testing.T) {
+	spec, _ := loadSpec(t, specPath)
+
+	types := map[string]any{
+		"AwayPeriod":           client.AwayPeriod{},
+		"BulkData":             client.BulkData{},
+		"BulkPullRequest":      client.BulkPullRequest{},
+		"BulkTeam":             client.BulkTeam{},
+		"CompositionRule":      client.CompositionRule{},
+		"DigestPreferences":    client.DigestPreferences{},
+		"ExpertiseScore":       client.ExpertiseScore{},
+		"ImportReport":         client.ImportReport{},
+		"PullRequest":          client.PullRequest{},
+		"PullRequestShort":     client.PullRequestShort{},
+		"ReassignmentReport":   client.ReassignmentReport{},
+		"Repository":           client.Repository{},
+		"ReviewerAffinity":     client.ReviewerAffinity{},
+		"ReviewerReassignment": client.ReviewerReassignment{},
+		"SLABreach":            client.SLABreach{},
+		"SLASettings":          client.SLASettings{},
+		"Team":                 client.Team{},
+		"TeamHierarchy":        client.TeamHierarchy{},
+		"TeamMember":           client.TeamMember{},
+		"TeamNode":             client.TeamNode{},
+		"TeamPolicy":           client.TeamPolicy{},
+		"TeamStats":            client.TeamStats{},
+		"User":                 client.User{},
+		"UserPage":             client.UserPage{},
+		"WorkSchedule":         client.WorkSchedule{},
+	}
+
+	for name, value := range types {
+		t.Run(name, func(t *testing.T) {
+			schema, ok := spec.Components.Schemas[name]
+			if !ok {
+				t.Fatalf("schema %s not found in spec", name)
+			}
+
+			fields := make([]string, 0)
+			typ := reflect.TypeOf(value)
+			for i := 0; i < typ.NumField(); i++ {
+				tag, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+				if tag != "" && tag != "-" {
+					fields = append(fields, tag)
+				}
+			}
+			properties := make([]string, 0, len(schema.Value.Properties))
+			for property := range schema.Value.Properties {
+				properties = append(properties, property)
+			}
+			sort.Strings(fields)
+			sort.Strings(properties)
+
+			if strings.Join(fields, ",") != strings.Join(properties, ",") {
+				t.Fatalf("client fields differ from spec\ngot:  %v\nwant: %v", fields, properties)
+			}
+		})
+	}
+}
+
+func TestGeneratedClient_CoversEveryOperation(t *testing.T) {
+	spec, _ := loadSpec(t, specPath)
+
+	generated := reflect.TypeOf(&clientapi.Client{})
+	for _, item := range spec.Paths.Map() {
+		for _, operation := range item.Operations() {
+			method := strings.ToUpper(operation.OperationID[:1]) + operation.OperationID[1:]
+			if _, ok := generated.MethodByName(method); !ok {
+				t.Errorf("generated client has no %s method, run go generate ./...", method)
+			}
+		}
+	}
+}
@@ -0,0 +1,438 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"pr-review/internal/http/api"
+	"pr-review/internal/http/apiv2"
+	"pr-review/internal/http/handlers"
+	"pr-review/internal/http/middleware"
+	"pr-review/internal/notify"
+	"pr-review/internal/service"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gin-gonic/gin"
+)
+
+type apiCase struct {
+	name        string
+	operation   string
+	method      string
+	target      string
+	contentType string
+	header      map[string]string
+	body        string
+	status      int
+}
+
+var cases = []apiCase{
+	{name: "add_team", operation: "addTeam", method: http.MethodPost, target: "/team/add", body: `{"team_name":"payments","members":[{"user_id":"u10","username":"Zed","is_active":true}]}`, status: http.StatusCreated},
+	{name: "add_existing_team", operation: "addTeam", method: http.MethodPost, target: "/team/add", body: `{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true}]}`, status: http.StatusBadRequest},
+	{name: "get_team", operation: "getTeam", method: http.MethodGet, target: "/team/get?team_name=backend", status: http.StatusOK},
+	{name: "get_team_with_sub_teams", operation: "getTeam", method: http.MethodGet, target: "/team/get?team_name=backend&include_sub_teams=true", status: http.StatusOK},
+	{name: "get_unknown_team", operation: "getTeam", method: http.MethodGet, target: "/team/get?team_name=unknown", status: http.StatusNotFound},
+	{name: "set_reviewer_pools", operation: "setReviewerPools", method: http.MethodPost, target: "/team/setReviewerPools", body: `{"team_name":"backend","fallback_teams":["platform"],"shared_reviewers":["u5"]}`, status: http.StatusOK},
+	{name: "get_team_policy", operation: "getTeamPolicy", method: http.MethodGet, target: "/team/policy?team_name=backend", status: http.StatusOK},
+	{name: "set_team_policy", operation: "setTeamPolicy", method: http.MethodPut, target: "/team/policy", body: `{"team_name":"backend","required_reviewers":2,"selection_strategy":"random","required_approvals":1,"composition_rules":[{"min_grade":"senior","at_least":1}]}`, status: http.StatusOK},
+	{name: "set_parent_team", operation: "setParentTeam", method: http.MethodPost, target: "/team/setParent", body: `{"team_name":"platform","parent_team":"backend"}`, status: http.StatusOK},
+	{name: "get_team_hierarchy", operation: "getTeamHierarchy", method: http.MethodGet, target: "/team/hierarchy?team_name=backend", status: http.StatusOK},
+	{name: "get_team_stats", operation: "getTeamStats", method: http.MethodGet, target: "/team/stats?team_name=backend&include_sub_teams=true", status: http.StatusOK},
+	{name: "get_user", operation: "getUser", method: http.MethodGet, target: "/users/get?user_id=u1", status: http.StatusOK},
+	{name: "get_unknown_user", operation: "getUser", method: http.MethodGet, target: "/users/get?user_id=unknown", status: http.StatusNotFound},
+	{name: "list_users", operation: "listUsers", method: http.MethodGet, target: "/users/list?team_name=backend&is_active=true&limit=2", status: http.StatusOK},
+	{name: "update_username", operation: "updateUsername", method: http.MethodPatch, target: "/users/update", body: `{"user_id":"u2","username":"Robert"}`, status: http.StatusOK},
+	{name: "delete_user", operation: "deleteUser", method: http.MethodDelete, target: "/users/delete?user_id=u4", status: http.StatusOK},
+	{name: "set_is_active", operation: "setUserIsActive", method: http.MethodPost, target: "/users/setIsActive", body: `{"user_id":"u2","is_active":false,"reassign_reviews":true}`, status: http.StatusOK},
+	{name: "set_away", operation: "setUserAway", method: http.MethodPost, target: "/users/setAway", body: `{"user_id":"u2","from":"2099-11-03T00:00:00Z","until":"2099-11-17T00:00:00Z","reason":"vacation","handover":true}`, status: http.StatusCreated},
+	{name: "set_schedule", operation: "setUserSchedule", method: http.MethodPost, target: "/users/setSchedule", body: `{"user_id":"u2","time_zone":"Asia/Yerevan","work_start":"10:00","work_end":"19:00","work_days":[1,2,3,4,5]}`, status: http.StatusOK},
+	{name: "set_overnight_schedule", operation: "setUserSchedule", method: http.MethodPost, target: "/users/setSchedule", body: `{"user_id":"u2","time_zone":"Asia/Yerevan","work_start":"22:00","work_end":"06:00","work_days":[1,2,3,4,5]}`, status: http.StatusOK},
+	{name: "set_schedule_empty_shift", operation: "setUserSchedule", method: http.MethodPost, target: "/users/setSchedule", body: `{"user_id":"u2","time_zone":"Asia/Yerevan","work_start":"19:00","work_end":"19:00","work_days":[1]}`, status: http.StatusUnprocessableEntity},
+	{name: "get_review", operation: "getUserReview", method: http.MethodGet, target: "/users/getReview?user_id=u2", status: http.StatusOK},
+	{name: "get_digest_preferences", operation: "getDigestPreferences", method: http.MethodGet, target: "/users/digestPreferences?user_id=u2", status: http.StatusOK},
+	{name: "set_digest_preferences", operation: "setDigestPreferences", method: http.MethodPost, target: "/users/digestPreferences", body: `{"user_id":"u2","enabled":true,"frequency":"weekly","email":"u2@example.com"}`, status: http.StatusOK},
+	{name: "get_expertise", operation: "getUserExpertise", method: http.MethodGet, target: "/users/expertise?user_id=u2", status: http.StatusOK},
+	{name: "create_pull_request", operation: "createPullRequest", method: http.MethodPost, target: "/pullRequest/create", body: `{"pull_request_id":"pr-2","pull_request_name":"Add cache","author_id":"u1","labels":["cache"],"priority":"low"}`, status: http.StatusCreated},
+	{name: "create_existing_pull_request", operation: "createPullRequest", method: http.MethodPost, target: "/pullRequest/create", body: `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1"}`, status: http.StatusConflict},
+	{name: "update_pull_request", operation: "updatePullRequest", method: http.MethodPatch, target: "/pullRequest/update", body: `{"pull_request_id":"pr-1","pull_request_name":"Add full-text search","version":1}`, status: http.StatusOK},
+	{name: "update_stale_pull_request", operation: "updatePullRequest", method: http.MethodPatch, target: "/pullRequest/update", header: map[string]string{"If-Match": `"7"`}, body: `{"pull_request_id":"pr-1","pull_request_name":"Add full-text search"}`, status: http.StatusPreconditionFailed},
+	{name: "approve_pull_request", operation: "approvePullRequest", method: http.MethodPost, target: "/pullRequest/approve", body: `{"pull_request_id":"pr-1","user_id":"u2"}`, status: http.StatusOK},
+	{name: "merge_pull_request", operation: "mergePullRequest", method: http.MethodPost, target: "/pullRequest/merge", body: `{"pull_request_id":"pr-1"}`, status: http.StatusOK},
+	{name: "reassign_reviewer", operation: "reassignReviewer", method: http.MethodPost, target: "/pullRequest/reassign", body: `{"pull_request_id":"pr-1","old_user_id":"u2"}`, status: http.StatusOK},
+	{name: "reassign_unassigned_reviewer", operation: "reassignReviewer", method: http.MethodPost, target: "/pullRequest/reassign", body: `{"pull_request_id":"pr-1","old_user_id":"u4"}`, status: http.StatusConflict},
+	{name: "get_sla_settings", operation: "getSLASettings", method: http.MethodGet, target: "/sla/settings?team_name=backend", status: http.StatusOK},
+	{name: "set_sla_settings", operation: "setSLASettings", method: http.MethodPost, target: "/sla/settings", body: `{"team_name":"backend","time_to_first_review_minutes":60,"time_to_merge_minutes":600,"auto_reassign":true}`, status: http.StatusOK},
+	{name: "list_sla_breaches", operation: "listSLABreaches", method: http.MethodGet, target: "/sla/breaches?team_name=backend&kind=FIRST_REVIEW&open_only=true", status: http.StatusOK},
+	{name: "list_affinities", operation: "listAffinities", method: http.MethodGet, target: "/affinity/list?author_id=u1", status: http.StatusOK},
+	{name: "set_affinity", operation: "setAffinity", method: http.MethodPost, target: "/affinity/set", body: `{"author_id":"u1","reviewer_id":"u3","weight":5}`, status: http.StatusOK},
+	{name: "set_affinity_same_user", operation: "setAffinity", method: http.MethodPost, target: "/affinity/set", body: `{"author_id":"u1","reviewer_id":"u1","blocked":true}`, status: http.StatusUnprocessableEntity},
+	{name: "delete_affinity", operation: "deleteAffinity", method: http.MethodPost, target: "/affinity/delete", body: `{"author_id":"u1","reviewer_id":"u5"}`, status: http.StatusOK},
+	{name: "add_repository", operation: "addRepository", method: http.MethodPost, target: "/repository/add", body: `{"repository_name":"search","team_name":"backend"}`, status: http.StatusCreated},
+	{name: "get_repository", operation: "getRepository", method: http.MethodGet, target: "/repository/get?repository_name=billing", status: http.StatusOK},
+	{name: "get_unknown_repository", operation: "getRepository", method: http.MethodGet, target: "/repository/get?repository_name=unknown", status: http.StatusNotFound},
+	{name: "upload_codeowners", operation: "uploadCodeOwners", method: http.MethodPost, target: "/repository/uploadCodeOwners", body: `{"repository_name":"billing","content":"* @u1\n*.sql @u2\n"}`, status: http.StatusOK},
+	{name: "import", operation: "importData", method: http.MethodPost, target: "/admin/import", body: `{"teams":[{"team_name":"payments"}],"users":[{"user_id":"u10","username":"Zed","team_name":"payments","is_active":true}],"pull_requests":[{"pull_request_id":"pr-3","pull_request_name":"Add ledger","author_id":"u10","assigned_reviewers":["u1"]}]}`, status: http.StatusOK},
+	{name: "import_dry_run", operation: "importData", method: http.MethodPost, target: "/admin/import?dry_run=true", body: `{"users":[{"user_id":"u10","username":"Zed","team_name":"unknown","is_active":true}]}`, status: http.StatusOK},
+	{name: "import_rejected", operation: "importData", method: http.MethodPost, target: "/admin/import", body: `{"users":[{"user_id":"u10","username":"Zed","team_name":"unknown","is_active":true}]}`, status: http.StatusUnprocessableEntity},
+	{name: "import_csv", operation: "importData", method: http.MethodPost, target: "/admin/import?resource=users", contentType: "text/csv", body: "user_id,username,team_name\nu10,Zed,backend\n", status: http.StatusOK},
+	{name: "export", operation: "exportData", method: http.MethodGet, target: "/admin/export", status: http.StatusOK},
+	{name: "export_csv", operation: "exportData", method: http.MethodGet, target: "/admin/export?format=csv&resource=users", status: http.StatusOK},
+}
+
+const (
+	specPath   = "../../api/openapi.yaml"
+	specPathV2 = "../../api/openapi-v2.yaml"
+)
+
+func loadSpec(t *testing.T, path string) (*openapi3.T, routers.Router) {
+	t.Helper()
+	spec, err := openapi3.NewLoader().LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+	if err := spec.Validate(context.Background()); err != nil {
+		t.Fatalf("invalid spec: %v", err)
+	}
+	router, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		t.Fatalf("failed to build spec router: %v", err)
+	}
+	return spec, router
+}
+
+func newEngine(t *testing.T) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	s := newStore()
+	prService := service.NewPullRequestService(s, s, s, s, s, s, s)
+	teamService := service.NewTeamService(s, s)
+	h := &handlers.Handlers{
+		Team:        handlers.NewTeamHandler(teamService),
+		User:        handlers.NewUserHandler(service.NewUserService(s, prService), service.NewAvailabilityService(s, s, prService), service.NewDigestService(s, s, s, notify.LogNotifier{}), service.NewExpertiseService(s, s)),
+		PullRequest: handlers.NewPullRequestHandler(prService),
+		SLA:         handlers.NewSLAHandler(service.NewSLAService(s, s, prService, service.LogEscalationPublisher{})),
+		Affinity:    handlers.NewAffinityHandler(service.NewAffinityService(s, s)),
+		Repository:  handlers.NewRepositoryHandler(service.NewRepositoryService(s, s)),
+		Admin:       handlers.NewAdminHandler(service.NewBulkService(s, s, s, s, teamService)),
+	}
+
+	resolve := func(*gin.Context) *handlers.Handlers { return h }
+	engine := gin.New()
+	handlers.Register(engine.Group("/", validation(t, api.GetSwagger)), handlers.NewServer(resolve))
+	handlers.RegisterV2(engine.Group("/", validation(t, apiv2.GetSwagger)), handlers.NewServerV2(resolve))
+	return engine
+}
+
+func validation(t *testing.T, embedded func() (*openapi3.T, error)) gin.HandlerFunc {
+	t.Helper()
+	spec, err := embedded()
+	if err != nil {
+		t.Fatalf("failed to load embedded spec: %v", err)
+	}
+	handler, err := middleware.Validation(spec, true)
+	if err != nil {
+		t.Fatalf("failed to build validation middleware: %v", err)
+	}
+	return handler
+}
+
+func TestGeneratedSpec_UpToDate(t *testing.T) {
+	tests := []struct {
+		path     string
+		embedded func() (*openapi3.T, error)
+	}{
+		{specPath, api.GetSwagger},
+		{specPathV2, apiv2.GetSwagger},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			spec, _ := loadSpec(t, tt.path)
+			embedded, err := tt.embedded()
+			if err != nil {
+				t.Fatalf("failed to load embedded spec: %v", err)
+			}
+
+			for _, item := range spec.Paths.Map() {
+				for _, operation := range item.Operations() {
+					operation.OperationID = strings.ToUpper(operation.OperationID[:1]) + operation.OperationID[1:]
+				}
+			}
+			want, _ := json.Marshal(spec)
+			got, _ := json.Marshal(embedded)
+			if !bytes.Equal(got, want) {
+				t.Fatal("generated code is out of date with " + tt.path + ", run go generate ./...")
+			}
+		})
+	}
+}
+
+func TestRoutes_MatchSpec(t *testing.T) {
+	want := make([]string, 0)
+	for _, path := range []string{specPath, specPathV2} {
+		spec, _ := loadSpec(t, path)
+		for path, item := range spec.Paths.Map() {
+			for method := range item.Operations() {
+				want = append(want, method+" "+specRoute(path))
+			}
+		}
+	}
+	got := make([]string, 0)
+	for _, route := range newEngine(t).Routes() {
+		got = append(got, route.Method+" "+route.Path)
+	}
+	sort.Strings(want)
+	sort.Strings(got)
+
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("registered routes differ from spec\ngot:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func specRoute(path string) string {
+	return strings.NewReplacer("{", ":", "}", "").Replace(path)
+}
+
+func TestHandlers_ConformToSpec(t *testing.T) {
+	_, router := loadSpec(t, specPath)
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			checkCase(t, router, tt)
+		})
+	}
+}
+
+func checkCase(t *testing.T, router routers.Router, tt apiCase) *httptest.ResponseRecorder {
+	t.Helper()
+	req := newRequest(tt)
+	route, params, err := router.FindRoute(req)
+	if err != nil {
+		t.Fatalf("route not found in spec: %v", err)
+	}
+	if route.Operation.OperationID != tt.operation {
+		t.Fatalf("expected operation %s, got %s", tt.operation, route.Operation.OperationID)
+	}
+
+	input := &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: params,
+		Route:      route,
+		Options:    &openapi3filter.Options{AuthenticationFunc: openapi3filter.NoopAuthenticationFunc},
+	}
+	if err := openapi3filter.ValidateRequest(context.Background(), input); err != nil {
+		t.Fatalf("test request does not match spec: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	newEngine(t).ServeHTTP(w, newRequest(tt))
+	if w.Code != tt.status {
+		t.Fatalf("expected status %d, got %d: %s", tt.status, w.Code, w.Body.String())
+	}
+	checkResponse(t, input, w)
+	return w
+}
+
+func TestHandlers_RejectMalformedRequests(t *testing.T) {
+	spec, router := loadSpec(t, specPath)
+
+	for path, item := range spec.Paths.Map() {
+		for method, operation := range item.Operations() {
+			if operation.RequestBody != nil && operation.RequestBody.Value.Content.Get("application/json") != nil {
+				t.Run(operation.OperationID+"_malformed_body", func(t *testing.T) {
+					checkBadRequest(t, router, apiCase{method: method, target: path, body: `{`})
+				})
+			}
+
+			query := make([]string, 0)
+			for _, param := range operation.Parameters {
+				if param.Value.In == openapi3.ParameterInQuery && param.Value.Required {
+					query = append(query, param.Value.Name)
+				}
+			}
+			for _, missing := range query {
+				target := path + "?"
+				for _, name := range query {
+					if name != missing {
+						target += name + "=x&"
+					}
+				}
+				t.Run(operation.OperationID+"_missing_"+missing, func(t *testing.T) {
+					checkBadRequest(t, router, apiCase{method: method, target: target})
+				})
+			}
+		}
+	}
+}
+
+func TestHandlers_LocalizeErrors(t *testing.T) {
+	tests := []struct {
+		name            string
+		acceptLanguage  string
+		target          string
+		body            string
+		expectedLang    string
+		expectedMessage string
+		expectedDetail  string
+	}{
+		{"english_default", "", "/team/get?team_name=unknown", "", "en", "team not found", ""},
+		{"russian", "ru-RU,ru;q=0.9", "/team/get?team_name=unknown", "", "ru", "команда не найдена", ""},
+		{"russian_violation", "ru", "/users/setSchedule", `{"user_id":"u2","time_zone":"Asia/Yerevan","work_start":"19:00","work_end":"19:00","work_days":[1]}`, "ru", "work_end должен отличаться от work_start", "work_end должен отличаться от work_start"},
+		{"russian_schema", "ru", "/team/get", "", "ru", "запрос не соответствует схеме API", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := http.MethodGet
+			if tt.body != "" {
+				method = http.MethodPost
+			}
+			req := newRequest(apiCase{method: method, target: tt.target, body: tt.body})
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			w := httptest.NewRecorder()
+			newEngine(t).ServeHTTP(w, req)
+
+			if got := w.Header().Get("Content-Language"); got != tt.expectedLang {
+				t.Errorf("expected Content-Language %s, got %q", tt.expectedLang, got)
+			}
+			var response struct {
+				Error struct {
+					Message string `json:"message"`
+					Details []struct {
+						Message string `json:"message"`
+					} `json:"details"`
+				} `json:"error"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("invalid error response: %v", err)
+			}
+			if response.Error.Message != tt.expectedMessage {
+				t.Errorf("expected message %q, got %q", tt.expectedMessage, response.Error.Message)
+			}
+			if tt.expectedDetail != "" && (len(response.Error.Details) != 1 || response.Error.Details[0].Message != tt.expectedDetail) {
+				t.Errorf("expected detail %q, got %+v", tt.expectedDetail, response.Error.Details)
+			}
+		})
+	}
+}
+
+func TestCases_CoverEveryOperation(t *testing.T) {
+	spec, _ := loadSpec(t, specPath)
+
+	covered := make(map[string]bool)
+	for _, tt := range cases {
+		if tt.status < http.StatusBadRequest {
+			covered[tt.operation] = true
+		}
+	}
+	for _, item := range spec.Paths.Map() {
+		for _, operation := range item.Operations() {
+			if !covered[operation.OperationID] {
+				t.Errorf("operation %s has no successful conformance case", operation.OperationID)
+			}
+		}
+	}
+}
+
+func newRequest(tt apiCase) *http.Request {
+	var body io.Reader = http.NoBody
+	if tt.body != "" {
+		body = strings.NewReader(tt.body)
+	}
+	req := httptest.NewRequest(tt.method, tt.target, body)
+	if tt.body != "" {
+		contentType := tt.contentType
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		req.Header.Set("Content-Type", contentType)
+	}
+	for name, value := range tt.header {
+		req.Header.Set(name, value)
+	}
+	return req
+}
+
+func checkBadRequest(t *testing.T, router routers.Router, tt apiCase) {
+	t.Helper()
+	req := newRequest(tt)
+	route, params, err := router.FindRoute(req)
+	if err != nil {
+		t.Fatalf("route not found in spec: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	newEngine(t).ServeHTTP(w, newRequest(tt))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d: %s", w.Code, w.Body.String())
+	}
+	checkResponse(t, &openapi3filter.RequestValidationInput{Request: req, PathParams: params, Route: route}, w)
+}
+
+func checkResponse(t *testing.T, input *openapi3filter.RequestValidationInput, w *httptest.ResponseRecorder) {
+	t.Helper()
+	body := w.Body.Bytes()
+	err := openapi3filter.ValidateResponse(context.Background(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 w.Code,
+		Header:                 w.Header(),
+		Body:                   io.NopCloser(bytes.NewReader(body)),
+		Options:                &openapi3filter.Options{IncludeResponseStatus: true},
+	})
+	if err != nil {
+		t.Fatalf("response does not match spec: %v\n%s", err, body)
+	}
+
+	response := input.Route.Operation.Responses.Status(w.Code).Value
+	media := response.Content.Get("application/json")
+	if media == nil || !strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+		return
+	}
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if fields := undeclaredFields(media.Schema.Value, value, "$"); len(fields) > 0 {
+		t.Fatalf("response has fields missing from spec: %s\n%s", strings.Join(fields, ", "), body)
+	}
+}
+
+func undeclaredFields(schema *openapi3.Schema, value any, path string) []string {
+	fields := make([]string, 0)
+	switch value := value.(type) {
+	case map[string]any:
+		if len(schema.Properties) == 0 {
+			return fields
+		}
+		for key, item := range value {
+			property, ok := schema.Properties[key]
+			if !ok {
+				fields = append(fields, path+"."+key)
+				continue
+			}
+			fields = append(fields, undeclaredFields(property.Value, item, path+"."+key)...)
+		}
+	case []any:
+		if schema.Items == nil {
+			return fields
+		}
+		for _, item := range value {
+			fields = append(fields, undeclaredFields(schema.Items.Value, item, path+"[]")...)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
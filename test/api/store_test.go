@@ -0,0 +1,577 @@
+package api_test
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"pr-review/internal/entity"
+	"pr-review/internal/repo"
+)
+
+var (
+	_ repo.TeamRepository         = (*store)(nil)
+	_ repo.UserRepository         = (*store)(nil)
+	_ repo.PullRequestRepository  = (*store)(nil)
+	_ repo.AvailabilityRepository = (*store)(nil)
+	_ repo.SLARepository          = (*store)(nil)
+	_ repo.DigestRepository       = (*store)(nil)
+	_ repo.AffinityRepository     = (*store)(nil)
+	_ repo.RepositoryRepository   = (*store)(nil)
+	_ repo.ExpertiseRepository    = (*store)(nil)
+	_ repo.BulkRepository         = (*store)(nil)
+)
+
+var fixtureTime = time.Date(2025, time.November, 3, 10, 0, 0, 0, time.UTC)
+
+type store struct {
+	teams      map[string]*entity.Team
+	policies   map[string]*entity.TeamPolicy
+	users      map[string]*entity.User
+	deleted    map[string]bool
+	tombstones map[string]*entity.User
+	schedules  map[string]*entity.WorkSchedule
+	prs        map[string]*entity.PullRequest
+	approvals  map[string][]string
+	sla        map[string]*entity.SLASettings
+	breaches   []*entity.SLABreach
+	repos      map[string]*entity.Repository
+	affinities []*entity.ReviewerAffinity
+	digests    map[string]*entity.DigestPreferences
+	expertise  []*entity.ExpertiseScore
+	away       []*entity.AwayPeriod
+}
+
+func newStore() *store {
+	s := &store{
+		teams:      make(map[string]*entity.Team),
+		policies:   make(map[string]*entity.TeamPolicy),
+		users:      make(map[string]*entity.User),
+		deleted:    make(map[string]bool),
+		tombstones: make(map[string]*entity.User),
+		schedules:  make(map[string]*entity.WorkSchedule),
+		prs:        make(map[string]*entity.PullRequest),
+		approvals:  make(map[string][]string),
+		sla:        make(map[string]*entity.SLASettings),
+		repos:      make(map[string]*entity.Repository),
+		digests:    make(map[string]*entity.DigestPreferences),
+	}
+
+	s.teams["backend"] = &entity.Team{Name: "backend", SelectionStrategy: entity.SelectionStrategyRandom}
+	s.teams["platform"] = &entity.Team{Name: "platform", SelectionStrategy: entity.SelectionStrategyRandom, ParentTeam: "backend"}
+	for _, user := range []*entity.User{
+		{ID: "u1", Name: "Alice", Team: "backend", IsActive: true, Grade: entity.GradeSenior},
+		{ID: "u2", Name: "Bob", Team: "backend", IsActive: true},
+		{ID: "u3", Name: "Carol", Team: "backend", IsActive: true},
+		{ID: "u4", Name: "Dave", Team: "backend", IsActive: true},
+		{ID: "u5", Name: "Eve", Team: "platform", IsActive: true},
+	} {
+		s.users[user.ID] = user
+	}
+
+	createdAt := fixtureTime
+	s.prs["pr-1"] = &entity.PullRequest{
+		ID:                "pr-1",
+		Name:              "Add search",
+		AuthorID:          "u1",
+		Status:            entity.StatusOpen,
+		AssignedReviewers: []string{"u2", "u3"},
+		Labels:            []string{"search"},
+		Priority:          entity.PriorityHigh,
+		Version:           1,
+		CreatedAt:         &createdAt,
+	}
+	s.sla["backend"] = &entity.SLASettings{TeamName: "backend", FirstReviewMinutes: 240, MergeMinutes: 2880}
+	s.breaches = []*entity.SLABreach{{
+		ID:            1,
+		Kind:          entity.SLABreachFirstReview,
+		PullRequestID: "pr-1",
+		ReviewerID:    "u2",
+		TeamName:      "backend",
+		StartedAt:     fixtureTime,
+		Deadline:      fixtureTime.Add(4 * time.Hour),
+		DetectedAt:    fixtureTime.Add(5 * time.Hour),
+	}}
+	s.repos["billing"] = &entity.Repository{Name: "billing", TeamName: "backend", CodeOwners: "* @u1\n"}
+	s.affinities = []*entity.ReviewerAffinity{{AuthorID: "u1", ReviewerID: "u5", Weight: 0, Blocked: true, Reason: "pairing"}}
+	s.digests["u2"] = &entity.DigestPreferences{UserID: "u2", Enabled: true, Frequency: entity.DigestFrequencyDaily}
+	s.expertise = []*entity.ExpertiseScore{{UserID: "u2", Area: "internal/search", Score: 1.5, UpdatedAt: fixtureTime}}
+	return s
+}
+
+func (s *store) CreateTeam(team *entity.Team) error {
+	s.teams[team.Name] = &entity.Team{
+		Name:              team.Name,
+		SelectionStrategy: entity.SelectionStrategyRandom,
+		FallbackTeams:     team.FallbackTeams,
+		SharedReviewers:   team.SharedReviewers,
+	}
+	for i := range team.Members {
+		member := team.Members[i]
+		member.Team = team.Name
+		s.users[member.ID] = &member
+	}
+	return nil
+}
+
+func (s *store) GetTeam(teamName string) (*entity.Team, error) {
+	team, ok := s.teams[teamName]
+	if !ok {
+		return nil, nil
+	}
+	result := *team
+	result.Members = make([]entity.User, 0)
+	for _, user := range s.sortedUsers() {
+		if user.Team == teamName {
+			result.Members = append(result.Members, *user)
+		}
+	}
+	if result.FallbackTeams == nil {
+		result.FallbackTeams = make([]string, 0)
+	}
+	if result.SharedReviewers == nil {
+		result.SharedReviewers = make([]string, 0)
+	}
+	return &result, nil
+}
+
+func (s *store) TeamExists(teamName string) (bool, error) {
+	_, ok := s.teams[teamName]
+	return ok, nil
+}
+
+func (s *store) SetReviewerPools(teamName string, fallbackTeams, sharedReviewers []string) error {
+	s.teams[teamName].FallbackTeams = fallbackTeams
+	s.teams[teamName].SharedReviewers = sharedReviewers
+	return nil
+}
+
+func (s *store) GetPolicy(teamName string) (*entity.TeamPolicy, error) {
+	return s.policies[teamName], nil
+}
+
+func (s *store) SavePolicy(policy *entity.TeamPolicy) error {
+	s.policies[policy.TeamName] = policy
+	return nil
+}
+
+func (s *store) SetParentTeam(teamName, parentTeam string) error {
+	s.teams[teamName].ParentTeam = parentTeam
+	return nil
+}
+
+func (s *store) GetSubTeams(teamName string) ([]string, error) {
+	subTeams := make([]string, 0)
+	for name, team := range s.teams {
+		if team.ParentTeam == teamName {
+			subTeams = append(subTeams, name)
+		}
+	}
+	sort.Strings(subTeams)
+	return subTeams, nil
+}
+
+func (s *store) GetTeamAncestors(teamName string, maxDepth int) ([]string, error) {
+	ancestors := make([]string, 0)
+	seen := map[string]bool{teamName: true}
+	for team := s.teams[teamName]; team != nil && team.ParentTeam != "" && !seen[team.ParentTeam] && len(ancestors) < maxDepth; team = s.teams[team.ParentTeam] {
+		seen[team.ParentTeam] = true
+		ancestors = append(ancestors, team.ParentTeam)
+	}
+	return ancestors, nil
+}
+
+func (s *store) GetTeamStats(teamNames []string) (*entity.TeamStats, error) {
+	stats := &entity.TeamStats{Teams: teamNames}
+	for _, user := range s.users {
+		for _, name := range teamNames {
+			if user.Team == name {
+				stats.Members++
+				if user.IsActive {
+					stats.ActiveMembers++
+				}
+			}
+		}
+	}
+	return stats, nil
+}
+
+func (s *store) GetUser(userID string) (*entity.User, error) {
+	user, ok := s.users[userID]
+	if !ok {
+		return nil, nil
+	}
+	result := *user
+	return &result, nil
+}
+
+func (s *store) GetUserIncludingDeleted(userID string) (*entity.User, error) {
+	if tombstone, ok := s.tombstones[userID]; ok {
+		result := *tombstone
+		return &result, nil
+	}
+	return s.GetUser(userID)
+}
+
+func (s *store) CreateOrUpdateUser(user *entity.User) error {
+	result := *user
+	s.users[user.ID] = &result
+	return nil
+}
+
+func (s *store) UpdateUser(user *entity.User) error {
+	return s.CreateOrUpdateUser(user)
+}
+
+func (s *store) UpdateUserWithReassignments(user *entity.User, reassignments []entity.ReviewerReassignment) error {
+	s.reassign(reassignments)
+	return s.CreateOrUpdateUser(user)
+}
+
+func (s *store) GetUsersByTeam(teamName string) ([]*entity.User, error) {
+	users := make([]*entity.User, 0)
+	for _, user := range s.sortedUsers() {
+		if user.Team == teamName {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+func (s *store) GetActiveUsersByTeam(teamName string) ([]*entity.User, error) {
+	users := make([]*entity.User, 0)
+	for _, user := range s.sortedUsers() {
+		if user.Team == teamName && user.IsActive {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+func (s *store) SetWorkSchedule(userID string, schedule *entity.WorkSchedule) error {
+	s.schedules[userID] = schedule
+	return nil
+}
+
+func (s *store) GetWorkSchedules(userIDs []string) (map[string]*entity.WorkSchedule, error) {
+	schedules := make(map[string]*entity.WorkSchedule)
+	for _, id := range userIDs {
+		if schedule, ok := s.schedules[id]; ok {
+			schedules[id] = schedule
+		}
+	}
+	return schedules, nil
+}
+
+func (s *store) ListUsers(filter entity.UserFilter) ([]*entity.User, error) {
+	users := make([]*entity.User, 0)
+	for _, user := range s.sortedUsers() {
+		if filter.TeamName != "" && user.Team != filter.TeamName {
+			continue
+		}
+		if filter.IsActive != nil && user.IsActive != *filter.IsActive {
+			continue
+		}
+		if !strings.HasPrefix(strings.ToLower(user.Name), strings.ToLower(filter.UsernamePrefix)) {
+			continue
+		}
+		users = append(users, user)
+	}
+	if filter.Offset >= len(users) {
+		return make([]*entity.User, 0), nil
+	}
+	users = users[filter.Offset:]
+	if filter.Limit < len(users) {
+		users = users[:filter.Limit]
+	}
+	return users, nil
+}
+
+func (s *store) UpdateUsername(userID, username string) error {
+	s.users[userID].Name = username
+	return nil
+}
+
+func (s *store) GetDeletedUserIDs(userIDs []string) ([]string, error) {
+	deleted := make([]string, 0)
+	for _, id := range userIDs {
+		if s.deleted[id] {
+			deleted = append(deleted, id)
+		}
+	}
+	return deleted, nil
+}
+
+func (s *store) DeleteUser(userID string, reassignments []entity.ReviewerReassignment, _ time.Time) error {
+	s.reassign(reassignments)
+	if user, ok := s.users[userID]; ok {
+		s.tombstones[userID] = &entity.User{ID: userID, Name: entity.DeletedUsername, Team: user.Team}
+	}
+	delete(s.users, userID)
+	s.deleted[userID] = true
+	return nil
+}
+
+func (s *store) reassign(reassignments []entity.ReviewerReassignment) {
+	for _, reassignment := range reassignments {
+		pr, ok := s.prs[reassignment.PullRequestID]
+		if !ok {
+			continue
+		}
+		for i, reviewer := range pr.AssignedReviewers {
+			if reviewer == reassignment.OldReviewerID {
+				pr.AssignedReviewers[i] = reassignment.NewReviewerID
+				pr.Version++
+			}
+		}
+	}
+}
+
+func (s *store) sortedUsers() []*entity.User {
+	users := make([]*entity.User, 0, len(s.users))
+	for _, user := range s.users {
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users
+}
+
+func (s *store) CreatePR(pr *entity.PullRequest) error {
+	createdAt := fixtureTime
+	pr.CreatedAt = &createdAt
+	pr.Version = 1
+	result := *pr
+	s.prs[pr.ID] = &result
+	return nil
+}
+
+func (s *store) GetPR(prID string) (*entity.PullRequest, error) {
+	pr, ok := s.prs[prID]
+	if !ok {
+		return nil, nil
+	}
+	result := *pr
+	result.AssignedReviewers = append([]string(nil), pr.AssignedReviewers...)
+	return &result, nil
+}
+
+func (s *store) UpdatePR(pr *entity.PullRequest) (bool, error) {
+	return s.UpdatePRMetadata(pr, pr.Version)
+}
+
+func (s *store) UpdatePRMetadata(pr *entity.PullRequest, version int) (bool, error) {
+	if s.prs[pr.ID].Version != version {
+		return false, nil
+	}
+	pr.Version = version + 1
+	result := *pr
+	s.prs[pr.ID] = &result
+	return true, nil
+}
+
+func (s *store) PRExists(prID string) (bool, error) {
+	_, ok := s.prs[prID]
+	return ok, nil
+}
+
+func (s *store) GetPRsByReviewer(userID string) ([]*entity.PullRequest, error) {
+	prs := make([]*entity.PullRequest, 0)
+	for _, pr := range s.prs {
+		for _, reviewer := range pr.AssignedReviewers {
+			if reviewer == userID {
+				prs = append(prs, pr)
+			}
+		}
+	}
+	sort.Slice(prs, func(i, j int) bool { return prs[i].ID < prs[j].ID })
+	return prs, nil
+}
+
+func (s *store) GetReviewersWithOpenPRs() ([]string, error) {
+	return make([]string, 0), nil
+}
+
+func (s *store) AddApproval(prID, reviewerID string) error {
+	s.approvals[prID] = append(s.approvals[prID], reviewerID)
+	return nil
+}
+
+func (s *store) CountApprovals(prID string) (int, error) {
+	return len(s.approvals[prID]), nil
+}
+
+func (s *store) CountOpenReviews(userIDs []string) (map[string]int, error) {
+	counts := make(map[string]int, len(userIDs))
+	for _, id := range userIDs {
+		prs, _ := s.GetPRsByReviewer(id)
+		counts[id] = len(prs)
+	}
+	return counts, nil
+}
+
+func (s *store) CreateAwayPeriod(period *entity.AwayPeriod) error {
+	period.ID = int64(len(s.away) + 1)
+	s.away = append(s.away, period)
+	return nil
+}
+
+func (s *store) GetAwayUserIDs(time.Time) ([]string, error) {
+	return make([]string, 0), nil
+}
+
+func (s *store) GetPendingHandovers(time.Time) ([]*entity.AwayPeriod, error) {
+	return make([]*entity.AwayPeriod, 0), nil
+}
+
+func (s *store) CompleteHandover(_ int64, _ time.Time, reassignments []entity.ReviewerReassignment) error {
+	s.reassign(reassignments)
+	return nil
+}
+
+func (s *store) GetEndedPeriods(time.Time) ([]*entity.AwayPeriod, error) {
+	return make([]*entity.AwayPeriod, 0), nil
+}
+
+func (s *store) MarkReturned(int64, time.Time) error {
+	return nil
+}
+
+func (s *store) GetSettings(teamName string) (*entity.SLASettings, error) {
+	return s.sla[teamName], nil
+}
+
+func (s *store) SaveSettings(settings *entity.SLASettings) error {
+	s.sla[settings.TeamName] = settings
+	return nil
+}
+
+func (s *store) ListSettings() ([]*entity.SLASettings, error) {
+	settings := make([]*entity.SLASettings, 0, len(s.sla))
+	for _, item := range s.sla {
+		settings = append(settings, item)
+	}
+	return settings, nil
+}
+
+func (s *store) FindReviewBreaches(string, time.Time) ([]*entity.SLABreach, error) {
+	return make([]*entity.SLABreach, 0), nil
+}
+
+func (s *store) FindMergeBreaches(string, time.Time) ([]*entity.SLABreach, error) {
+	return make([]*entity.SLABreach, 0), nil
+}
+
+func (s *store) RecordBreach(breach *entity.SLABreach) error {
+	s.breaches = append(s.breaches, breach)
+	return nil
+}
+
+func (s *store) MarkReassigned(int64, string) error {
+	return nil
+}
+
+func (s *store) ResolveBreaches(time.Time) error {
+	return nil
+}
+
+func (s *store) ListBreaches(filter entity.SLABreachFilter) ([]*entity.SLABreach, error) {
+	breaches := make([]*entity.SLABreach, 0)
+	for _, breach := range s.breaches {
+		if filter.TeamName != "" && breach.TeamName != filter.TeamName {
+			continue
+		}
+		if filter.Kind != "" && breach.Kind != filter.Kind {
+			continue
+		}
+		breaches = append(breaches, breach)
+	}
+	return breaches, nil
+}
+
+func (s *store) GetPreferences(userID string) (*entity.DigestPreferences, error) {
+	return s.digests[userID], nil
+}
+
+func (s *store) SavePreferences(prefs *entity.DigestPreferences) error {
+	s.digests[prefs.UserID] = prefs
+	return nil
+}
+
+func (s *store) MarkDigestSent(string, time.Time) error {
+	return nil
+}
+
+func (s *store) ListAffinities(authorID string) ([]*entity.ReviewerAffinity, error) {
+	affinities := make([]*entity.ReviewerAffinity, 0)
+	for _, affinity := range s.affinities {
+		if affinity.AuthorID == authorID {
+			affinities = append(affinities, affinity)
+		}
+	}
+	return affinities, nil
+}
+
+func (s *store) SaveAffinity(affinity *entity.ReviewerAffinity) error {
+	s.affinities = append(s.affinities, affinity)
+	return nil
+}
+
+func (s *store) DeleteAffinity(authorID, reviewerID string) (bool, error) {
+	for i, affinity := range s.affinities {
+		if affinity.AuthorID == authorID && affinity.ReviewerID == reviewerID {
+			s.affinities = append(s.affinities[:i], s.affinities[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *store) SaveRepository(repository *entity.Repository) error {
+	updatedAt := fixtureTime
+	repository.UpdatedAt = &updatedAt
+	s.repos[repository.Name] = repository
+	return nil
+}
+
+func (s *store) GetRepository(name string) (*entity.Repository, error) {
+	return s.repos[name], nil
+}
+
+func (s *store) SetCodeOwners(name, content string) error {
+	s.repos[name].CodeOwners = content
+	return nil
+}
+
+func (s *store) RecordReview(string, []string, time.Time) error {
+	return nil
+}
+
+func (s *store) GetScores([]string, []string) ([]*entity.ExpertiseScore, error) {
+	return make([]*entity.ExpertiseScore, 0), nil
+}
+
+func (s *store) GetUserExpertise(userID string) ([]*entity.ExpertiseScore, error) {
+	scores := make([]*entity.ExpertiseScore, 0)
+	for _, score := range s.expertise {
+		if score.UserID == userID {
+			scores = append(scores, score)
+		}
+	}
+	return scores, nil
+}
+
+func (s *store) Import(*entity.BulkData) error {
+	return nil
+}
+
+func (s *store) Export() (*entity.BulkData, error) {
+	data := &entity.BulkData{
+		Teams:        make([]entity.BulkTeam, 0),
+		Users:        make([]entity.User, 0),
+		PullRequests: make([]entity.BulkPullRequest, 0),
+	}
+	for _, user := range s.sortedUsers() {
+		data.Users = append(data.Users, *user)
+	}
+	return data, nil
+}
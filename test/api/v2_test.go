@@ -0,0 +1,182 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+var casesV2 = []apiCase{
+	{name: "create_team", operation: "createTeam", method: http.MethodPost, target: "/v2/teams", body: `{"team_name":"payments","members":[{"user_id":"u10","username":"Zed","is_active":true}]}`, status: http.StatusCreated},
+	{name: "create_existing_team", operation: "createTeam", method: http.MethodPost, target: "/v2/teams", body: `{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true}]}`, status: http.StatusConflict},
+	{name: "get_team", operation: "getTeam", method: http.MethodGet, target: "/v2/teams/backend", status: http.StatusOK},
+	{name: "get_team_with_sub_teams", operation: "getTeam", method: http.MethodGet, target: "/v2/teams/backend?include_sub_teams=true", status: http.StatusOK},
+	{name: "get_unknown_team", operation: "getTeam", method: http.MethodGet, target: "/v2/teams/unknown", status: http.StatusNotFound},
+	{name: "get_user", operation: "getUser", method: http.MethodGet, target: "/v2/users/u1", status: http.StatusOK},
+	{name: "get_unknown_user", operation: "getUser", method: http.MethodGet, target: "/v2/users/unknown", status: http.StatusNotFound},
+	{name: "rename_user", operation: "updateUser", method: http.MethodPatch, target: "/v2/users/u2", body: `{"username":"Robert"}`, status: http.StatusOK},
+	{name: "deactivate_user", operation: "updateUser", method: http.MethodPatch, target: "/v2/users/u2", body: `{"is_active":false,"reassign_reviews":true}`, status: http.StatusOK},
+	{name: "update_user_without_changes", operation: "updateUser", method: http.MethodPatch, target: "/v2/users/u2", body: `{"reassign_reviews":true}`, status: http.StatusUnprocessableEntity},
+	{name: "update_unknown_user", operation: "updateUser", method: http.MethodPatch, target: "/v2/users/unknown", body: `{"username":"Robert"}`, status: http.StatusNotFound},
+	{name: "delete_user", operation: "deleteUser", method: http.MethodDelete, target: "/v2/users/u4", status: http.StatusNoContent},
+	{name: "delete_unknown_user", operation: "deleteUser", method: http.MethodDelete, target: "/v2/users/unknown", status: http.StatusNotFound},
+	{name: "create_pull_request", operation: "createPullRequest", method: http.MethodPost, target: "/v2/pull-requests", body: `{"pull_request_id":"pr-2","pull_request_name":"Add cache","author_id":"u1","labels":["cache"]}`, status: http.StatusCreated},
+	{name: "create_existing_pull_request", operation: "createPullRequest", method: http.MethodPost, target: "/v2/pull-requests", body: `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1"}`, status: http.StatusConflict},
+	{name: "get_pull_request", operation: "getPullRequest", method: http.MethodGet, target: "/v2/pull-requests/pr-1", status: http.StatusOK},
+	{name: "get_unknown_pull_request", operation: "getPullRequest", method: http.MethodGet, target: "/v2/pull-requests/unknown", status: http.StatusNotFound},
+	{name: "update_pull_request", operation: "updatePullRequest", method: http.MethodPatch, target: "/v2/pull-requests/pr-1", header: map[string]string{"If-Match": `"1"`}, body: `{"pull_request_name":"Add full-text search"}`, status: http.StatusOK},
+	{name: "update_pull_request_without_if_match", operation: "updatePullRequest", method: http.MethodPatch, target: "/v2/pull-requests/pr-1", body: `{"pull_request_name":"Add full-text search"}`, status: http.StatusPreconditionRequired},
+	{name: "update_stale_pull_request", operation: "updatePullRequest", method: http.MethodPatch, target: "/v2/pull-requests/pr-1", header: map[string]string{"If-Match": `"7"`}, body: `{"pull_request_name":"Add full-text search"}`, status: http.StatusPreconditionFailed},
+	{name: "merge_pull_request", operation: "mergePullRequest", method: http.MethodPut, target: "/v2/pull-requests/pr-1/merge", status: http.StatusOK},
+	{name: "merge_pull_request_by", operation: "mergePullRequest", method: http.MethodPut, target: "/v2/pull-requests/pr-1/merge", body: `{"merged_by":"u2"}`, status: http.StatusOK},
+	{name: "list_reviewers", operation: "listReviewers", method: http.MethodGet, target: "/v2/pull-requests/pr-1/reviewers", status: http.StatusOK},
+	{name: "remove_reviewer", operation: "removeReviewer", method: http.MethodDelete, target: "/v2/pull-requests/pr-1/reviewers/u2", status: http.StatusOK},
+	{name: "remove_unassigned_reviewer", operation: "removeReviewer", method: http.MethodDelete, target: "/v2/pull-requests/pr-1/reviewers/u4", status: http.StatusConflict},
+	{name: "approve_pull_request", operation: "approvePullRequest", method: http.MethodPut, target: "/v2/pull-requests/pr-1/approvals/u2", status: http.StatusNoContent},
+	{name: "approve_by_unassigned_user", operation: "approvePullRequest", method: http.MethodPut, target: "/v2/pull-requests/pr-1/approvals/u4", status: http.StatusConflict},
+}
+
+func TestHandlersV2_ConformToSpec(t *testing.T) {
+	_, router := loadSpec(t, specPathV2)
+
+	for _, tt := range casesV2 {
+		t.Run(tt.name, func(t *testing.T) {
+			checkCase(t, router, tt)
+		})
+	}
+}
+
+func TestCasesV2_CoverEveryOperation(t *testing.T) {
+	spec, _ := loadSpec(t, specPathV2)
+
+	covered := make(map[string]bool)
+	for _, tt := range casesV2 {
+		if tt.status < http.StatusBadRequest {
+			covered[tt.operation] = true
+		}
+	}
+	for _, item := range spec.Paths.Map() {
+		for _, operation := range item.Operations() {
+			if !covered[operation.OperationID] {
+				t.Errorf("operation %s has no successful conformance case", operation.OperationID)
+			}
+		}
+	}
+}
+
+func TestHandlersV2_ResourceHeaders(t *testing.T) {
+	tests := []struct {
+		name     string
+		create   apiCase
+		location string
+	}{
+		{"team", caseV2(t, "create_team"), "/v2/teams/payments"},
+		{"pull_request", caseV2(t, "create_pull_request"), "/v2/pull-requests/pr-2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newEngine(t)
+
+			created := serveCase(engine, tt.create)
+			if created.Code != http.StatusCreated {
+				t.Fatalf("expected status 201, got %d: %s", created.Code, created.Body.String())
+			}
+			if got := created.Header().Get("Location"); got != tt.location {
+				t.Fatalf("expected Location %s, got %q", tt.location, got)
+			}
+
+			fetched := serveCase(engine, apiCase{method: http.MethodGet, target: tt.location})
+			if fetched.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", fetched.Code, fetched.Body.String())
+			}
+			if tag := fetched.Header().Get("ETag"); tag == "" || tag != created.Header().Get("ETag") {
+				t.Errorf("expected GET ETag %q to match the created ETag %q", tag, created.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+func TestHandlersV2_ConditionalRequests(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		change apiCase
+	}{
+		{"team", "/v2/teams/backend", apiCase{method: http.MethodPatch, target: "/v2/users/u2", body: `{"username":"Robert"}`}},
+		{"user", "/v2/users/u2", apiCase{method: http.MethodPatch, target: "/v2/users/u2", body: `{"username":"Robert"}`}},
+		{"pull_request", "/v2/pull-requests/pr-1", apiCase{method: http.MethodPatch, target: "/v2/pull-requests/pr-1", header: map[string]string{"If-Match": `"1"`}, body: `{"pull_request_name":"Add full-text search"}`}},
+		{"reviewers", "/v2/pull-requests/pr-1/reviewers", apiCase{method: http.MethodDelete, target: "/v2/pull-requests/pr-1/reviewers/u2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newEngine(t)
+
+			first := serveCase(engine, apiCase{method: http.MethodGet, target: tt.target})
+			tag := first.Header().Get("ETag")
+			if first.Code != http.StatusOK || tag == "" {
+				t.Fatalf("expected 200 with an ETag, got %d %q", first.Code, tag)
+			}
+
+			cached := serveCase(engine, apiCase{method: http.MethodGet, target: tt.target, header: map[string]string{"If-None-Match": tag}})
+			if cached.Code != http.StatusNotModified || cached.Body.Len() != 0 {
+				t.Fatalf("expected empty 304 for a matching If-None-Match, got %d: %s", cached.Code, cached.Body.String())
+			}
+
+			if changed := serveCase(engine, tt.change); changed.Code != http.StatusOK {
+				t.Fatalf("expected change to succeed, got %d: %s", changed.Code, changed.Body.String())
+			}
+
+			stale := serveCase(engine, apiCase{method: http.MethodGet, target: tt.target, header: map[string]string{"If-None-Match": tag}})
+			if stale.Code != http.StatusOK || stale.Header().Get("ETag") == tag {
+				t.Errorf("expected 200 with a new ETag after the change, got %d %q", stale.Code, stale.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+func TestHandlersV2_PullRequestETagChangesAfterReassignment(t *testing.T) {
+	engine := newEngine(t)
+
+	before := serveCase(engine, caseV2(t, "get_pull_request"))
+	tag := before.Header().Get("ETag")
+	if before.Code != http.StatusOK || tag == "" {
+		t.Fatalf("expected 200 with an ETag, got %d %q", before.Code, tag)
+	}
+
+	if deactivated := serveCase(engine, caseV2(t, "deactivate_user")); deactivated.Code != http.StatusOK {
+		t.Fatalf("expected deactivation to succeed, got %d: %s", deactivated.Code, deactivated.Body.String())
+	}
+
+	after := serveCase(engine, apiCase{method: http.MethodGet, target: "/v2/pull-requests/pr-1", header: map[string]string{"If-None-Match": tag}})
+	if after.Code != http.StatusOK || after.Header().Get("ETag") == tag {
+		t.Fatalf("expected 200 with a new ETag after reassignment, got %d %q", after.Code, after.Header().Get("ETag"))
+	}
+	if strings.Contains(after.Body.String(), `"u2"`) {
+		t.Errorf("expected u2 to be reassigned away from pr-1, got %s", after.Body.String())
+	}
+
+	stale := serveCase(engine, apiCase{method: http.MethodPatch, target: "/v2/pull-requests/pr-1", header: map[string]string{"If-Match": tag}, body: `{"pull_request_name":"Add full-text search"}`})
+	if stale.Code != http.StatusPreconditionFailed {
+		t.Errorf("expected 412 for an If-Match from before the reassignment, got %d: %s", stale.Code, stale.Body.String())
+	}
+}
+
+func serveCase(engine http.Handler, tt apiCase) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, newRequest(tt))
+	return w
+}
+
+func caseV2(t *testing.T, name string) apiCase {
+	t.Helper()
+	for _, tt := range casesV2 {
+		if tt.name == name {
+			return tt
+		}
+	}
+	t.Fatalf("unknown v2 case %s", name)
+	return apiCase{}
+}
@@ -0,0 +1,116 @@
+package bulk_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-review/internal/bulk"
+	"pr-review/internal/entity"
+)
+
+func TestCSV_RoundTrip(t *testing.T) {
+	createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	required, allowSelfMerge := 3, false
+	data := &entity.BulkData{
+		Teams: []entity.BulkTeam{
+			{
+				Name:                   "backend",
+				RequiredReviewers:      &required,
+				SelectionStrategy:      entity.SelectionStrategyExpertise,
+				AllowSelfMerge:         &allowSelfMerge,
+				RequiredApprovals:      1,
+				ReassignOnDeactivation: true,
+				CompositionRules:       []entity.CompositionRule{{MinGrade: entity.GradeSenior, AtLeast: 1}},
+				SecurityTeam:           "security",
+				MaxOpenReviews:         5,
+				HierarchyFallback:      true,
+				FallbackTeams:          []string{"frontend", "platform"},
+				SharedReviewers:        []string{"u9"},
+			},
+		},
+		Users: []entity.User{
+			{ID: "u1", Name: "Alice, Jr.", Team: "backend", IsActive: true, Grade: entity.GradeSenior},
+			{ID: "u2", Name: "Bob", Team: "backend", IsActive: false, Grade: entity.GradeMiddle},
+		},
+		PullRequests: []entity.BulkPullRequest{
+			{ID: "p1", Name: "Add \"search\"", AuthorID: "u1", Status: entity.StatusOpen, AssignedReviewers: []string{"u2", "u3"}, CreatedAt: &createdAt},
+			{ID: "p2", Name: "Fix", AuthorID: "u2", Status: entity.StatusMerged, AssignedReviewers: []string{}, CreatedAt: &createdAt, MergedAt: &createdAt},
+		},
+	}
+
+	for _, resource := range []entity.BulkResource{entity.BulkResourceTeams, entity.BulkResourceUsers, entity.BulkResourcePullRequests} {
+		t.Run(string(resource), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := bulk.WriteCSV(resource, data, &buf); err != nil {
+				t.Fatalf("unexpected write error: %v", err)
+			}
+			parsed, err := bulk.ReadCSV(resource, &buf, 100)
+			if err != nil {
+				t.Fatalf("unexpected read error: %v", err)
+			}
+
+			var got, want any
+			switch resource {
+			case entity.BulkResourceTeams:
+				got, want = parsed.Teams, data.Teams
+			case entity.BulkResourceUsers:
+				got, want = parsed.Users, data.Users
+			default:
+				got, want = parsed.PullRequests, data.PullRequests
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("round trip mismatch:\n got %+v\nwant %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestReadCSV_ColumnsAndDefaults(t *testing.T) {
+	content := "\ufeffteam_name, username, user_id\nbackend,Alice,u1\n"
+	data, err := bulk.ReadCSV(entity.BulkResourceUsers, strings.NewReader(content), 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []entity.User{{ID: "u1", Name: "Alice", Team: "backend", IsActive: true}}
+	if !reflect.DeepEqual(data.Users, want) {
+		t.Fatalf("expected %+v, got %+v", want, data.Users)
+	}
+}
+
+func TestReadCSV_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource entity.BulkResource
+		content  string
+		maxRows  int
+		errMsg   string
+	}{
+		{name: "empty", resource: entity.BulkResourceTeams, content: "", errMsg: "header is required"},
+		{name: "unknown_column", resource: entity.BulkResourceTeams, content: "team_name,color\n", errMsg: `unknown column "color"`},
+		{name: "missing_column", resource: entity.BulkResourceUsers, content: "user_id,username\n", errMsg: `missing column "team_name"`},
+		{name: "duplicate_column", resource: entity.BulkResourceTeams, content: "team_name,team_name\n", errMsg: "duplicate column"},
+		{name: "bad_bool", resource: entity.BulkResourceUsers, content: "user_id,username,team_name,is_active\nu1,A,t,true\nu2,B,t,maybe\n", errMsg: "row 2: is_active must be true or false"},
+		{name: "bad_int", resource: entity.BulkResourceTeams, content: "team_name,required_reviewers\nbackend,two\n", errMsg: "row 1: required_reviewers must be an integer"},
+		{name: "bad_composition_rules", resource: entity.BulkResourceTeams, content: "team_name,composition_rules\nbackend,senior\n", errMsg: "row 1: composition_rules must be a JSON array of rules"},
+		{name: "bad_time", resource: entity.BulkResourcePullRequests, content: "pull_request_id,pull_request_name,author_id,createdAt\np1,PR,u1,yesterday\n", errMsg: "row 1: createdAt must be an RFC 3339 timestamp"},
+		{name: "wrong_field_count", resource: entity.BulkResourceTeams, content: "team_name\nbackend,extra\n", errMsg: "wrong number of fields"},
+		{name: "too_many_rows", resource: entity.BulkResourceTeams, content: "team_name\na\nb\nc\n", maxRows: 2, errMsg: "more than 2 rows"},
+		{name: "unknown_resource", resource: "repositories", content: "name\n", errMsg: "unknown resource"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maxRows := tt.maxRows
+			if maxRows == 0 {
+				maxRows = 100
+			}
+			_, err := bulk.ReadCSV(tt.resource, strings.NewReader(tt.content), maxRows)
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Fatalf("expected error %q, got %v", tt.errMsg, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"pr-review/pkg/client"
+)
+
+type recordedRequest struct {
+	method string
+	path   string
+	query  string
+	header http.Header
+	body   map[string]any
+}
+
+func newServer(t *testing.T, status int, response string) (*client.Client, *recordedRequest) {
+	t.Helper()
+	recorded := &recordedRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded.method = r.Method
+		recorded.path = r.URL.Path
+		recorded.query = r.URL.RawQuery
+		recorded.header = r.Header.Clone()
+		if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+			if err := json.Unmarshal(data, &recorded.body); err != nil {
+				t.Errorf("request body is not JSON: %v", err)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		io.WriteString(w, response)
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.New(client.Config{BaseURL: server.URL + "/", Token: "secret", Organization: "acme"})
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	return c, recorded
+}
+
+func TestClient_Requests(t *testing.T) {
+	ctx := context.Background()
+	active := false
+
+	tests := []struct {
+		name     string
+		response string
+		call     func(c *client.Client) (any, error)
+		method   string
+		path     string
+		query    string
+		body     map[string]any
+		want     any
+	}{
+		{
+			name:     "add_team",
+			response: `{"team":{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true}]}}`,
+			call: func(c *client.Client) (any, error) {
+				return c.AddTeam(ctx, &client.Team{
+					TeamName: "backend",
+					Members:  []client.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}},
+				})
+			},
+			method: http.MethodPost,
+			path:   "/team/add",
+			body: map[string]any{
+				"team_name":                "backend",
+				"members":                  []any{map[string]any{"user_id": "u1", "username": "Alice", "is_active": true}},
+				"reassign_on_deactivation": false,
+			},
+			want: &client.Team{
+				TeamName: "backend",
+				Members:  []client.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}},
+			},
+		},
+		{
+			name:     "get_team_unwrapped",
+			response: `{"team_name":"backend","members":[]}`,
+			call:     func(c *client.Client) (any, error) { return c.GetTeam(ctx, "backend") },
+			method:   http.MethodGet,
+			path:     "/team/get",
+			query:    "team_name=backend",
+			want:     &client.Team{TeamName: "backend", Members: []client.TeamMember{}},
+		},
+		{
+			name:     "team_stats",
+			response: `{"stats":{"team_name":"platform","teams":["platform","backend"],"members":4}}`,
+			call:     func(c *client.Client) (any, error) { return c.GetTeamStats(ctx, "platform", true) },
+			method:   http.MethodGet,
+			path:     "/team/stats",
+			query:    "include_sub_teams=true&team_name=platform",
+			want:     &client.TeamStats{TeamName: "platform", Teams: []string{"platform", "backend"}, Members: 4},
+		},
+		{
+			name:     "list_users",
+			response: `{"users":[{"user_id":"u2","username":"Bob","team_name":"backend","is_active":false}],"limit":1,"offset":0,"next_offset":1}`,
+			call: func(c *client.Client) (any, error) {
+				return c.ListUsers(ctx, client.ListUsersOptions{TeamName: "backend", IsActive: &active, Limit: 1})
+			},
+			method: http.MethodGet,
+			path:   "/users/list",
+			query:  "is_active=false&limit=1&team_name=backend",
+			want: &client.UserPage{
+				Users:      []*client.User{{UserID: "u2", Username: "Bob", TeamName: "backend"}},
+				Limit:      1,
+				NextOffset: intPtr(1),
+			},
+		},
+		{
+			name:     "deactivate_user",
+			response: `{"user":{"user_id":"u2","username":"Bob","team_name":"backend","is_active":false},"handover":{"reassignments":[],"unreplaced_pull_requests":["pr-1"]}}`,
+			call: func(c *client.Client) (any, error) {
+				return c.SetIsActive(ctx, &client.SetIsActiveRequest{UserID: "u2", ReassignReviews: boolPtr(true)})
+			},
+			method: http.MethodPost,
+			path:   "/users/setIsActive",
+			body:   map[string]any{"user_id": "u2", "is_active": false, "reassign_reviews": true},
+			want: &client.SetIsActiveResult{
+				User:     &client.User{UserID: "u2", Username: "Bob", TeamName: "backend"},
+				Handover: &client.ReassignmentReport{Reassignments: []client.ReviewerReassignment{}, UnreplacedPRs: []string{"pr-1"}},
+			},
+		},
+		{
+			name:     "review_queue",
+			response: `{"user_id":"u2","pull_requests":[{"pull_request_id":"pr-1","pull_request_name":"Fix","author_id":"u1","status":"OPEN"}]}`,
+			call: func(c *client.Client) (any, error) {
+				return c.GetReview(ctx, "u2", client.ReviewQueueOptions{Labels: []string{"bug", "ui"}, Priority: client.PriorityHigh})
+			},
+			method: http.MethodGet,
+			path:   "/users/getReview",
+			query:  "label=bug&label=ui&priority=high&user_id=u2",
+			want:   []*client.PullRequestShort{{PullRequestID: "pr-1", PullRequestName: "Fix", AuthorID: "u1", Status: client.StatusOpen}},
+		},
+		{
+			name:     "create_pull_request",
+			response: `{"pr":{"pull_request_id":"pr-1","pull_request_name":"Fix","author_id":"u1","status":"OPEN","assigned_reviewers":["u2"],"version":1}}`,
+			call: func(c *client.Client) (any, error) {
+				return c.CreatePullRequest(ctx, &client.CreatePullRequestRequest{PullRequestID: "pr-1", PullRequestName: "Fix", AuthorID: "u1", Labels: []string{"bug"}})
+			},
+			method: http.MethodPost,
+			path:   "/pullRequest/create",
+			body:   map[string]any{"pull_request_id": "pr-1", "pull_request_name": "Fix", "author_id": "u1", "labels": []any{"bug"}},
+			want:   &client.PullRequest{PullRequestID: "pr-1", PullRequestName: "Fix", AuthorID: "u1", Status: client.StatusOpen, AssignedReviewers: []string{"u2"}, Version: 1},
+		},
+		{
+			name:     "merge_pull_request",
+			response: `{"pr":{"pull_request_id":"pr-1","status":"MERGED","assigned_reviewers":[]}}`,
+			call:     func(c *client.Client) (any, error) { return c.MergePullRequest(ctx, "pr-1", "") },
+			method:   http.MethodPost,
+			path:     "/pullRequest/merge",
+			body:     map[string]any{"pull_request_id": "pr-1"},
+			want:     &client.PullRequest{PullRequestID: "pr-1", Status: client.StatusMerged, AssignedReviewers: []string{}},
+		},
+		{
+			name:     "reassign_reviewer",
+			response: `{"pr":{"pull_request_id":"pr-1","status":"OPEN","assigned_reviewers":["u3"]},"replaced_by":"u3"}`,
+			call:     func(c *client.Client) (any, error) { return c.ReassignReviewer(ctx, "pr-1", "u2") },
+			method:   http.MethodPost,
+			path:     "/pullRequest/reassign",
+			body:     map[string]any{"pull_request_id": "pr-1", "old_user_id": "u2"},
+			want: &client.ReassignResult{
+				PR:         &client.PullRequest{PullRequestID: "pr-1", Status: client.StatusOpen, AssignedReviewers: []string{"u3"}},
+				ReplacedBy: "u3",
+			},
+		},
+		{
+			name:     "delete_user",
+			response: `{"user_id":"u2","handover":{"reassignments":[],"unreplaced_pull_requests":[]}}`,
+			call:     func(c *client.Client) (any, error) { return c.DeleteUser(ctx, "u2") },
+			method:   http.MethodDelete,
+			path:     "/users/delete",
+			query:    "user_id=u2",
+			want:     &client.ReassignmentReport{Reassignments: []client.ReviewerReassignment{}, UnreplacedPRs: []string{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, recorded := newServer(t, http.StatusOK, tt.response)
+
+			got, err := tt.call(c)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if recorded.method != tt.method || recorded.path != tt.path || recorded.query != tt.query {
+				t.Fatalf("expected %s %s?%s, got %s %s?%s", tt.method, tt.path, tt.query, recorded.method, recorded.path, recorded.query)
+			}
+			if !reflect.DeepEqual(recorded.body, tt.body) {
+				t.Fatalf("expected body %v, got %v", tt.body, recorded.body)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+			if auth := recorded.header.Get("Authorization"); auth != "Bearer secret" {
+				t.Fatalf("expected bearer token, got %q", auth)
+			}
+			if org := recorded.header.Get(client.OrganizationHeader); org != "acme" {
+				t.Fatalf("expected organization header, got %q", org)
+			}
+		})
+	}
+}
+
+func TestClient_APIError(t *testing.T) {
+	c, _ := newServer(t, http.StatusConflict, `{"error":{"code":"PR_EXISTS","message":"PR id already exists"}}`)
+
+	_, err := c.CreatePullRequest(context.Background(), &client.CreatePullRequestRequest{PullRequestID: "pr-1"})
+	if !client.IsCode(err, "PR_EXISTS") {
+		t.Fatalf("expected PR_EXISTS, got %v", err)
+	}
+	if err.Error() != "PR_EXISTS: PR id already exists" {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+}
+
+func TestClient_APIErrorDetails(t *testing.T) {
+	c, _ := newServer(t, http.StatusUnprocessableEntity,
+		`{"error":{"code":"VALIDATION_FAILED","message":"work_end must be after work_start","details":[{"field":"work_end","rule":"consistent","message":"work_end must be after work_start"}],"documentation_url":"/docs/errors#validation_failed"}}`)
+
+	_, err := c.CreatePullRequest(context.Background(), &client.CreatePullRequestRequest{PullRequestID: "pr-1"})
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Details) != 1 || apiErr.Details[0].Rule != "consistent" {
+		t.Fatalf("expected error with field details, got %#v", err)
+	}
+	if err.Error() != "VALIDATION_FAILED: work_end must be after work_start (work_end: work_end must be after work_start)" {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+}
+
+func TestClient_ImportRejectedReturnsReport(t *testing.T) {
+	c, recorded := newServer(t, http.StatusUnprocessableEntity,
+		`{"report":{"dry_run":false,"applied":false,"teams":1,"users":0,"pull_requests":0,"errors":[{"resource":"teams","row":1,"id":"backend","message":"team_name already exists"}]}}`)
+
+	report, err := c.Import(context.Background(), &client.BulkData{Teams: []client.BulkTeam{{TeamName: "backend"}}}, false)
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 error, got %v", err)
+	}
+	if report == nil || len(report.Errors) != 1 || report.Errors[0].Message != "team_name already exists" {
+		t.Fatalf("expected report with row errors, got %+v", report)
+	}
+	if recorded.path != "/admin/import" || recorded.query != "" {
+		t.Fatalf("unexpected request %s?%s", recorded.path, recorded.query)
+	}
+}
+
+func TestNew_InvalidBaseURL(t *testing.T) {
+	if _, err := client.New(client.Config{BaseURL: "localhost:8080"}); err == nil {
+		t.Fatal("expected error for base URL without scheme")
+	}
+}
+
+func intPtr(v int) *int    { return &v }
+func boolPtr(v bool) *bool { return &v }
@@ -0,0 +1,130 @@
+package codeowners_test
+
+import (
+	"strings"
+	"testing"
+
+	"pr-review/internal/codeowners"
+)
+
+const sampleCodeOwners = `# Default owners for everything in the repo.
+*       @global-owner1 @global-owner2
+
+# Order is important; the last matching pattern takes precedence.
+*.js    @js-owner #This is an inline comment.
+*.go docs@example.com
+
+/build/logs/ @doctocat
+docs/*  docs@example.com
+apps/ @octocat
+/docs/ @doctocat
+/scripts/ @doctocat @octocat
+**/logs @octocat
+/apps/github
+/src/**/migrations @acme/db-team
+\#notes @octocat
+`
+
+func TestParse_Owners(t *testing.T) {
+	file, err := codeowners.Parse(sampleCodeOwners)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{path: "README.md", want: []string{"@global-owner1", "@global-owner2"}},
+		{path: "web/app.js", want: []string{"@js-owner"}},
+		{path: "cmd/main.go", want: []string{"docs@example.com"}},
+		{path: "build/logs/out.txt", want: []string{"@octocat"}},
+		{path: "build/out.txt", want: []string{"@global-owner1", "@global-owner2"}},
+		{path: "docs/getting-started.md", want: []string{"@doctocat"}},
+		{path: "src/docs/getting-started.md", want: []string{"@global-owner1", "@global-owner2"}},
+		{path: "service/apps/main.rb", want: []string{"@octocat"}},
+		{path: "scripts/deploy.sh", want: []string{"@doctocat", "@octocat"}},
+		{path: "deeply/nested/logs/today.log", want: []string{"@octocat"}},
+		{path: "apps/github/index.rb", want: []string{}},
+		{path: "src/db/migrations/001.sql", want: []string{"@acme/db-team"}},
+		{path: "src/migrations/001.sql", want: []string{"@acme/db-team"}},
+		{path: "/web/app.js", want: []string{"@js-owner"}},
+		{path: "#notes", want: []string{"@octocat"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got := file.Owners(tt.path)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("expected owners %v for %s, got %v", tt.want, tt.path, got)
+			}
+		})
+	}
+}
+
+func TestParse_Wildcards(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		match   bool
+	}{
+		{pattern: "docs/*", path: "docs/a.md", match: true},
+		{pattern: "docs/*", path: "docs/sub/a.md", match: false},
+		{pattern: "docs/**", path: "docs/sub/a.md", match: true},
+		{pattern: "/docs", path: "docs/sub/a.md", match: true},
+		{pattern: "/docs", path: "src/docs/a.md", match: false},
+		{pattern: "docs", path: "src/docs/a.md", match: true},
+		{pattern: "a/**/b", path: "a/b", match: true},
+		{pattern: "a/**/b", path: "a/x/y/b/c.txt", match: true},
+		{pattern: "file?.txt", path: "dir/file1.txt", match: true},
+		{pattern: "file?.txt", path: "dir/file10.txt", match: false},
+		{pattern: "*.md", path: "notes.mdx", match: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+"_"+tt.path, func(t *testing.T) {
+			file, err := codeowners.Parse(tt.pattern + " @owner")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := len(file.Owners(tt.path)) > 0; got != tt.match {
+				t.Fatalf("pattern %q on %q: expected match=%v", tt.pattern, tt.path, tt.match)
+			}
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		errMsg  string
+	}{
+		{name: "negation", content: "!*.js @owner", errMsg: "line 1: negated pattern"},
+		{name: "char_range", content: "*.go @a\n*.[ch] @owner", errMsg: "line 2: character ranges"},
+		{name: "bad_owner", content: "*.go owner", errMsg: "invalid owner \"owner\""},
+		{name: "bad_team_owner", content: "*.go @org/team/extra", errMsg: "invalid owner"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := codeowners.Parse(tt.content)
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Fatalf("expected error %q, got %v", tt.errMsg, err)
+			}
+		})
+	}
+}
+
+func TestFile_OwnersForPaths(t *testing.T) {
+	file, err := codeowners.Parse("* @lead\n/api/ @alice @bob\n*.sql @acme/dba @alice\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := file.OwnersForPaths([]string{"api/handler.go", "db/001.sql", "api/routes.go"})
+	want := []string{"@alice", "@bob", "@acme/dba"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
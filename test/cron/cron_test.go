@@ -0,0 +1,63 @@
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"pr-review/internal/cron"
+)
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{name: "too_few_fields", expr: "0 9 * *"},
+		{name: "too_many_fields", expr: "0 9 * * * *"},
+		{name: "minute_out_of_range", expr: "60 9 * * *"},
+		{name: "bad_value", expr: "a 9 * * *"},
+		{name: "bad_step", expr: "*/0 9 * * *"},
+		{name: "reversed_range", expr: "0 9 * * 5-1"},
+		{name: "weekday_out_of_range", expr: "0 9 * * 8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := cron.Parse(tt.expr); err == nil {
+				t.Fatalf("expected error for %q", tt.expr)
+			}
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	friday := time.Date(2024, 1, 5, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		expr  string
+		after time.Time
+		want  time.Time
+	}{
+		{name: "weekdays_skip_weekend", expr: "0 9 * * 1-5", after: friday, want: time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)},
+		{name: "same_day_later", expr: "0 12 * * *", after: friday, want: time.Date(2024, 1, 5, 12, 0, 0, 0, time.UTC)},
+		{name: "step_minutes", expr: "*/15 * * * *", after: friday, want: time.Date(2024, 1, 5, 10, 45, 0, 0, time.UTC)},
+		{name: "list_hours", expr: "0 8,17 * * *", after: friday, want: time.Date(2024, 1, 5, 17, 0, 0, 0, time.UTC)},
+		{name: "sunday_as_seven", expr: "0 0 * * 7", after: friday, want: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)},
+		{name: "day_or_weekday", expr: "0 0 1 * 1", after: friday, want: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)},
+		{name: "strictly_after", expr: "30 10 * * *", after: friday, want: time.Date(2024, 1, 6, 10, 30, 0, 0, time.UTC)},
+		{name: "never_fires", expr: "0 0 31 2 *", after: friday, want: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := cron.Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := schedule.Next(tt.after); !got.Equal(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package entity_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"pr-review/internal/entity"
+)
+
+func TestWorkSchedule_UntilWorkingHours(t *testing.T) {
+	monday := time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC)
+	day := &entity.WorkSchedule{TimeZone: "UTC", Start: "09:00", End: "18:00", Days: []time.Weekday{time.Monday}}
+	night := &entity.WorkSchedule{TimeZone: "UTC", Start: "22:00", End: "06:00", Days: []time.Weekday{time.Monday}}
+
+	tests := []struct {
+		name     string
+		schedule *entity.WorkSchedule
+		at       time.Time
+		want     time.Duration
+	}{
+		{"day_shift_on", day, monday.Add(10 * time.Hour), 0},
+		{"day_shift_before_start", day, monday.Add(8 * time.Hour), time.Hour},
+		{"day_shift_after_end", day, monday.Add(18 * time.Hour), 7*24*time.Hour - 9*time.Hour},
+		{"night_shift_before_midnight", night, monday.Add(23 * time.Hour), 0},
+		{"night_shift_after_midnight", night, monday.Add(27 * time.Hour), 0},
+		{"night_shift_before_start", night, monday.Add(21 * time.Hour), time.Hour},
+		{"night_shift_after_end", night, monday.Add(30 * time.Hour), 6*24*time.Hour + 16*time.Hour},
+		{"night_shift_other_day", night, monday.Add(-21 * time.Hour), 43 * time.Hour},
+		{"invalid_clock", &entity.WorkSchedule{TimeZone: "UTC", Start: "9am", End: "18:00", Days: []time.Weekday{time.Monday}}, monday, time.Duration(math.MaxInt64)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.UntilWorkingHours(tt.at); got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package i18n_test
+
+import (
+	"regexp"
+	"sort"
+	"testing"
+
+	"pr-review/internal/i18n"
+)
+
+var placeholder = regexp.MustCompile(`\{[a-z_]+\}`)
+
+func TestCatalogs_HaveSameKeysAndPlaceholders(t *testing.T) {
+	english := i18n.Keys(i18n.English)
+	russian := i18n.Keys(i18n.Russian)
+	sort.Strings(english)
+	sort.Strings(russian)
+
+	if len(english) == 0 {
+		t.Fatal("expected english catalog to be loaded")
+	}
+	if len(english) != len(russian) {
+		t.Fatalf("expected catalogs to have the same size, got en=%d ru=%d", len(english), len(russian))
+	}
+	for i, key := range english {
+		if russian[i] != key {
+			t.Fatalf("catalog keys differ: en has %s, ru has %s", key, russian[i])
+		}
+		en := placeholders(i18n.Translate(i18n.English, key, nil))
+		ru := placeholders(i18n.Translate(i18n.Russian, key, nil))
+		if en != ru {
+			t.Errorf("%s: placeholders differ, en=%v ru=%v", key, en, ru)
+		}
+	}
+}
+
+func placeholders(message string) string {
+	found := placeholder.FindAllString(message, -1)
+	sort.Strings(found)
+	result := ""
+	for _, name := range found {
+		result += name
+	}
+	return result
+}
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name     string
+		lang     i18n.Language
+		key      string
+		params   i18n.Params
+		expected string
+	}{
+		{"english", i18n.English, "NOT_FOUND.team", nil, "team not found"},
+		{"russian", i18n.Russian, "NOT_FOUND.team", nil, "команда не найдена"},
+		{"params", i18n.Russian, "VALIDATION_FAILED.max_length", i18n.Params{"field": "username", "max": 255}, "поле username не может быть длиннее 255 символов"},
+		{"unknown_language", i18n.Language("de"), "PR_MERGED.update", nil, "cannot update merged PR"},
+		{"unknown_key", i18n.Russian, "NOT_FOUND.unknown", nil, "NOT_FOUND.unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := i18n.Translate(tt.lang, tt.key, tt.params); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestFromAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected i18n.Language
+	}{
+		{"", i18n.English},
+		{"ru", i18n.Russian},
+		{"ru-RU,ru;q=0.9,en;q=0.8", i18n.Russian},
+		{"en-US,en;q=0.9", i18n.English},
+		{"de-DE", i18n.English},
+		{"de-DE,ru;q=0.5", i18n.Russian},
+		{"not a language;;", i18n.English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			if got := i18n.FromAcceptLanguage(tt.header); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"pr-review/internal/http/dto"
+	"pr-review/internal/http/middleware"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+)
+
+const testSpec = `
+openapi: 3.0.3
+info: { title: test, version: "1" }
+paths:
+  /team/get:
+    get:
+      parameters:
+        - name: team_name
+          in: query
+          required: true
+          schema: { type: string, minLength: 1, maxLength: 5 }
+      responses:
+        '200':
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: object
+                required: [team_name]
+                properties:
+                  team_name: { type: string }
+  /team/add:
+    post:
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [team_name, members]
+              properties:
+                team_name: { type: string }
+                members:
+                  type: array
+                  items:
+                    type: object
+                    required: [user_id]
+                    properties:
+                      user_id: { type: string, minLength: 1 }
+      responses:
+        '201':
+          description: created
+`
+
+func newRouter(t *testing.T, validateResponses bool, handler gin.HandlerFunc) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(testSpec))
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+	validation, err := middleware.Validation(spec, validateResponses)
+	if err != nil {
+		t.Fatalf("failed to build middleware: %v", err)
+	}
+
+	router := gin.New()
+	router.Use(validation)
+	router.GET("/team/get", handler)
+	router.POST("/team/add", handler)
+	router.GET("/health", handler)
+	return router
+}
+
+func serve(router *gin.Engine, method, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func decodeError(t *testing.T, w *httptest.ResponseRecorder) dto.ErrorDetail {
+	t.Helper()
+	var response dto.ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("invalid error response: %v\n%s", err, w.Body.String())
+	}
+	return response.Error
+}
+
+func fields(details []dto.FieldViolation) []string {
+	result := make([]string, len(details))
+	for i, detail := range details {
+		result[i] = detail.Field
+	}
+	return result
+}
+
+func TestValidation_ValidRequestReachesHandler(t *testing.T) {
+	called := false
+	router := newRouter(t, false, func(c *gin.Context) {
+		called = true
+		c.JSON(http.StatusOK, gin.H{"team_name": c.Query("team_name")})
+	})
+
+	w := serve(router, http.MethodGet, "/team/get?team_name=core", "")
+
+	if w.Code != http.StatusOK || !called {
+		t.Fatalf("expected handler to be called with 200, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestValidation_InvalidQueryParameter(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		rule   string
+	}{
+		{"missing", "/team/get", "required"},
+		{"too_long", "/team/get?team_name=backend", "maxLength"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newRouter(t, false, func(c *gin.Context) {
+				t.Fatal("handler must not be called for an invalid request")
+			})
+
+			w := serve(router, http.MethodGet, tt.target, "")
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected status 400, got %d", w.Code)
+			}
+			detail := decodeError(t, w)
+			if detail.Code != "INVALID_REQUEST" {
+				t.Errorf("expected code INVALID_REQUEST, got %s", detail.Code)
+			}
+			if len(detail.Details) != 1 || detail.Details[0].Field != "team_name" || detail.Details[0].Rule != tt.rule {
+				t.Errorf("expected a team_name %s violation, got %+v", tt.rule, detail.Details)
+			}
+			if detail.DocumentationURL != "/docs/errors#invalid_request" {
+				t.Errorf("unexpected documentation_url %q", detail.DocumentationURL)
+			}
+		})
+	}
+}
+
+func TestValidation_ReportsEveryBodyViolation(t *testing.T) {
+	router := newRouter(t, false, func(c *gin.Context) {
+		t.Fatal("handler must not be called for an invalid request")
+	})
+
+	w := serve(router, http.MethodPost, "/team/add", `{"members":[{"user_id":"u1"},{"user_id":""}]}`)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", w.Code)
+	}
+	got := strings.Join(fields(decodeError(t, w).Details), ",")
+	for _, want := range []string{"team_name", "members[1].user_id"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected violation for %s, got %s", want, got)
+		}
+	}
+}
+
+func TestValidation_HandlerReadsValidatedBody(t *testing.T) {
+	router := newRouter(t, false, func(c *gin.Context) {
+		var req struct {
+			TeamName string `json:"team_name"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil || req.TeamName != "core" {
+			t.Errorf("expected body to be readable after validation, got %q: %v", req.TeamName, err)
+		}
+		c.Status(http.StatusCreated)
+	})
+
+	w := serve(router, http.MethodPost, "/team/add", `{"team_name":"core","members":[]}`)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestValidation_UnknownRoutePassesThrough(t *testing.T) {
+	router := newRouter(t, true, func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	})
+
+	w := serve(router, http.MethodGet, "/health", "")
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestValidation_Responses(t *testing.T) {
+	tests := []struct {
+		name              string
+		validateResponses bool
+		body              gin.H
+		expectedStatus    int
+	}{
+		{"valid", true, gin.H{"team_name": "core"}, http.StatusOK},
+		{"invalid", true, gin.H{"name": "core"}, http.StatusInternalServerError},
+		{"invalid_not_checked", false, gin.H{"name": "core"}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newRouter(t, tt.validateResponses, func(c *gin.Context) {
+				c.JSON(http.StatusOK, tt.body)
+			})
+
+			w := serve(router, http.MethodGet, "/team/get?team_name=core", "")
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if tt.expectedStatus == http.StatusInternalServerError {
+				detail := decodeError(t, w)
+				if detail.Code != "INTERNAL_ERROR" || len(detail.Details) == 0 {
+					t.Errorf("expected INTERNAL_ERROR with details, got %+v", detail)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package notify_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/notify"
+)
+
+func sampleDigest() *entity.ReviewDigest {
+	now := time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC)
+	return &entity.ReviewDigest{
+		UserID:      "u1",
+		Username:    "Alice",
+		Email:       "alice@example.com",
+		GeneratedAt: now,
+		Items: []entity.DigestItem{
+			{PullRequestID: "pr-1", PullRequestName: "Old change", AuthorID: "u2", CreatedAt: now.Add(-50 * time.Hour), AgeSeconds: 50 * 3600},
+			{PullRequestID: "pr-2", PullRequestName: "Fresh change", AuthorID: "u3", CreatedAt: now.Add(-90 * time.Minute), AgeSeconds: 90 * 60},
+		},
+	}
+}
+
+func TestWebhookNotifier_Send(t *testing.T) {
+	var received entity.ReviewDigest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	if err := notify.NewWebhookNotifier(server.URL).Send(sampleDigest()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if received.UserID != "u1" || len(received.Items) != 2 || received.Items[0].PullRequestID != "pr-1" {
+		t.Fatalf("unexpected webhook payload: %+v", received)
+	}
+}
+
+func TestWebhookNotifier_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	err := notify.NewWebhookNotifier(server.URL).Send(sampleDigest())
+	if err == nil || !strings.Contains(err.Error(), "500") {
+		t.Fatalf("expected status error, got %v", err)
+	}
+}
+
+type smtpMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+func startSMTPServer(t *testing.T) (string, string, <-chan smtpMessage) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	messages := make(chan smtpMessage, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+		var msg smtpMessage
+		reply("220 localhost ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			command := strings.ToUpper(line)
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(command, "MAIL FROM:"):
+				msg.from = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+				reply("250 OK")
+			case strings.HasPrefix(command, "RCPT TO:"):
+				msg.to = append(msg.to, strings.Trim(line[len("RCPT TO:"):], "<> "))
+				reply("250 OK")
+			case command == "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				var data strings.Builder
+				for {
+					dataLine, err := reader.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if dataLine == ".\r\n" {
+						break
+					}
+					data.WriteString(dataLine)
+				}
+				msg.data = data.String()
+				reply("250 OK")
+			case command == "QUIT":
+				reply("221 Bye")
+				messages <- msg
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	return host, port, messages
+}
+
+func TestSMTPNotifier_Send(t *testing.T) {
+	host, port, messages := startSMTPServer(t)
+	notifier := notify.NewSMTPNotifier(config.SMTPConfig{Host: host, Port: port, From: "bot@example.com"})
+
+	if err := notifier.Send(sampleDigest()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case msg := <-messages:
+		if msg.from != "bot@example.com" {
+			t.Fatalf("unexpected sender %q", msg.from)
+		}
+		if len(msg.to) != 1 || msg.to[0] != "alice@example.com" {
+			t.Fatalf("unexpected recipients %v", msg.to)
+		}
+		if !strings.Contains(msg.data, "Subject: 2 pull requests awaiting your review") {
+			t.Fatalf("missing subject in message: %q", msg.data)
+		}
+		first := strings.Index(msg.data, "pr-1")
+		second := strings.Index(msg.data, "pr-2")
+		if first < 0 || second < 0 || first > second {
+			t.Fatalf("expected oldest PR first in message: %q", msg.data)
+		}
+		if !strings.Contains(msg.data, "open for 2d 2h") || !strings.Contains(msg.data, "open for 1h 30m") {
+			t.Fatalf("missing PR ages in message: %q", msg.data)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("SMTP server did not receive a message")
+	}
+}
+
+func TestSMTPNotifier_FailsWithoutEmail(t *testing.T) {
+	notifier := notify.NewSMTPNotifier(config.SMTPConfig{Host: "127.0.0.1", Port: "1"})
+	digest := sampleDigest()
+	digest.Email = ""
+
+	if err := notifier.Send(digest); !errors.Is(err, notify.ErrNoEmail) {
+		t.Fatalf("expected digest without email to fail with ErrNoEmail, got %v", err)
+	}
+}
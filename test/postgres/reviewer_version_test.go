@@ -0,0 +1,125 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"pr-review/internal/entity"
+	"pr-review/internal/repo"
+	"pr-review/internal/repo/postgres"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type affectingDB struct {
+	recordingDB
+}
+
+func (d *affectingDB) Begin(context.Context) (pgx.Tx, error) {
+	return &affectingTx{recordingTx: recordingTx{db: &d.recordingDB}}, nil
+}
+
+type affectingTx struct {
+	recordingTx
+}
+
+func (t *affectingTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	_, _ = t.recordingTx.Exec(ctx, sql, args...)
+	return pgconn.NewCommandTag("UPDATE 1"), nil
+}
+
+func bumpsVersion(queries []recordedQuery) bool {
+	for _, q := range queries {
+		if strings.HasPrefix(q.sql, "UPDATE pull_requests") && strings.Contains(q.sql, "version + 1") {
+			return true
+		}
+	}
+	return false
+}
+
+func TestReviewerChanges_BumpPullRequestVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(db postgres.DB)
+	}{
+		{"UpdateUserWithReassignments", func(db postgres.DB) {
+			_ = postgres.NewUserRepository(db, "org-a").UpdateUserWithReassignments(member, reassignments)
+		}},
+		{"DeleteUser", func(db postgres.DB) {
+			_ = postgres.NewUserRepository(db, "org-a").DeleteUser("u1", nil, now)
+		}},
+		{"CompleteHandover", func(db postgres.DB) {
+			_ = postgres.NewAvailabilityRepository(db, "org-a").CompleteHandover(1, now, reassignments)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &affectingDB{}
+			tt.call(db)
+			if !bumpsVersion(db.queries) {
+				t.Errorf("expected reviewer change to bump pull_requests.version, got %v", db.queries)
+			}
+		})
+	}
+}
+
+func TestPullRequestRepository_UpdatePRRequiresReadVersion(t *testing.T) {
+	db := &recordingDB{}
+	pr := &entity.PullRequest{ID: "p1", Name: "Add search", AuthorID: "u1", Status: entity.StatusMerged, Version: 4}
+	_, _ = postgres.NewPullRequestRepository(db, "org-a").UpdatePR(pr)
+
+	if len(db.queries) == 0 || !strings.HasPrefix(db.queries[0].sql, "UPDATE pull_requests") {
+		t.Fatalf("expected UpdatePR to start with UPDATE pull_requests, got %v", db.queries)
+	}
+	update := db.queries[0]
+	if !strings.Contains(update.sql, "version = $") {
+		t.Errorf("expected UPDATE to be guarded by the read version, got %s", update.sql)
+	}
+	found := false
+	for _, arg := range update.args {
+		if arg == 4 {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected read version 4 among args, got %v", update.args)
+	}
+}
+
+func TestReviewerChanges_FailWhenAssignmentIsStale(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(db postgres.DB) error
+	}{
+		{"UpdateUserWithReassignments", func(db postgres.DB) error {
+			return postgres.NewUserRepository(db, "org-a").UpdateUserWithReassignments(member, reassignments)
+		}},
+		{"DeleteUser", func(db postgres.DB) error {
+			return postgres.NewUserRepository(db, "org-a").DeleteUser("u1", reassignments, now)
+		}},
+		{"CompleteHandover", func(db postgres.DB) error {
+			return postgres.NewAvailabilityRepository(db, "org-a").CompleteHandover(1, now, reassignments)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &recordingDB{}
+			if err := tt.call(db); !errors.Is(err, repo.ErrReviewerChanged) {
+				t.Fatalf("expected ErrReviewerChanged when no reviewer row matches, got %v", err)
+			}
+			if bumpsVersion(db.queries) {
+				t.Errorf("expected no version bump for a stale reassignment, got %v", db.queries)
+			}
+			for _, q := range db.queries {
+				if strings.HasPrefix(q.sql, "UPDATE assigned_reviewers") && (!strings.Contains(q.sql, "is_active") || !strings.Contains(q.sql, "pr.status")) {
+					t.Errorf("expected the replacement to re-check the PR and the new reviewer, got %s", q.sql)
+				}
+			}
+		})
+	}
+}
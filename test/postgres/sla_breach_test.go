@@ -0,0 +1,102 @@
+package postgres_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-review/internal/entity"
+	"pr-review/internal/repo/postgres"
+	"pr-review/internal/service"
+)
+
+func TestSLARepository_ApprovalsExcludedFromReviewBreaches(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(r *postgres.SLARepository)
+		verb string
+	}{
+		{"FindReviewBreaches", func(r *postgres.SLARepository) { _, _ = r.FindReviewBreaches("backend", now) }, "SELECT"},
+		{"ResolveBreaches", func(r *postgres.SLARepository) { _ = r.ResolveBreaches(now) }, "UPDATE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &recordingDB{}
+			tt.call(postgres.NewSLARepository(db, "org-a"))
+			if len(db.queries) != 1 || !strings.HasPrefix(db.queries[0].sql, tt.verb) {
+				t.Fatalf("expected a single %s, got %v", tt.verb, db.queries)
+			}
+			if !strings.Contains(db.queries[0].sql, "pull_request_approvals") {
+				t.Errorf("expected query to consult pull_request_approvals, got %s", db.queries[0].sql)
+			}
+		})
+	}
+}
+
+func TestSLAService_ApprovedReviewerNotBreachedOrReassigned(t *testing.T) {
+	pool := connectTestDB(t)
+
+	org := fmt.Sprintf("sla-%d", time.Now().UnixNano())
+	createOrganizations(t, pool, org)
+
+	teams := postgres.NewTeamRepository(pool, org)
+	users := postgres.NewUserRepository(pool, org)
+	prs := postgres.NewPullRequestRepository(pool, org)
+	slas := postgres.NewSLARepository(pool, org)
+
+	members := make([]entity.User, 0)
+	for _, id := range []string{"u1", "u2", "u3", "u4"} {
+		members = append(members, entity.User{ID: id, Name: id, Team: "backend", IsActive: true})
+	}
+	if err := teams.CreateTeam(&entity.Team{Name: "backend", Members: members}); err != nil {
+		t.Fatalf("failed to create team: %v", err)
+	}
+	pr := &entity.PullRequest{ID: "pr-1", Name: "Add search", AuthorID: "u1", Status: entity.StatusOpen, AssignedReviewers: []string{"u2", "u3"}}
+	if err := prs.CreatePR(pr); err != nil {
+		t.Fatalf("failed to create pull request: %v", err)
+	}
+	if err := slas.SaveSettings(&entity.SLASettings{TeamName: "backend", FirstReviewMinutes: 60, AutoReassign: true}); err != nil {
+		t.Fatalf("failed to save SLA settings: %v", err)
+	}
+
+	earlier := &entity.SLABreach{Kind: entity.SLABreachFirstReview, PullRequestID: "pr-1", ReviewerID: "u2", TeamName: "backend", StartedAt: time.Now(), Deadline: time.Now(), DetectedAt: time.Now()}
+	if err := slas.RecordBreach(earlier); err != nil {
+		t.Fatalf("failed to record breach: %v", err)
+	}
+	if err := prs.AddApproval("pr-1", "u2"); err != nil {
+		t.Fatalf("failed to approve: %v", err)
+	}
+
+	prService := service.NewPullRequestService(prs, users, teams,
+		postgres.NewAvailabilityRepository(pool, org), postgres.NewAffinityRepository(pool, org),
+		postgres.NewRepositoryRepository(pool, org), postgres.NewExpertiseRepository(pool, org))
+	slaService := service.NewSLAService(slas, teams, prService, service.LogEscalationPublisher{})
+
+	detected, err := slaService.CheckBreaches(time.Now().Add(24 * time.Hour))
+	if err != nil {
+		t.Fatalf("failed to check breaches: %v", err)
+	}
+	if len(detected) != 1 || detected[0].ReviewerID != "u3" || detected[0].ReassignedTo != "u4" {
+		t.Fatalf("expected only u3 to breach and be replaced by u4, got %+v", detected)
+	}
+
+	got, err := prs.GetPR("pr-1")
+	if err != nil || got == nil || !strings.Contains(strings.Join(got.AssignedReviewers, ","), "u2") {
+		t.Fatalf("expected approved u2 to stay assigned, got %+v (%v)", got, err)
+	}
+	if approvals, err := prs.CountApprovals("pr-1"); err != nil || approvals != 1 {
+		t.Errorf("expected u2's approval to still count, got %d (%v)", approvals, err)
+	}
+
+	breaches, err := slas.ListBreaches(entity.SLABreachFilter{})
+	if err != nil {
+		t.Fatalf("failed to list breaches: %v", err)
+	}
+	for _, breach := range breaches {
+		if breach.ReviewerID == "u2" && (breach.ResolvedAt == nil || breach.ReassignedTo != "") {
+			t.Errorf("expected u2's earlier breach to be resolved by the approval, got %+v", breach)
+		}
+	}
+}
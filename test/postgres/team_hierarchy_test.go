@@ -0,0 +1,17 @@
+package postgres_test
+
+import (
+	"strings"
+	"testing"
+
+	"pr-review/internal/repo/postgres"
+)
+
+func TestTeamRepository_GetTeamAncestorsSingleQuery(t *testing.T) {
+	db := &recordingDB{}
+	_, _ = postgres.NewTeamRepository(db, "org-a").GetTeamAncestors("backend", 10)
+
+	if len(db.queries) != 1 || !strings.HasPrefix(db.queries[0].sql, "WITH RECURSIVE") {
+		t.Fatalf("expected a single recursive query, got %v", db.queries)
+	}
+}
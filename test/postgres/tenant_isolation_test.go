@@ -0,0 +1,168 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-review/internal/entity"
+	"pr-review/internal/repo/postgres"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var tenantTables = []string{
+	"teams", "users", "pull_requests", "assigned_reviewers", "user_availability",
+	"user_schedules", "team_sla", "sla_breaches", "digest_preferences", "team_fallbacks",
+	"team_shared_reviewers", "team_policies", "pull_request_approvals", "reviewer_affinities",
+	"repositories", "pull_request_files", "pull_request_labels", "reviewer_expertise",
+}
+
+var surrogateKeyTables = map[string]bool{"user_availability": true, "sla_breaches": true}
+
+func connectTestDB(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	pool, err := pgxpool.New(context.Background(), dsn)
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func createOrganizations(t *testing.T, pool *pgxpool.Pool, ids ...string) {
+	t.Helper()
+	ctx := context.Background()
+	for _, id := range ids {
+		if _, err := pool.Exec(ctx, "INSERT INTO organizations (org_id, name) VALUES ($1, $1)", id); err != nil {
+			t.Fatalf("failed to create organization %s: %v", id, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		for _, id := range ids {
+			if _, err := pool.Exec(ctx, "DELETE FROM pull_requests WHERE org_id = $1", id); err != nil {
+				t.Errorf("failed to clean up pull requests of %s: %v", id, err)
+			}
+			if _, err := pool.Exec(ctx, "DELETE FROM organizations WHERE org_id = $1", id); err != nil {
+				t.Errorf("failed to clean up organization %s: %v", id, err)
+			}
+		}
+	})
+}
+
+func TestMigrations_TenantSchema(t *testing.T) {
+	pool := connectTestDB(t)
+	ctx := context.Background()
+
+	for _, table := range tenantTables {
+		t.Run(table, func(t *testing.T) {
+			var nullable string
+			var columnDefault *string
+			err := pool.QueryRow(ctx, `
+				SELECT is_nullable, column_default FROM information_schema.columns
+				WHERE table_schema = current_schema() AND table_name = $1 AND column_name = 'org_id'`,
+				table).Scan(&nullable, &columnDefault)
+			if err != nil {
+				t.Fatalf("expected org_id column: %v", err)
+			}
+			if nullable != "NO" || columnDefault != nil {
+				t.Errorf("expected org_id NOT NULL without a default, got nullable=%s default=%v", nullable, columnDefault)
+			}
+
+			var references bool
+			err = pool.QueryRow(ctx, `
+				SELECT EXISTS (
+					SELECT 1 FROM pg_constraint
+					WHERE conrelid = $1::regclass AND contype = 'f' AND confrelid = 'organizations'::regclass)`,
+				table).Scan(&references)
+			if err != nil {
+				t.Fatalf("failed to read foreign keys: %v", err)
+			}
+			if !references {
+				t.Errorf("expected a foreign key to organizations")
+			}
+
+			if surrogateKeyTables[table] {
+				return
+			}
+			var primaryKey string
+			err = pool.QueryRow(ctx, `
+				SELECT pg_get_constraintdef(oid) FROM pg_constraint
+				WHERE conrelid = $1::regclass AND contype = 'p'`,
+				table).Scan(&primaryKey)
+			if err != nil {
+				t.Fatalf("expected a primary key: %v", err)
+			}
+			if !strings.HasPrefix(primaryKey, "PRIMARY KEY (org_id, ") {
+				t.Errorf("expected primary key to start with org_id, got %s", primaryKey)
+			}
+		})
+	}
+}
+
+func TestRepositories_SameKeysIsolatedBetweenOrganizations(t *testing.T) {
+	pool := connectTestDB(t)
+
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	orgs := []string{"it-a-" + suffix, "it-b-" + suffix}
+	createOrganizations(t, pool, orgs...)
+
+	for _, org := range orgs {
+		if err := postgres.NewTeamRepository(pool, org).CreateTeam(&entity.Team{Name: "backend"}); err != nil {
+			t.Fatalf("%s: failed to create team: %v", org, err)
+		}
+		users := postgres.NewUserRepository(pool, org)
+		for _, id := range []string{"u1", "u2"} {
+			if err := users.CreateOrUpdateUser(&entity.User{ID: id, Name: id + "@" + org, Team: "backend", IsActive: true}); err != nil {
+				t.Fatalf("%s: failed to create user %s: %v", org, id, err)
+			}
+		}
+		pr := &entity.PullRequest{ID: "pr-1", Name: "pr@" + org, AuthorID: "u1", Status: entity.StatusOpen, AssignedReviewers: []string{"u2"}}
+		if err := postgres.NewPullRequestRepository(pool, org).CreatePR(pr); err != nil {
+			t.Fatalf("%s: failed to create pull request: %v", org, err)
+		}
+	}
+
+	for _, org := range orgs {
+		user, err := postgres.NewUserRepository(pool, org).GetUser("u1")
+		if err != nil || user == nil || user.Name != "u1@"+org {
+			t.Errorf("%s: expected own u1, got %+v (%v)", org, user, err)
+		}
+		pr, err := postgres.NewPullRequestRepository(pool, org).GetPR("pr-1")
+		if err != nil || pr == nil || pr.Name != "pr@"+org {
+			t.Errorf("%s: expected own pr-1, got %+v (%v)", org, pr, err)
+		}
+	}
+
+	a, b := orgs[0], orgs[1]
+	if err := postgres.NewUserRepository(pool, a).DeleteUser("u2", nil, time.Now()); err != nil {
+		t.Fatalf("failed to delete u2 in %s: %v", a, err)
+	}
+
+	deleted, err := postgres.NewUserRepository(pool, a).GetUser("u2")
+	if err != nil || deleted != nil {
+		t.Errorf("%s: expected u2 to be deleted, got %+v (%v)", a, deleted, err)
+	}
+	kept, err := postgres.NewUserRepository(pool, b).GetUser("u2")
+	if err != nil || kept == nil || kept.Name != "u2@"+b {
+		t.Errorf("%s: expected u2 to survive deletion in %s, got %+v (%v)", b, a, kept, err)
+	}
+
+	prA, err := postgres.NewPullRequestRepository(pool, a).GetPR("pr-1")
+	if err != nil || prA == nil || len(prA.AssignedReviewers) != 0 || prA.Version != 2 {
+		t.Errorf("%s: expected pr-1 without reviewers at version 2, got %+v (%v)", a, prA, err)
+	}
+	prB, err := postgres.NewPullRequestRepository(pool, b).GetPR("pr-1")
+	if err != nil || prB == nil || strings.Join(prB.AssignedReviewers, ",") != "u2" || prB.Version != 1 {
+		t.Errorf("%s: expected pr-1 untouched with reviewer u2 at version 1, got %+v (%v)", b, prB, err)
+	}
+}
@@ -0,0 +1,388 @@
+package postgres_test
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-review/internal/entity"
+	"pr-review/internal/repo"
+	"pr-review/internal/repo/postgres"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type recordedQuery struct {
+	sql  string
+	args []any
+}
+
+type recordingDB struct {
+	queries []recordedQuery
+}
+
+func (d *recordingDB) record(sql string, args []any) {
+	d.queries = append(d.queries, recordedQuery{sql: sql, args: args})
+}
+
+func (d *recordingDB) Begin(context.Context) (pgx.Tx, error) {
+	return &recordingTx{db: d}, nil
+}
+
+func (d *recordingDB) Exec(_ context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	d.record(sql, args)
+	return pgconn.CommandTag{}, nil
+}
+
+func (d *recordingDB) Query(_ context.Context, sql string, args ...any) (pgx.Rows, error) {
+	d.record(sql, args)
+	return &emptyRows{}, nil
+}
+
+func (d *recordingDB) QueryRow(_ context.Context, sql string, args ...any) pgx.Row {
+	d.record(sql, args)
+	return zeroRow{}
+}
+
+type recordingTx struct {
+	pgx.Tx
+	db *recordingDB
+}
+
+func (t *recordingTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return t.db.Exec(ctx, sql, args...)
+}
+
+func (t *recordingTx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return t.db.Query(ctx, sql, args...)
+}
+
+func (t *recordingTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return t.db.QueryRow(ctx, sql, args...)
+}
+
+func (t *recordingTx) Commit(context.Context) error   { return nil }
+func (t *recordingTx) Rollback(context.Context) error { return nil }
+
+type emptyRows struct {
+	pgx.Rows
+}
+
+func (r *emptyRows) Close()            {}
+func (r *emptyRows) Err() error        { return nil }
+func (r *emptyRows) Next() bool        { return false }
+func (r *emptyRows) Scan(...any) error { return nil }
+
+type zeroRow struct{}
+
+func (zeroRow) Scan(...any) error { return nil }
+
+var tableRef = regexp.MustCompile(`(?i)\b(FROM|JOIN|INTO|UPDATE)\s+(\w+)`)
+
+func assertScoped(t *testing.T, method string, queries []recordedQuery, orgID, otherOrgID string) {
+	t.Helper()
+	if len(queries) == 0 {
+		t.Errorf("%s: expected at least one query", method)
+		return
+	}
+
+	for _, q := range queries {
+		refs := 0
+		for _, m := range tableRef.FindAllStringSubmatch(q.sql, -1) {
+			if !strings.EqualFold(m[2], "EXCLUDED") {
+				refs++
+			}
+		}
+		if scoped := strings.Count(q.sql, "org_id"); scoped < refs {
+			t.Errorf("%s: %d table references but only %d org_id predicates in %q", method, refs, scoped, q.sql)
+		}
+
+		hasOrg := false
+		for _, arg := range q.args {
+			if arg == orgID {
+				hasOrg = true
+			}
+			if arg == otherOrgID {
+				t.Errorf("%s: query bound to foreign organization %q: %q", method, otherOrgID, q.sql)
+			}
+		}
+		if !hasOrg {
+			t.Errorf("%s: query not bound to organization %q: %q %v", method, orgID, q.sql, q.args)
+		}
+	}
+}
+
+func runScoped(t *testing.T, iface any, calls map[string]func(db postgres.DB, orgID string)) {
+	t.Helper()
+
+	ifaceType := reflect.TypeOf(iface).Elem()
+	for i := 0; i < ifaceType.NumMethod(); i++ {
+		if _, ok := calls[ifaceType.Method(i).Name]; !ok {
+			t.Errorf("%s.%s is not covered by the tenant scope test", ifaceType.Name(), ifaceType.Method(i).Name)
+		}
+	}
+
+	for method, call := range calls {
+		db := &recordingDB{}
+		call(db, "org-a")
+		assertScoped(t, ifaceType.Name()+"."+method, db.queries, "org-a", "org-b")
+	}
+}
+
+var (
+	now           = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	reassignments = []entity.ReviewerReassignment{{PullRequestID: "p1", OldReviewerID: "u2", NewReviewerID: "u3"}}
+	member        = &entity.User{ID: "u1", Name: "Alice", Team: "t1", IsActive: true}
+	pullRequest   = &entity.PullRequest{
+		ID:                "p1",
+		Name:              "Add feature",
+		AuthorID:          "u1",
+		Status:            entity.StatusOpen,
+		AssignedReviewers: []string{"u2"},
+		ChangedFiles:      []string{"main.go"},
+		Labels:            []string{"db"},
+	}
+)
+
+func TestTeamRepository_TenantScoped(t *testing.T) {
+	runScoped(t, (*repo.TeamRepository)(nil), map[string]func(postgres.DB, string){
+		"CreateTeam": func(db postgres.DB, org string) {
+			_ = postgres.NewTeamRepository(db, org).CreateTeam(&entity.Team{Name: "t1", Members: []entity.User{*member}, FallbackTeams: []string{"t2"}, SharedReviewers: []string{"u2"}})
+		},
+		"GetTeam": func(db postgres.DB, org string) { _, _ = postgres.NewTeamRepository(db, org).GetTeam("t1") },
+		"TeamExists": func(db postgres.DB, org string) {
+			_, _ = postgres.NewTeamRepository(db, org).TeamExists("t1")
+		},
+		"SetReviewerPools": func(db postgres.DB, org string) {
+			_ = postgres.NewTeamRepository(db, org).SetReviewerPools("t1", []string{"t2"}, []string{"u2"})
+		},
+		"GetPolicy": func(db postgres.DB, org string) { _, _ = postgres.NewTeamRepository(db, org).GetPolicy("t1") },
+		"SavePolicy": func(db postgres.DB, org string) {
+			_ = postgres.NewTeamRepository(db, org).SavePolicy(&entity.TeamPolicy{TeamName: "t1"})
+		},
+		"SetParentTeam": func(db postgres.DB, org string) {
+			_ = postgres.NewTeamRepository(db, org).SetParentTeam("t1", "t0")
+		},
+		"GetSubTeams": func(db postgres.DB, org string) {
+			_, _ = postgres.NewTeamRepository(db, org).GetSubTeams("t1")
+		},
+		"GetTeamAncestors": func(db postgres.DB, org string) {
+			_, _ = postgres.NewTeamRepository(db, org).GetTeamAncestors("t1", 10)
+		},
+		"GetTeamStats": func(db postgres.DB, org string) {
+			_, _ = postgres.NewTeamRepository(db, org).GetTeamStats([]string{"t1"})
+		},
+	})
+}
+
+func TestUserRepository_TenantScoped(t *testing.T) {
+	runScoped(t, (*repo.UserRepository)(nil), map[string]func(postgres.DB, string){
+		"GetUser": func(db postgres.DB, org string) { _, _ = postgres.NewUserRepository(db, org).GetUser("u1") },
+		"GetUserIncludingDeleted": func(db postgres.DB, org string) {
+			_, _ = postgres.NewUserRepository(db, org).GetUserIncludingDeleted("u1")
+		},
+		"CreateOrUpdateUser": func(db postgres.DB, org string) {
+			_ = postgres.NewUserRepository(db, org).CreateOrUpdateUser(member)
+		},
+		"UpdateUser": func(db postgres.DB, org string) { _ = postgres.NewUserRepository(db, org).UpdateUser(member) },
+		"UpdateUserWithReassignments": func(db postgres.DB, org string) {
+			_ = postgres.NewUserRepository(db, org).UpdateUserWithReassignments(member, reassignments)
+		},
+		"GetUsersByTeam": func(db postgres.DB, org string) {
+			_, _ = postgres.NewUserRepository(db, org).GetUsersByTeam("t1")
+		},
+		"GetActiveUsersByTeam": func(db postgres.DB, org string) {
+			_, _ = postgres.NewUserRepository(db, org).GetActiveUsersByTeam("t1")
+		},
+		"SetWorkSchedule": func(db postgres.DB, org string) {
+			_ = postgres.NewUserRepository(db, org).SetWorkSchedule("u1", &entity.WorkSchedule{TimeZone: "UTC", Start: "09:00", End: "18:00"})
+		},
+		"GetWorkSchedules": func(db postgres.DB, org string) {
+			_, _ = postgres.NewUserRepository(db, org).GetWorkSchedules([]string{"u1"})
+		},
+		"ListUsers": func(db postgres.DB, org string) {
+			active := true
+			_, _ = postgres.NewUserRepository(db, org).ListUsers(entity.UserFilter{TeamName: "t1", IsActive: &active, UsernamePrefix: "al", Limit: 10})
+		},
+		"UpdateUsername": func(db postgres.DB, org string) {
+			_ = postgres.NewUserRepository(db, org).UpdateUsername("u1", "Alice")
+		},
+		"GetDeletedUserIDs": func(db postgres.DB, org string) {
+			_, _ = postgres.NewUserRepository(db, org).GetDeletedUserIDs([]string{"u1"})
+		},
+		"DeleteUser": func(db postgres.DB, org string) {
+			_ = postgres.NewUserRepository(db, org).DeleteUser("u1", reassignments, now)
+		},
+	})
+}
+
+func TestPullRequestRepository_TenantScoped(t *testing.T) {
+	runScoped(t, (*repo.PullRequestRepository)(nil), map[string]func(postgres.DB, string){
+		"CreatePR": func(db postgres.DB, org string) {
+			_ = postgres.NewPullRequestRepository(db, org).CreatePR(pullRequest)
+		},
+		"GetPR": func(db postgres.DB, org string) { _, _ = postgres.NewPullRequestRepository(db, org).GetPR("p1") },
+		"UpdatePR": func(db postgres.DB, org string) {
+			_, _ = postgres.NewPullRequestRepository(db, org).UpdatePR(pullRequest)
+		},
+		"UpdatePRMetadata": func(db postgres.DB, org string) {
+			_, _ = postgres.NewPullRequestRepository(db, org).UpdatePRMetadata(pullRequest, 1)
+		},
+		"PRExists": func(db postgres.DB, org string) {
+			_, _ = postgres.NewPullRequestRepository(db, org).PRExists("p1")
+		},
+		"GetPRsByReviewer": func(db postgres.DB, org string) {
+			_, _ = postgres.NewPullRequestRepository(db, org).GetPRsByReviewer("u2")
+		},
+		"GetReviewersWithOpenPRs": func(db postgres.DB, org string) {
+			_, _ = postgres.NewPullRequestRepository(db, org).GetReviewersWithOpenPRs()
+		},
+		"AddApproval": func(db postgres.DB, org string) {
+			_ = postgres.NewPullRequestRepository(db, org).AddApproval("p1", "u2")
+		},
+		"CountApprovals": func(db postgres.DB, org string) {
+			_, _ = postgres.NewPullRequestRepository(db, org).CountApprovals("p1")
+		},
+		"CountOpenReviews": func(db postgres.DB, org string) {
+			_, _ = postgres.NewPullRequestRepository(db, org).CountOpenReviews([]string{"u2"})
+		},
+	})
+}
+
+func TestAvailabilityRepository_TenantScoped(t *testing.T) {
+	runScoped(t, (*repo.AvailabilityRepository)(nil), map[string]func(postgres.DB, string){
+		"CreateAwayPeriod": func(db postgres.DB, org string) {
+			_ = postgres.NewAvailabilityRepository(db, org).CreateAwayPeriod(&entity.AwayPeriod{UserID: "u1", From: now, Until: now.Add(time.Hour)})
+		},
+		"GetAwayUserIDs": func(db postgres.DB, org string) {
+			_, _ = postgres.NewAvailabilityRepository(db, org).GetAwayUserIDs(now)
+		},
+		"GetPendingHandovers": func(db postgres.DB, org string) {
+			_, _ = postgres.NewAvailabilityRepository(db, org).GetPendingHandovers(now)
+		},
+		"CompleteHandover": func(db postgres.DB, org string) {
+			_ = postgres.NewAvailabilityRepository(db, org).CompleteHandover(1, now, reassignments)
+		},
+		"GetEndedPeriods": func(db postgres.DB, org string) {
+			_, _ = postgres.NewAvailabilityRepository(db, org).GetEndedPeriods(now)
+		},
+		"MarkReturned": func(db postgres.DB, org string) {
+			_ = postgres.NewAvailabilityRepository(db, org).MarkReturned(1, now)
+		},
+	})
+}
+
+func TestSLARepository_TenantScoped(t *testing.T) {
+	runScoped(t, (*repo.SLARepository)(nil), map[string]func(postgres.DB, string){
+		"GetSettings": func(db postgres.DB, org string) { _, _ = postgres.NewSLARepository(db, org).GetSettings("t1") },
+		"SaveSettings": func(db postgres.DB, org string) {
+			_ = postgres.NewSLARepository(db, org).SaveSettings(&entity.SLASettings{TeamName: "t1"})
+		},
+		"ListSettings": func(db postgres.DB, org string) { _, _ = postgres.NewSLARepository(db, org).ListSettings() },
+		"FindReviewBreaches": func(db postgres.DB, org string) {
+			_, _ = postgres.NewSLARepository(db, org).FindReviewBreaches("t1", now)
+		},
+		"FindMergeBreaches": func(db postgres.DB, org string) {
+			_, _ = postgres.NewSLARepository(db, org).FindMergeBreaches("t1", now)
+		},
+		"RecordBreach": func(db postgres.DB, org string) {
+			_ = postgres.NewSLARepository(db, org).RecordBreach(&entity.SLABreach{Kind: entity.SLABreachMerge, PullRequestID: "p1", TeamName: "t1"})
+		},
+		"MarkReassigned": func(db postgres.DB, org string) {
+			_ = postgres.NewSLARepository(db, org).MarkReassigned(1, "u3")
+		},
+		"ResolveBreaches": func(db postgres.DB, org string) { _ = postgres.NewSLARepository(db, org).ResolveBreaches(now) },
+		"ListBreaches": func(db postgres.DB, org string) {
+			_, _ = postgres.NewSLARepository(db, org).ListBreaches(entity.SLABreachFilter{TeamName: "t1", OpenOnly: true})
+		},
+	})
+}
+
+func TestDigestRepository_TenantScoped(t *testing.T) {
+	runScoped(t, (*repo.DigestRepository)(nil), map[string]func(postgres.DB, string){
+		"GetPreferences": func(db postgres.DB, org string) {
+			_, _ = postgres.NewDigestRepository(db, org).GetPreferences("u1")
+		},
+		"SavePreferences": func(db postgres.DB, org string) {
+			_ = postgres.NewDigestRepository(db, org).SavePreferences(&entity.DigestPreferences{UserID: "u1", Enabled: true})
+		},
+		"MarkDigestSent": func(db postgres.DB, org string) {
+			_ = postgres.NewDigestRepository(db, org).MarkDigestSent("u1", now)
+		},
+	})
+}
+
+func TestAffinityRepository_TenantScoped(t *testing.T) {
+	runScoped(t, (*repo.AffinityRepository)(nil), map[string]func(postgres.DB, string){
+		"ListAffinities": func(db postgres.DB, org string) {
+			_, _ = postgres.NewAffinityRepository(db, org).ListAffinities("u1")
+		},
+		"SaveAffinity": func(db postgres.DB, org string) {
+			_ = postgres.NewAffinityRepository(db, org).SaveAffinity(&entity.ReviewerAffinity{AuthorID: "u1", ReviewerID: "u2", Weight: 2})
+		},
+		"DeleteAffinity": func(db postgres.DB, org string) {
+			_, _ = postgres.NewAffinityRepository(db, org).DeleteAffinity("u1", "u2")
+		},
+	})
+}
+
+func TestRepositoryRepository_TenantScoped(t *testing.T) {
+	runScoped(t, (*repo.RepositoryRepository)(nil), map[string]func(postgres.DB, string){
+		"SaveRepository": func(db postgres.DB, org string) {
+			_ = postgres.NewRepositoryRepository(db, org).SaveRepository(&entity.Repository{Name: "api", TeamName: "t1"})
+		},
+		"GetRepository": func(db postgres.DB, org string) {
+			_, _ = postgres.NewRepositoryRepository(db, org).GetRepository("api")
+		},
+		"SetCodeOwners": func(db postgres.DB, org string) {
+			_ = postgres.NewRepositoryRepository(db, org).SetCodeOwners("api", "* @u1")
+		},
+	})
+}
+
+func TestExpertiseRepository_TenantScoped(t *testing.T) {
+	runScoped(t, (*repo.ExpertiseRepository)(nil), map[string]func(postgres.DB, string){
+		"RecordReview": func(db postgres.DB, org string) {
+			_ = postgres.NewExpertiseRepository(db, org).RecordReview("u1", []string{"label:db"}, now)
+		},
+		"GetScores": func(db postgres.DB, org string) {
+			_, _ = postgres.NewExpertiseRepository(db, org).GetScores([]string{"u1"}, []string{"label:db"})
+		},
+		"GetUserExpertise": func(db postgres.DB, org string) {
+			_, _ = postgres.NewExpertiseRepository(db, org).GetUserExpertise("u1")
+		},
+	})
+}
+
+func TestBulkRepository_TenantScoped(t *testing.T) {
+	runScoped(t, (*repo.BulkRepository)(nil), map[string]func(postgres.DB, string){
+		"Import": func(db postgres.DB, org string) {
+			_ = postgres.NewBulkRepository(db, org).Import(&entity.BulkData{
+				Teams:        []entity.BulkTeam{{Name: "t1"}},
+				Users:        []entity.User{*member},
+				PullRequests: []entity.BulkPullRequest{{ID: "p1", Name: "Add feature", AuthorID: "u1", Status: entity.StatusOpen, AssignedReviewers: []string{"u2"}}},
+			})
+		},
+		"Export": func(db postgres.DB, org string) { _, _ = postgres.NewBulkRepository(db, org).Export() },
+	})
+}
+
+func TestTenantScope_SameKeysDifferentOrganizations(t *testing.T) {
+	dbA, dbB := &recordingDB{}, &recordingDB{}
+	_, _ = postgres.NewUserRepository(dbA, "org-a").GetUser("u1")
+	_, _ = postgres.NewUserRepository(dbB, "org-b").GetUser("u1")
+
+	if len(dbA.queries) != 1 || len(dbB.queries) != 1 {
+		t.Fatalf("expected one query per organization, got %d and %d", len(dbA.queries), len(dbB.queries))
+	}
+	if dbA.queries[0].sql != dbB.queries[0].sql {
+		t.Fatalf("expected identical statements, got %q and %q", dbA.queries[0].sql, dbB.queries[0].sql)
+	}
+	if reflect.DeepEqual(dbA.queries[0].args, dbB.queries[0].args) {
+		t.Fatalf("expected organization to be bound as an argument, got %v for both", dbA.queries[0].args)
+	}
+}
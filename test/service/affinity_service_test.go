@@ -0,0 +1,163 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+
+	"pr-review/internal/entity"
+	"pr-review/internal/service"
+)
+
+type mockAffinityRepo struct {
+	ListAffinitiesFn func(string) ([]*entity.ReviewerAffinity, error)
+	SaveAffinityFn   func(*entity.ReviewerAffinity) error
+	DeleteAffinityFn func(string, string) (bool, error)
+}
+
+func (m *mockAffinityRepo) ListAffinities(authorID string) ([]*entity.ReviewerAffinity, error) {
+	if m.ListAffinitiesFn != nil {
+		return m.ListAffinitiesFn(authorID)
+	}
+	return nil, nil
+}
+func (m *mockAffinityRepo) SaveAffinity(affinity *entity.ReviewerAffinity) error {
+	if m.SaveAffinityFn != nil {
+		return m.SaveAffinityFn(affinity)
+	}
+	return nil
+}
+func (m *mockAffinityRepo) DeleteAffinity(authorID, reviewerID string) (bool, error) {
+	if m.DeleteAffinityFn != nil {
+		return m.DeleteAffinityFn(authorID, reviewerID)
+	}
+	return false, nil
+}
+
+func TestAffinityService_SetAffinity(t *testing.T) {
+	userRepo := &mockUserRepo{GetUserFn: func(id string) (*entity.User, error) {
+		if id == "ghost" {
+			return nil, nil
+		}
+		return &entity.User{ID: id}, nil
+	}}
+
+	tests := []struct {
+		name     string
+		affinity *entity.ReviewerAffinity
+		wantErr  bool
+		errMsg   string
+	}{
+		{name: "empty_ids", affinity: &entity.ReviewerAffinity{AuthorID: "a1"}, wantErr: true, errMsg: "cannot be empty"},
+		{name: "same_user", affinity: &entity.ReviewerAffinity{AuthorID: "a1", ReviewerID: "a1", Blocked: true}, wantErr: true, errMsg: "must differ"},
+		{name: "blocked_with_weight", affinity: &entity.ReviewerAffinity{AuthorID: "a1", ReviewerID: "r1", Blocked: true, Weight: 3}, wantErr: true, errMsg: "blocked pairs cannot have a weight"},
+		{name: "zero_weight", affinity: &entity.ReviewerAffinity{AuthorID: "a1", ReviewerID: "r1"}, wantErr: true, errMsg: "weight must be between 1 and 10"},
+		{name: "weight_too_high", affinity: &entity.ReviewerAffinity{AuthorID: "a1", ReviewerID: "r1", Weight: 11}, wantErr: true, errMsg: "weight must be between 1 and 10"},
+		{name: "reviewer_not_found", affinity: &entity.ReviewerAffinity{AuthorID: "a1", ReviewerID: "ghost", Blocked: true}, wantErr: true, errMsg: "reviewer not found"},
+		{name: "block", affinity: &entity.ReviewerAffinity{AuthorID: "a1", ReviewerID: "r1", Blocked: true, Reason: "co-author"}},
+		{name: "prefer", affinity: &entity.ReviewerAffinity{AuthorID: "a1", ReviewerID: "r1", Weight: 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *entity.ReviewerAffinity
+			affinityRepo := &mockAffinityRepo{SaveAffinityFn: func(a *entity.ReviewerAffinity) error {
+				saved = a
+				return nil
+			}}
+			svc := service.NewAffinityService(affinityRepo, userRepo)
+
+			_, err := svc.SetAffinity(tt.affinity)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("expected error %q, got %v", tt.errMsg, err)
+				}
+				if saved != nil {
+					t.Fatalf("affinity must not be saved on error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if saved != tt.affinity {
+				t.Fatalf("expected affinity to be saved, got %+v", saved)
+			}
+		})
+	}
+}
+
+func TestAffinityService_DeleteAffinity(t *testing.T) {
+	svc := service.NewAffinityService(&mockAffinityRepo{}, &mockUserRepo{})
+	if err := svc.DeleteAffinity("a1", "r1"); err == nil || !strings.Contains(err.Error(), "affinity not found") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	svc = service.NewAffinityService(&mockAffinityRepo{DeleteAffinityFn: func(string, string) (bool, error) { return true, nil }}, &mockUserRepo{})
+	if err := svc.DeleteAffinity("a1", "r1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPullRequestService_Affinities(t *testing.T) {
+	affinities := []*entity.ReviewerAffinity{
+		{AuthorID: "a1", ReviewerID: "r1", Blocked: true},
+		{AuthorID: "a1", ReviewerID: "r2", Weight: 10},
+	}
+	affinityRepo := &mockAffinityRepo{ListAffinitiesFn: func(authorID string) ([]*entity.ReviewerAffinity, error) {
+		if authorID != "a1" {
+			return nil, nil
+		}
+		return affinities, nil
+	}}
+	userRepo := &mockUserRepo{
+		GetUserFn:              func(id string) (*entity.User, error) { return &entity.User{ID: id, Team: "team1", IsActive: true}, nil },
+		GetActiveUsersByTeamFn: func(string) ([]*entity.User, error) { return makeMembers("a1", "r1", "r2", "r3", "r4"), nil },
+	}
+	teamRepo := &mockTeamRepo{GetTeamFn: func(string) (*entity.Team, error) { return &entity.Team{Name: "team1"}, nil }}
+
+	t.Run("blocked_never_selected_and_affinity_preferred", func(t *testing.T) {
+		var created *entity.PullRequest
+		prRepo := &mockPRRepo{
+			PRExistsFn: func(string) (bool, error) { return false, nil },
+			CreatePRFn: func(pr *entity.PullRequest) error {
+				created = pr
+				return nil
+			},
+		}
+		teamRepo := &mockTeamRepo{
+			GetTeamFn: teamRepo.GetTeamFn,
+			GetPolicyFn: func(string) (*entity.TeamPolicy, error) {
+				return &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 1, SelectionStrategy: entity.SelectionStrategyRandom}, nil
+			},
+		}
+		svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, affinityRepo, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+
+		preferred := 0
+		for i := 0; i < 200; i++ {
+			if _, err := svc.CreatePR("p1", "n1", "a1"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			switch created.AssignedReviewers[0] {
+			case "r1":
+				t.Fatalf("blocked reviewer r1 was assigned")
+			case "r2":
+				preferred++
+			}
+		}
+		if preferred < 120 {
+			t.Fatalf("expected preferred reviewer to dominate selection, got %d of 200", preferred)
+		}
+	})
+
+	t.Run("blocked_skipped_on_reassign", func(t *testing.T) {
+		prRepo := &mockPRRepo{GetPRFn: func(string) (*entity.PullRequest, error) {
+			return &entity.PullRequest{ID: "p1", AuthorID: "a1", Status: entity.StatusOpen, AssignedReviewers: []string{"r2", "r3", "r4"}}, nil
+		}}
+		svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, affinityRepo, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+
+		_, _, err := svc.ReassignReviewer("p1", "r3")
+		if err == nil || !strings.Contains(err.Error(), "no active replacement candidate") {
+			t.Fatalf("expected no candidate error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,206 @@
+package service_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-review/internal/entity"
+	"pr-review/internal/service"
+)
+
+type mockAvailabilityRepo struct {
+	CreateAwayPeriodFn    func(*entity.AwayPeriod) error
+	GetAwayUserIDsFn      func(time.Time) ([]string, error)
+	GetPendingHandoversFn func(time.Time) ([]*entity.AwayPeriod, error)
+	CompleteHandoverFn    func(int64, time.Time, []entity.ReviewerReassignment) error
+	GetEndedPeriodsFn     func(time.Time) ([]*entity.AwayPeriod, error)
+	MarkReturnedFn        func(int64, time.Time) error
+}
+
+func (m *mockAvailabilityRepo) CreateAwayPeriod(period *entity.AwayPeriod) error {
+	if m.CreateAwayPeriodFn != nil {
+		return m.CreateAwayPeriodFn(period)
+	}
+	return nil
+}
+func (m *mockAvailabilityRepo) GetAwayUserIDs(at time.Time) ([]string, error) {
+	if m.GetAwayUserIDsFn != nil {
+		return m.GetAwayUserIDsFn(at)
+	}
+	return nil, nil
+}
+func (m *mockAvailabilityRepo) GetPendingHandovers(at time.Time) ([]*entity.AwayPeriod, error) {
+	if m.GetPendingHandoversFn != nil {
+		return m.GetPendingHandoversFn(at)
+	}
+	return nil, nil
+}
+func (m *mockAvailabilityRepo) CompleteHandover(periodID int64, at time.Time, reassignments []entity.ReviewerReassignment) error {
+	if m.CompleteHandoverFn != nil {
+		return m.CompleteHandoverFn(periodID, at, reassignments)
+	}
+	return nil
+}
+func (m *mockAvailabilityRepo) GetEndedPeriods(at time.Time) ([]*entity.AwayPeriod, error) {
+	if m.GetEndedPeriodsFn != nil {
+		return m.GetEndedPeriodsFn(at)
+	}
+	return nil, nil
+}
+func (m *mockAvailabilityRepo) MarkReturned(periodID int64, at time.Time) error {
+	if m.MarkReturnedFn != nil {
+		return m.MarkReturnedFn(periodID, at)
+	}
+	return nil
+}
+
+func TestAvailabilityService_SetAway(t *testing.T) {
+	now := time.Now()
+	future := now.Add(24 * time.Hour)
+
+	existingUser := &mockUserRepo{GetUserFn: func(id string) (*entity.User, error) {
+		return &entity.User{ID: id, Name: id, Team: "team1", IsActive: true}, nil
+	}}
+
+	tests := []struct {
+		name         string
+		userID       string
+		from         time.Time
+		until        time.Time
+		reason       string
+		handover     bool
+		userRepo     *mockUserRepo
+		availability *mockAvailabilityRepo
+		wantErr      bool
+		errMsg       string
+		wantHandover bool
+	}{
+		{name: "empty_user_id", userID: "", from: now, until: future, wantErr: true, errMsg: "user_id cannot be empty"},
+		{name: "reason_too_long", userID: "u1", from: now, until: future, reason: longID, wantErr: true, errMsg: "reason cannot exceed 255"},
+		{name: "until_before_from", userID: "u1", from: future, until: now, wantErr: true, errMsg: "until must be after from"},
+		{name: "window_in_past", userID: "u1", from: now.Add(-48 * time.Hour), until: now.Add(-24 * time.Hour), wantErr: true, errMsg: "until must be in the future"},
+		{name: "user_not_found", userID: "u1", from: now, until: future, userRepo: &mockUserRepo{}, wantErr: true, errMsg: "user not found"},
+		{name: "create_error", userID: "u1", from: now, until: future, userRepo: existingUser, availability: &mockAvailabilityRepo{CreateAwayPeriodFn: func(*entity.AwayPeriod) error { return errors.New("create failed") }}, wantErr: true, errMsg: "create failed"},
+		{name: "future_window_defers_handover", userID: "u1", from: future, until: future.Add(time.Hour), handover: true, userRepo: existingUser},
+		{name: "started_window_without_handover", userID: "u1", from: now.Add(-time.Minute), until: future, userRepo: existingUser},
+		{name: "started_window_hands_over", userID: "u1", from: now.Add(-time.Minute), until: future, handover: true, userRepo: existingUser, wantHandover: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := tt.userRepo
+			if userRepo == nil {
+				userRepo = &mockUserRepo{}
+			}
+			availabilityRepo := tt.availability
+			if availabilityRepo == nil {
+				availabilityRepo = &mockAvailabilityRepo{}
+			}
+
+			prService := service.NewPullRequestService(&mockPRRepo{}, userRepo, &mockTeamRepo{}, availabilityRepo, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+			svc := service.NewAvailabilityService(availabilityRepo, userRepo, prService)
+
+			period, report, err := svc.SetAway(tt.userID, tt.from, tt.until, tt.reason, tt.handover)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if period == nil || period.UserID != tt.userID {
+				t.Fatalf("expected away period for %s, got %+v", tt.userID, period)
+			}
+			if tt.wantHandover != (report != nil) {
+				t.Fatalf("expected handover=%v, got report %+v", tt.wantHandover, report)
+			}
+			if tt.wantHandover && period.HandedOverAt == nil {
+				t.Fatalf("expected HandedOverAt to be set")
+			}
+		})
+	}
+}
+
+func TestAvailabilityService_ProcessWindows(t *testing.T) {
+	now := time.Now().UTC()
+
+	userRepo := &mockUserRepo{
+		GetUserFn: func(id string) (*entity.User, error) {
+			return &entity.User{ID: id, Name: id, Team: "team1", IsActive: true}, nil
+		},
+		GetActiveUsersByTeamFn: func(string) ([]*entity.User, error) { return makeMembers("u1", "r1", "r2", "r3"), nil },
+	}
+	prRepo := &mockPRRepo{GetPRsByReviewerFn: func(string) ([]*entity.PullRequest, error) {
+		return []*entity.PullRequest{
+			{ID: "p1", AuthorID: "a1", Status: entity.StatusOpen, AssignedReviewers: []string{"u1", "r1"}},
+		}, nil
+	}}
+
+	var handedOver []int64
+	var reassigned []entity.ReviewerReassignment
+	var returned []int64
+	availabilityRepo := &mockAvailabilityRepo{
+		GetAwayUserIDsFn: func(time.Time) ([]string, error) { return []string{"u1", "r3"}, nil },
+		GetPendingHandoversFn: func(time.Time) ([]*entity.AwayPeriod, error) {
+			return []*entity.AwayPeriod{{ID: 1, UserID: "u1", From: now.Add(-time.Hour), Until: now.Add(time.Hour), Handover: true}}, nil
+		},
+		CompleteHandoverFn: func(id int64, _ time.Time, r []entity.ReviewerReassignment) error {
+			handedOver = append(handedOver, id)
+			reassigned = append(reassigned, r...)
+			return nil
+		},
+		GetEndedPeriodsFn: func(time.Time) ([]*entity.AwayPeriod, error) {
+			return []*entity.AwayPeriod{{ID: 2, UserID: "u2", From: now.Add(-48 * time.Hour), Until: now.Add(-time.Hour)}}, nil
+		},
+		MarkReturnedFn: func(id int64, _ time.Time) error {
+			returned = append(returned, id)
+			return nil
+		},
+	}
+
+	prService := service.NewPullRequestService(prRepo, userRepo, &mockTeamRepo{}, availabilityRepo, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+	svc := service.NewAvailabilityService(availabilityRepo, userRepo, prService)
+
+	if err := svc.ProcessWindows(now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(handedOver) != 1 || handedOver[0] != 1 {
+		t.Fatalf("expected period 1 to be handed over, got %v", handedOver)
+	}
+	if len(reassigned) != 1 || reassigned[0].NewReviewerID != "r2" {
+		t.Fatalf("expected reassignment to the only available teammate r2, got %+v", reassigned)
+	}
+	if len(returned) != 1 || returned[0] != 2 {
+		t.Fatalf("expected period 2 to be marked returned, got %v", returned)
+	}
+}
+
+func TestPullRequestService_CreatePR_SkipsAwayUsers(t *testing.T) {
+	prRepo := &mockPRRepo{PRExistsFn: func(string) (bool, error) { return false, nil }}
+	userRepo := &mockUserRepo{
+		GetUserFn:              func(string) (*entity.User, error) { return &entity.User{ID: "a1", Team: "team1"}, nil },
+		GetActiveUsersByTeamFn: func(string) ([]*entity.User, error) { return makeMembers("a1", "r1", "r2", "r3"), nil },
+	}
+	teamRepo := &mockTeamRepo{GetTeamFn: func(string) (*entity.Team, error) { return &entity.Team{Name: "team1"}, nil }}
+	availabilityRepo := &mockAvailabilityRepo{GetAwayUserIDsFn: func(time.Time) ([]string, error) { return []string{"r1", "r3"}, nil }}
+
+	svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, availabilityRepo, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+
+	pr, err := svc.CreatePR("p1", "n1", "a1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pr.AssignedReviewers) != 1 || pr.AssignedReviewers[0] != "r2" {
+		t.Fatalf("expected only r2 to be assigned, got %v", pr.AssignedReviewers)
+	}
+}
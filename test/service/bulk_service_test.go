@@ -0,0 +1,208 @@
+package service_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"pr-review/internal/entity"
+	"pr-review/internal/service"
+)
+
+type mockBulkRepo struct {
+	ImportFn func(*entity.BulkData) error
+	ExportFn func() (*entity.BulkData, error)
+}
+
+func (m *mockBulkRepo) Import(data *entity.BulkData) error {
+	if m.ImportFn != nil {
+		return m.ImportFn(data)
+	}
+	return nil
+}
+func (m *mockBulkRepo) Export() (*entity.BulkData, error) {
+	if m.ExportFn != nil {
+		return m.ExportFn()
+	}
+	return &entity.BulkData{}, nil
+}
+
+func newBulkService(bulkRepo *mockBulkRepo) *service.BulkService {
+	teamRepo := &mockTeamRepo{TeamExistsFn: func(name string) (bool, error) { return name == "existing", nil }}
+	userRepo := &mockUserRepo{
+		GetUserFn: func(id string) (*entity.User, error) {
+			if id == "e1" {
+				return &entity.User{ID: id, Name: id, Team: "existing", IsActive: true}, nil
+			}
+			return nil, nil
+		},
+		GetDeletedUserIDsFn: func(ids []string) ([]string, error) {
+			deleted := make([]string, 0)
+			for _, id := range ids {
+				if id == "gone" {
+					deleted = append(deleted, id)
+				}
+			}
+			return deleted, nil
+		},
+	}
+	prRepo := &mockPRRepo{PRExistsFn: func(id string) (bool, error) { return id == "old", nil }}
+	return service.NewBulkService(bulkRepo, teamRepo, userRepo, prRepo, service.NewTeamService(teamRepo, userRepo))
+}
+
+func validBulkData() *entity.BulkData {
+	return &entity.BulkData{
+		Teams: []entity.BulkTeam{{Name: "payments", FallbackTeams: []string{"existing"}, SharedReviewers: []string{"u2"}}},
+		Users: []entity.User{
+			{ID: "u1", Name: "Alice", Team: "payments", IsActive: true},
+			{ID: "u2", Name: "Bob", Team: "existing", IsActive: true, Grade: entity.GradeSenior},
+		},
+		PullRequests: []entity.BulkPullRequest{
+			{ID: "p1", Name: "Add search", AuthorID: "u1", AssignedReviewers: []string{"u2", "e1"}},
+			{ID: "p2", Name: "Fix", AuthorID: "e1", Status: entity.StatusMerged},
+		},
+	}
+}
+
+func TestBulkService_ImportApplies(t *testing.T) {
+	var imported *entity.BulkData
+	svc := newBulkService(&mockBulkRepo{ImportFn: func(data *entity.BulkData) error {
+		imported = data
+		return nil
+	}})
+
+	report, err := svc.Import(validBulkData(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !report.Applied || len(report.Errors) != 0 {
+		t.Fatalf("expected applied import, got %+v", report)
+	}
+	if report.Teams != 1 || report.Users != 2 || report.PullRequests != 2 {
+		t.Fatalf("unexpected counts: %+v", report)
+	}
+	if imported.Teams[0].SelectionStrategy != entity.SelectionStrategyRandom {
+		t.Fatalf("expected default selection strategy, got %q", imported.Teams[0].SelectionStrategy)
+	}
+	policy := imported.Teams[0].Policy()
+	if policy.RequiredReviewers != 2 || !policy.AllowSelfMerge || policy.CompositionRules == nil {
+		t.Fatalf("expected default policy for a team without one, got %+v", policy)
+	}
+	if imported.PullRequests[0].Status != entity.StatusOpen || imported.PullRequests[0].CreatedAt == nil {
+		t.Fatalf("expected OPEN status and creation time defaults, got %+v", imported.PullRequests[0])
+	}
+	if imported.PullRequests[1].MergedAt == nil {
+		t.Fatalf("expected merged time default for MERGED pull request")
+	}
+}
+
+func TestBulkService_ImportReportsRowErrors(t *testing.T) {
+	one := 1
+	data := validBulkData()
+	data.Teams = append(data.Teams,
+		entity.BulkTeam{Name: "existing"},
+		entity.BulkTeam{Name: "payments"},
+		entity.BulkTeam{Name: "ops", SelectionStrategy: "round_robin"},
+		entity.BulkTeam{Name: "infra", RequiredReviewers: &one, RequiredApprovals: 2},
+		entity.BulkTeam{
+			Name:            "web",
+			SecurityTeam:    "nowhere",
+			FallbackTeams:   []string{"web", "payments", "payments", "ghost"},
+			SharedReviewers: []string{"u1", "u1", "nobody"},
+		},
+	)
+	data.Users = append(data.Users,
+		entity.User{ID: "u3", Name: "", Team: "payments"},
+		entity.User{ID: "u4", Name: "Dan", Team: "payments", Grade: "principal"},
+		entity.User{ID: "u1", Name: "Alice", Team: "payments"},
+		entity.User{ID: "u5", Name: "Eve", Team: "nowhere"},
+		entity.User{ID: "gone", Name: "Ghost", Team: "payments"},
+		entity.User{ID: "u6", Name: "Fay"},
+		entity.User{ID: "e1", Name: "Moved", Team: "payments", IsActive: true},
+	)
+	data.PullRequests = append(data.PullRequests,
+		entity.BulkPullRequest{ID: "old", Name: "Old", AuthorID: "u1"},
+		entity.BulkPullRequest{ID: "p3", Name: "Self review", AuthorID: "u1", AssignedReviewers: []string{"u1"}},
+		entity.BulkPullRequest{ID: "p4", Name: "Ghost author", AuthorID: "gone", AssignedReviewers: []string{"nobody"}},
+		entity.BulkPullRequest{ID: "p5", Name: "Too many", AuthorID: "u1", AssignedReviewers: []string{"u2", "e1", "u4"}},
+		entity.BulkPullRequest{ID: "p6", Name: "Closed", AuthorID: "u1", Status: "CLOSED"},
+	)
+
+	for _, dryRun := range []bool{true, false} {
+		t.Run(fmt.Sprintf("dry_run=%v", dryRun), func(t *testing.T) {
+			svc := newBulkService(&mockBulkRepo{ImportFn: func(*entity.BulkData) error {
+				t.Fatalf("import with row errors must not be applied")
+				return nil
+			}})
+
+			report, err := svc.Import(data, dryRun)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if report.Applied || report.DryRun != dryRun {
+				t.Fatalf("unexpected report flags: %+v", report)
+			}
+
+			got := make(map[string]bool, len(report.Errors))
+			for _, e := range report.Errors {
+				got[fmt.Sprintf("%s/%d: %s", e.Resource, e.Row, e.Message)] = true
+			}
+			want := []string{
+				"teams/2: team_name already exists",
+				"teams/3: duplicate team_name",
+				"teams/4: selection_strategy must be one of: random, working_hours, expertise",
+				"teams/5: required_approvals must be between 0 and required_reviewers",
+				"teams/6: security team nowhere not found",
+				"teams/6: team cannot be its own fallback",
+				"teams/6: duplicate fallback team payments",
+				"teams/6: fallback team ghost not found",
+				"teams/6: duplicate shared reviewer u1",
+				"teams/6: shared reviewer nobody not found",
+				"users/3: member username cannot be empty",
+				"users/4: member grade must be one of: junior, middle, senior, lead",
+				"users/5: duplicate user_id",
+				"users/6: team not found",
+				"users/7: user gone has been deleted",
+				"users/8: member team_name cannot be empty",
+				"users/9: user e1 already belongs to team existing",
+				"pull_requests/3: PR id already exists",
+				"pull_requests/4: author cannot review their own pull request",
+				"pull_requests/5: author not found",
+				"pull_requests/5: reviewer nobody not found",
+				"pull_requests/6: assigned_reviewers cannot contain more than 2 reviewers",
+				"pull_requests/7: status must be one of: OPEN, MERGED",
+			}
+			for _, w := range want {
+				if !got[w] {
+					t.Errorf("missing error %q in %v", w, report.Errors)
+				}
+			}
+			if len(report.Errors) != len(want) {
+				t.Fatalf("expected %d errors, got %d: %v", len(want), len(report.Errors), report.Errors)
+			}
+		})
+	}
+}
+
+func TestBulkService_DryRunDoesNotApply(t *testing.T) {
+	svc := newBulkService(&mockBulkRepo{ImportFn: func(*entity.BulkData) error {
+		t.Fatalf("dry run must not be applied")
+		return nil
+	}})
+
+	report, err := svc.Import(validBulkData(), true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Applied || len(report.Errors) != 0 {
+		t.Fatalf("expected clean, unapplied dry run, got %+v", report)
+	}
+}
+
+func TestBulkService_ImportRepositoryError(t *testing.T) {
+	svc := newBulkService(&mockBulkRepo{ImportFn: func(*entity.BulkData) error { return errors.New("tx failed") }})
+
+	if _, err := svc.Import(validBulkData(), false); err == nil || err.Error() != "tx failed" {
+		t.Fatalf("expected repository error, got %v", err)
+	}
+}
@@ -0,0 +1,211 @@
+package service_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-review/internal/entity"
+	"pr-review/internal/service"
+)
+
+type mockDigestRepo struct {
+	GetPreferencesFn  func(string) (*entity.DigestPreferences, error)
+	SavePreferencesFn func(*entity.DigestPreferences) error
+	MarkDigestSentFn  func(string, time.Time) error
+}
+
+func (m *mockDigestRepo) GetPreferences(userID string) (*entity.DigestPreferences, error) {
+	if m.GetPreferencesFn != nil {
+		return m.GetPreferencesFn(userID)
+	}
+	return nil, nil
+}
+func (m *mockDigestRepo) SavePreferences(prefs *entity.DigestPreferences) error {
+	if m.SavePreferencesFn != nil {
+		return m.SavePreferencesFn(prefs)
+	}
+	return nil
+}
+func (m *mockDigestRepo) MarkDigestSent(userID string, at time.Time) error {
+	if m.MarkDigestSentFn != nil {
+		return m.MarkDigestSentFn(userID, at)
+	}
+	return nil
+}
+
+type recordingNotifier struct {
+	sent []*entity.ReviewDigest
+	err  error
+}
+
+func (n *recordingNotifier) Send(digest *entity.ReviewDigest) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.sent = append(n.sent, digest)
+	return nil
+}
+
+func TestDigestService_SetPreferences(t *testing.T) {
+	existingUser := &mockUserRepo{GetUserFn: func(id string) (*entity.User, error) { return &entity.User{ID: id}, nil }}
+
+	tests := []struct {
+		name      string
+		prefs     *entity.DigestPreferences
+		userRepo  *mockUserRepo
+		wantErr   bool
+		errMsg    string
+		wantFreq  entity.DigestFrequency
+		wantEmail string
+	}{
+		{name: "empty_user", prefs: &entity.DigestPreferences{}, wantErr: true, errMsg: "user_id cannot be empty"},
+		{name: "user_not_found", prefs: &entity.DigestPreferences{UserID: "u1"}, userRepo: &mockUserRepo{}, wantErr: true, errMsg: "user not found"},
+		{name: "invalid_frequency", prefs: &entity.DigestPreferences{UserID: "u1", Frequency: "monthly"}, userRepo: existingUser, wantErr: true, errMsg: "frequency must be one of"},
+		{name: "invalid_email", prefs: &entity.DigestPreferences{UserID: "u1", Email: "not-an-email"}, userRepo: existingUser, wantErr: true, errMsg: "email is not a valid address"},
+		{name: "defaults_frequency", prefs: &entity.DigestPreferences{UserID: "u1", Enabled: true}, userRepo: existingUser, wantFreq: entity.DigestFrequencyDaily},
+		{name: "opt_out", prefs: &entity.DigestPreferences{UserID: "u1", Enabled: false, Frequency: entity.DigestFrequencyWeekly, Email: "u1@example.com"}, userRepo: existingUser, wantFreq: entity.DigestFrequencyWeekly, wantEmail: "u1@example.com"},
+		{name: "email_with_display_name", prefs: &entity.DigestPreferences{UserID: "u1", Enabled: true, Email: "Alice <alice@example.com>"}, userRepo: existingUser, wantFreq: entity.DigestFrequencyDaily, wantEmail: "alice@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := tt.userRepo
+			if userRepo == nil {
+				userRepo = &mockUserRepo{}
+			}
+			var saved *entity.DigestPreferences
+			digestRepo := &mockDigestRepo{SavePreferencesFn: func(p *entity.DigestPreferences) error {
+				saved = p
+				return nil
+			}}
+			svc := service.NewDigestService(digestRepo, &mockPRRepo{}, userRepo, &recordingNotifier{})
+
+			prefs, err := svc.SetPreferences(tt.prefs)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if saved == nil || prefs.Frequency != tt.wantFreq || saved.Enabled != tt.prefs.Enabled || saved.Email != tt.wantEmail {
+				t.Fatalf("unexpected saved preferences: %+v", saved)
+			}
+		})
+	}
+}
+
+func TestDigestService_GetPreferencesDefaults(t *testing.T) {
+	userRepo := &mockUserRepo{GetUserFn: func(id string) (*entity.User, error) { return &entity.User{ID: id}, nil }}
+	svc := service.NewDigestService(&mockDigestRepo{}, &mockPRRepo{}, userRepo, &recordingNotifier{})
+
+	prefs, err := svc.GetPreferences("u1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !prefs.Enabled || prefs.Frequency != entity.DigestFrequencyDaily {
+		t.Fatalf("expected enabled daily defaults, got %+v", prefs)
+	}
+}
+
+func TestDigestService_BuildDigest(t *testing.T) {
+	now := time.Now().UTC()
+	older := now.Add(-48 * time.Hour)
+	newer := now.Add(-2 * time.Hour)
+	prRepo := &mockPRRepo{GetPRsByReviewerFn: func(string) ([]*entity.PullRequest, error) {
+		return []*entity.PullRequest{
+			{ID: "p-new", Name: "new", AuthorID: "a1", Status: entity.StatusOpen, CreatedAt: &newer},
+			{ID: "p-merged", Name: "merged", AuthorID: "a1", Status: entity.StatusMerged, CreatedAt: &older},
+			{ID: "p-old", Name: "old", AuthorID: "a2", Status: entity.StatusOpen, CreatedAt: &older},
+		}, nil
+	}}
+	svc := service.NewDigestService(&mockDigestRepo{}, prRepo, &mockUserRepo{}, &recordingNotifier{})
+
+	digest, err := svc.BuildDigest(&entity.User{ID: "r1", Name: "Reviewer"}, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(digest.Items) != 2 {
+		t.Fatalf("expected 2 open items, got %d", len(digest.Items))
+	}
+	if digest.Items[0].PullRequestID != "p-old" || digest.Items[1].PullRequestID != "p-new" {
+		t.Fatalf("expected oldest first, got %+v", digest.Items)
+	}
+	if digest.Items[0].AgeSeconds != int64((48 * time.Hour).Seconds()) {
+		t.Fatalf("unexpected age %d", digest.Items[0].AgeSeconds)
+	}
+}
+
+func TestDigestService_SendDigests(t *testing.T) {
+	now := time.Now().UTC()
+	createdAt := now.Add(-5 * time.Hour)
+	recent := now.Add(-30 * time.Minute)
+	yesterday := now.Add(-24 * time.Hour)
+
+	prefs := map[string]*entity.DigestPreferences{
+		"opted-out":   {UserID: "opted-out", Enabled: false, Frequency: entity.DigestFrequencyDaily},
+		"sent-recent": {UserID: "sent-recent", Enabled: true, Frequency: entity.DigestFrequencyDaily, LastSentAt: &recent},
+		"due":         {UserID: "due", Enabled: true, Frequency: entity.DigestFrequencyDaily, Email: "due@example.com", LastSentAt: &yesterday},
+	}
+
+	tests := []struct {
+		name       string
+		notifier   *recordingNotifier
+		wantSentTo []string
+		wantMarked []string
+	}{
+		{name: "respects_preferences", notifier: &recordingNotifier{}, wantSentTo: []string{"due", "no-prefs"}, wantMarked: []string{"due", "no-prefs"}},
+		{name: "notifier_failure_not_marked", notifier: &recordingNotifier{err: errors.New("smtp down")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var marked []string
+			digestRepo := &mockDigestRepo{
+				GetPreferencesFn: func(id string) (*entity.DigestPreferences, error) { return prefs[id], nil },
+				MarkDigestSentFn: func(id string, at time.Time) error {
+					marked = append(marked, id)
+					return nil
+				},
+			}
+			prRepo := &mockPRRepo{
+				GetReviewersWithOpenPRsFn: func() ([]string, error) {
+					return []string{"opted-out", "sent-recent", "due", "no-prefs", "inactive"}, nil
+				},
+				GetPRsByReviewerFn: func(string) ([]*entity.PullRequest, error) {
+					return []*entity.PullRequest{{ID: "p1", Name: "pr", AuthorID: "a1", Status: entity.StatusOpen, CreatedAt: &createdAt}}, nil
+				},
+			}
+			userRepo := &mockUserRepo{GetUserFn: func(id string) (*entity.User, error) {
+				return &entity.User{ID: id, IsActive: id != "inactive"}, nil
+			}}
+			svc := service.NewDigestService(digestRepo, prRepo, userRepo, tt.notifier)
+
+			sent, err := svc.SendDigests(now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if sent != len(tt.wantSentTo) || len(tt.notifier.sent) != len(tt.wantSentTo) {
+				t.Fatalf("expected %d digests, got %d", len(tt.wantSentTo), sent)
+			}
+			for i, id := range tt.wantSentTo {
+				if tt.notifier.sent[i].UserID != id {
+					t.Fatalf("expected digest %d for %s, got %s", i, id, tt.notifier.sent[i].UserID)
+				}
+			}
+			if len(marked) != len(tt.wantMarked) {
+				t.Fatalf("expected %v marked as sent, got %v", tt.wantMarked, marked)
+			}
+			if len(tt.wantSentTo) > 0 && tt.notifier.sent[0].Email != "due@example.com" {
+				t.Fatalf("expected digest email from preferences, got %q", tt.notifier.sent[0].Email)
+			}
+		})
+	}
+}
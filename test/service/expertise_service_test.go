@@ -0,0 +1,164 @@
+package service_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-review/internal/entity"
+	"pr-review/internal/service"
+)
+
+type mockExpertiseRepo struct {
+	RecordReviewFn     func(string, []string, time.Time) error
+	GetScoresFn        func([]string, []string) ([]*entity.ExpertiseScore, error)
+	GetUserExpertiseFn func(string) ([]*entity.ExpertiseScore, error)
+}
+
+func (m *mockExpertiseRepo) RecordReview(userID string, areas []string, at time.Time) error {
+	if m.RecordReviewFn != nil {
+		return m.RecordReviewFn(userID, areas, at)
+	}
+	return nil
+}
+func (m *mockExpertiseRepo) GetScores(userIDs []string, areas []string) ([]*entity.ExpertiseScore, error) {
+	if m.GetScoresFn != nil {
+		return m.GetScoresFn(userIDs, areas)
+	}
+	return nil, nil
+}
+func (m *mockExpertiseRepo) GetUserExpertise(userID string) ([]*entity.ExpertiseScore, error) {
+	if m.GetUserExpertiseFn != nil {
+		return m.GetUserExpertiseFn(userID)
+	}
+	return nil, nil
+}
+
+func TestExpertiseAreas(t *testing.T) {
+	pr := &entity.PullRequest{
+		Repository:   "api",
+		Labels:       []string{"Backend", "backend"},
+		ChangedFiles: []string{"README.md", "internal/service/pull_request.go", "internal/service/selection.go", "internal/http/dto/a/b.go"},
+	}
+
+	got := entity.ExpertiseAreas(pr, 3)
+	want := []string{"label:backend", "path:api:internal", "path:api:internal/service", "path:api:internal/http", "path:api:internal/http/dto"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected areas %v, got %v", want, got)
+	}
+}
+
+func TestExpertiseService_GetExpertise(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	userRepo := &mockUserRepo{GetUserFn: func(id string) (*entity.User, error) {
+		if id == "ghost" {
+			return nil, nil
+		}
+		return &entity.User{ID: id}, nil
+	}}
+	expertiseRepo := &mockExpertiseRepo{GetUserExpertiseFn: func(userID string) ([]*entity.ExpertiseScore, error) {
+		return []*entity.ExpertiseScore{
+			{UserID: userID, Area: "label:backend", Score: 4, UpdatedAt: now.Add(-30 * 24 * time.Hour)},
+			{UserID: userID, Area: "path:api:internal", Score: 3, UpdatedAt: now},
+			{UserID: userID, Area: "path:web", Score: 1, UpdatedAt: now.Add(-365 * 24 * time.Hour)},
+		}, nil
+	}}
+	svc := service.NewExpertiseService(expertiseRepo, userRepo)
+
+	if _, err := svc.GetExpertise("ghost", now); err == nil || !strings.Contains(err.Error(), "user not found") {
+		t.Fatalf("expected user not found error, got %v", err)
+	}
+
+	scores, err := svc.GetExpertise("u1", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(scores) != 2 {
+		t.Fatalf("expected stale score to be dropped, got %d scores", len(scores))
+	}
+	if scores[0].Area != "path:api:internal" || scores[0].Score != 3 {
+		t.Fatalf("expected fresh score first, got %+v", scores[0])
+	}
+	if scores[1].Area != "label:backend" || math.Abs(scores[1].Score-2) > 1e-9 {
+		t.Fatalf("expected score halved after one half-life, got %+v", scores[1])
+	}
+}
+
+func TestPullRequestService_ExpertiseStrategy(t *testing.T) {
+	userRepo := &mockUserRepo{
+		GetUserFn:              func(id string) (*entity.User, error) { return &entity.User{ID: id, Team: "team1", IsActive: true}, nil },
+		GetActiveUsersByTeamFn: func(string) ([]*entity.User, error) { return makeMembers("a1", "r1", "r2", "r3", "r4"), nil },
+	}
+	teamRepo := &mockTeamRepo{
+		GetTeamFn: func(string) (*entity.Team, error) { return &entity.Team{Name: "team1"}, nil },
+		GetPolicyFn: func(string) (*entity.TeamPolicy, error) {
+			return &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 2, SelectionStrategy: entity.SelectionStrategyExpertise}, nil
+		},
+	}
+
+	var requestedAreas []string
+	expertiseRepo := &mockExpertiseRepo{GetScoresFn: func(userIDs []string, areas []string) ([]*entity.ExpertiseScore, error) {
+		requestedAreas = areas
+		now := time.Now()
+		return []*entity.ExpertiseScore{
+			{UserID: "r3", Area: "label:db", Score: 5, UpdatedAt: now},
+			{UserID: "r3", Area: "path:internal", Score: 4, UpdatedAt: now},
+			{UserID: "r4", Area: "path:internal", Score: 6, UpdatedAt: now},
+			{UserID: "r1", Area: "path:internal", Score: 0.5, UpdatedAt: now},
+		}, nil
+	}}
+
+	var created *entity.PullRequest
+	prRepo := &mockPRRepo{
+		PRExistsFn: func(string) (bool, error) { return false, nil },
+		CreatePRFn: func(pr *entity.PullRequest) error {
+			created = pr
+			return nil
+		},
+	}
+	svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, expertiseRepo)
+
+	for i := 0; i < 50; i++ {
+		_, err := svc.CreatePullRequest(&entity.PullRequest{ID: "p1", Name: "n1", AuthorID: "a1", Labels: []string{"DB"}, ChangedFiles: []string{"internal/repo.go"}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := strings.Join(created.AssignedReviewers, ",")
+		if got != "r3,r4" && got != "r4,r3" {
+			t.Fatalf("expected experts r3 and r4, got %v", created.AssignedReviewers)
+		}
+	}
+	if strings.Join(requestedAreas, ",") != "label:db,path:internal" {
+		t.Fatalf("unexpected expertise areas %v", requestedAreas)
+	}
+	if strings.Join(created.Labels, ",") != "DB" {
+		t.Fatalf("expected labels to be stored, got %v", created.Labels)
+	}
+
+	_, err := svc.CreatePullRequest(&entity.PullRequest{ID: "p2", Name: "n2", AuthorID: "a1", Labels: []string{" "}})
+	if err == nil || !strings.Contains(err.Error(), "labels cannot contain empty values") {
+		t.Fatalf("expected label validation error, got %v", err)
+	}
+}
+
+func TestPullRequestService_MergeRecordsExpertise(t *testing.T) {
+	recorded := make(map[string][]string)
+	expertiseRepo := &mockExpertiseRepo{RecordReviewFn: func(userID string, areas []string, _ time.Time) error {
+		recorded[userID] = areas
+		return nil
+	}}
+	prRepo := &mockPRRepo{GetPRFn: func(string) (*entity.PullRequest, error) {
+		return &entity.PullRequest{ID: "p1", AuthorID: "a1", Status: entity.StatusOpen, AssignedReviewers: []string{"r1", "r2"}, Labels: []string{"ui"}, ChangedFiles: []string{"web/app.js"}}, nil
+	}}
+	teamRepo := &mockTeamRepo{GetTeamFn: func(string) (*entity.Team, error) { return &entity.Team{Name: "team1"}, nil }}
+	userRepo := &mockUserRepo{GetUserFn: func(id string) (*entity.User, error) { return &entity.User{ID: id, Team: "team1", IsActive: true}, nil }}
+	svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, expertiseRepo)
+
+	if _, err := svc.MergePR("p1", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(recorded) != 2 || strings.Join(recorded["r1"], ",") != "label:ui,path:web" {
+		t.Fatalf("expected expertise recorded for both reviewers, got %v", recorded)
+	}
+}
@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/service"
+)
+
+type mockOrganizationRepo struct {
+	GetOrganizationFn        func(string) (*entity.Organization, error)
+	GetOrganizationByTokenFn func(string) (*entity.Organization, error)
+	ListOrganizationsFn      func() ([]*entity.Organization, error)
+}
+
+func (m *mockOrganizationRepo) GetOrganization(orgID string) (*entity.Organization, error) {
+	if m.GetOrganizationFn != nil {
+		return m.GetOrganizationFn(orgID)
+	}
+	return nil, nil
+}
+func (m *mockOrganizationRepo) GetOrganizationByToken(tokenHash string) (*entity.Organization, error) {
+	if m.GetOrganizationByTokenFn != nil {
+		return m.GetOrganizationByTokenFn(tokenHash)
+	}
+	return nil, nil
+}
+func (m *mockOrganizationRepo) ListOrganizations() ([]*entity.Organization, error) {
+	if m.ListOrganizationsFn != nil {
+		return m.ListOrganizationsFn()
+	}
+	return nil, nil
+}
+
+func TestOrganizationService_ResolveOrganization(t *testing.T) {
+	organizations := map[string]*entity.Organization{
+		"default": {ID: "default", Name: "Default"},
+		"acme":    {ID: "acme", Name: "Acme"},
+		"globex":  {ID: "globex", Name: "Globex"},
+	}
+	tokens := map[string]string{
+		service.HashToken("acme-token"): "acme",
+	}
+
+	tests := []struct {
+		name        string
+		token       string
+		orgID       string
+		defaultOrg  string
+		allowHeader bool
+		want        string
+		wantCode    entity.ErrorCode
+	}{
+		{name: "token", token: "acme-token", want: "acme"},
+		{name: "token_with_matching_header", token: "acme-token", orgID: "acme", want: "acme"},
+		{name: "invalid_token", token: "stolen", wantCode: entity.ErrorCodeUnauthorized},
+		{name: "token_for_other_organization", token: "acme-token", orgID: "globex", wantCode: entity.ErrorCodeForbidden},
+		{name: "header", orgID: "globex", allowHeader: true, want: "globex"},
+		{name: "header_disabled", orgID: "globex", wantCode: entity.ErrorCodeUnauthorized},
+		{name: "unknown_organization", orgID: "initech", allowHeader: true, wantCode: entity.ErrorCodeNotFound},
+		{name: "too_long_organization", orgID: strings.Repeat("a", 256), allowHeader: true, wantCode: entity.ErrorCodeNotFound},
+		{name: "default", want: "default"},
+		{name: "custom_default", defaultOrg: "acme", want: "acme"},
+		{name: "no_default", defaultOrg: config.NoDefaultOrganization, wantCode: entity.ErrorCodeUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orgRepo := &mockOrganizationRepo{
+				GetOrganizationFn: func(orgID string) (*entity.Organization, error) {
+					return organizations[orgID], nil
+				},
+				GetOrganizationByTokenFn: func(tokenHash string) (*entity.Organization, error) {
+					return organizations[tokens[tokenHash]], nil
+				},
+			}
+			defaultOrg := tt.defaultOrg
+			if defaultOrg == "" {
+				defaultOrg = config.DefaultOrganizationID
+			}
+			svc := service.NewOrganizationService(orgRepo, &config.TenantConfig{
+				DefaultOrganization: defaultOrg,
+				AllowHeader:         tt.allowHeader,
+			})
+
+			org, err := svc.ResolveOrganization(tt.token, tt.orgID)
+			if tt.wantCode != "" {
+				var domainErr *entity.DomainError
+				if !errors.As(err, &domainErr) || domainErr.Code != tt.wantCode {
+					t.Fatalf("expected %s error, got %v", tt.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if org.ID != tt.want {
+				t.Fatalf("expected organization %q, got %q", tt.want, org.ID)
+			}
+		})
+	}
+}
@@ -3,10 +3,12 @@ package service_test
 
 import (
 	"errors"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
+	"pr-review/internal/config"
 	"pr-review/internal/entity"
 	"pr-review/internal/service"
 )
@@ -65,7 +67,7 @@ func TestPullRequestService_CreatePR(t *testing.T) {
 				tr = &mockTeamRepo{}
 			}
 
-			svc := service.NewPullRequestService(prRepo, ur, tr)
+			svc := service.NewPullRequestService(prRepo, ur, tr, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
 
 			pr, err := svc.CreatePR(tt.prID, tt.prName, tt.authorID)
 
@@ -119,10 +121,13 @@ func TestPullRequestService_MergePR(t *testing.T) {
 		}}, wantErr: false, wantMerged: true},
 		{name: "update_error", prID: "p4", prRepo: &mockPRRepo{GetPRFn: func(string) (*entity.PullRequest, error) {
 			return &entity.PullRequest{ID: "p4", Status: entity.StatusOpen}, nil
-		}, UpdatePRFn: func(*entity.PullRequest) error { return errors.New("update err") }}, wantErr: true, errMsg: "update err"},
+		}, UpdatePRFn: func(*entity.PullRequest) (bool, error) { return false, errors.New("update err") }}, wantErr: true, errMsg: "update err"},
+		{name: "stale_version", prID: "p6", prRepo: &mockPRRepo{GetPRFn: func(string) (*entity.PullRequest, error) {
+			return &entity.PullRequest{ID: "p6", Status: entity.StatusOpen, Version: 3}, nil
+		}, UpdatePRFn: func(*entity.PullRequest) (bool, error) { return false, nil }}, wantErr: true, errMsg: "modified concurrently"},
 		{name: "success", prID: "p5", prRepo: &mockPRRepo{GetPRFn: func(string) (*entity.PullRequest, error) {
 			return &entity.PullRequest{ID: "p5", Status: entity.StatusOpen}, nil
-		}, UpdatePRFn: func(*entity.PullRequest) error { return nil }}, wantErr: false, wantMerged: true},
+		}, UpdatePRFn: func(*entity.PullRequest) (bool, error) { return true, nil }}, wantErr: false, wantMerged: true},
 	}
 
 	for _, tt := range tests {
@@ -131,9 +136,9 @@ func TestPullRequestService_MergePR(t *testing.T) {
 			if prRepo == nil {
 				prRepo = &mockPRRepo{}
 			}
-			svc := service.NewPullRequestService(prRepo, &mockUserRepo{}, &mockTeamRepo{})
+			svc := service.NewPullRequestService(prRepo, &mockUserRepo{}, &mockTeamRepo{}, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
 
-			pr, err := svc.MergePR(tt.prID)
+			pr, err := svc.MergePR(tt.prID, "")
 
 			if tt.wantErr {
 				if err == nil {
@@ -169,3 +174,709 @@ func TestPullRequestService_MergePR(t *testing.T) {
 		})
 	}
 }
+
+func TestPullRequestService_CreatePR_WorkingHoursStrategy(t *testing.T) {
+	now := time.Now().UTC()
+	allDays := []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+	inThreeDays := []time.Weekday{now.AddDate(0, 0, 3).Weekday()}
+
+	tests := []struct {
+		name      string
+		schedules map[string]*entity.WorkSchedule
+		want      []string
+	}{
+		{
+			name: "prefers_online_candidates",
+			schedules: map[string]*entity.WorkSchedule{
+				"r1": {TimeZone: "UTC", Start: "00:00", End: "23:59", Days: allDays},
+				"r2": {TimeZone: "Asia/Yerevan", Start: "09:00", End: "10:00", Days: inThreeDays},
+				"r3": {TimeZone: "Asia/Novosibirsk", Start: "00:00", End: "23:59", Days: allDays},
+			},
+			want: []string{"r1", "r3"},
+		},
+		{
+			name: "falls_back_to_soonest_when_nobody_online",
+			schedules: map[string]*entity.WorkSchedule{
+				"r1": {TimeZone: "UTC", Start: "09:00", End: "10:00", Days: []time.Weekday{now.AddDate(0, 0, 4).Weekday()}},
+				"r2": {TimeZone: "UTC", Start: "09:00", End: "10:00", Days: []time.Weekday{now.AddDate(0, 0, 2).Weekday()}},
+				"r3": {TimeZone: "UTC", Start: "09:00", End: "10:00", Days: []time.Weekday{now.AddDate(0, 0, 3).Weekday()}},
+			},
+			want: []string{"r2", "r3"},
+		},
+		{
+			name: "ranks_unscheduled_after_online",
+			schedules: map[string]*entity.WorkSchedule{
+				"r1": {TimeZone: "UTC", Start: "00:00", End: "23:59", Days: allDays},
+				"r2": {TimeZone: "Asia/Yerevan", Start: "00:00", End: "23:59", Days: allDays},
+			},
+			want: []string{"r1", "r2"},
+		},
+		{
+			name: "ranks_unscheduled_before_offline",
+			schedules: map[string]*entity.WorkSchedule{
+				"r1": {TimeZone: "UTC", Start: "00:00", End: "23:59", Days: allDays},
+				"r2": {TimeZone: "UTC", Start: "09:00", End: "10:00", Days: inThreeDays},
+			},
+			want: []string{"r1", "r3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := &mockPRRepo{PRExistsFn: func(string) (bool, error) { return false, nil }}
+			userRepo := &mockUserRepo{
+				GetUserFn:              func(string) (*entity.User, error) { return &entity.User{ID: "a1", Team: "team1"}, nil },
+				GetActiveUsersByTeamFn: func(string) ([]*entity.User, error) { return makeMembers("a1", "r1", "r2", "r3"), nil },
+				GetWorkSchedulesFn: func([]string) (map[string]*entity.WorkSchedule, error) {
+					return tt.schedules, nil
+				},
+			}
+			teamRepo := &mockTeamRepo{GetTeamFn: func(string) (*entity.Team, error) {
+				return &entity.Team{Name: "team1", SelectionStrategy: entity.SelectionStrategyWorkingHours}, nil
+			}}
+
+			svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+
+			pr, err := svc.CreatePR("p1", "n1", "a1")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := map[string]bool{}
+			for _, id := range pr.AssignedReviewers {
+				got[id] = true
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected reviewers %v, got %v", tt.want, pr.AssignedReviewers)
+			}
+			for _, id := range tt.want {
+				if !got[id] {
+					t.Fatalf("expected reviewers %v, got %v", tt.want, pr.AssignedReviewers)
+				}
+			}
+		})
+	}
+}
+
+func TestPullRequestService_FallbackReviewerPools(t *testing.T) {
+	members := map[string][]*entity.User{
+		"team1":    makeMembers("a1", "r1"),
+		"backup":   {{ID: "b1", Team: "backup", IsActive: true}},
+		"platform": {{ID: "p1", Team: "platform", IsActive: true}, {ID: "p2", Team: "platform", IsActive: true}},
+	}
+	users := map[string]*entity.User{
+		"a1": {ID: "a1", Team: "team1", IsActive: true},
+		"r1": {ID: "r1", Team: "team1", IsActive: true},
+		"s1": {ID: "s1", Team: "platform", IsActive: true},
+		"s2": {ID: "s2", Team: "platform", IsActive: false},
+	}
+	userRepo := &mockUserRepo{
+		GetUserFn:              func(id string) (*entity.User, error) { return users[id], nil },
+		GetActiveUsersByTeamFn: func(team string) ([]*entity.User, error) { return members[team], nil },
+	}
+
+	tests := []struct {
+		name          string
+		fallbackTeams []string
+		shared        []string
+		reviewers     []string
+		reassign      string
+		want          []string
+		wantErr       string
+	}{
+		{name: "own_team_then_fallback", fallbackTeams: []string{"backup", "platform"}, want: []string{"r1", "b1"}},
+		{name: "shared_pool_when_no_fallback", shared: []string{"s1", "s2"}, want: []string{"r1", "s1"}},
+		{name: "only_own_team", want: []string{"r1"}},
+		{name: "reassign_uses_author_pools", fallbackTeams: []string{"backup"}, reviewers: []string{"r1", "x1"}, reassign: "x1", want: []string{"r1", "b1"}},
+		{name: "reassign_without_pools", reviewers: []string{"r1", "x1"}, reassign: "x1", wantErr: "no active replacement candidate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *entity.PullRequest
+			prRepo := &mockPRRepo{
+				PRExistsFn: func(string) (bool, error) { return false, nil },
+				CreatePRFn: func(pr *entity.PullRequest) error {
+					created = pr
+					return nil
+				},
+				GetPRFn: func(string) (*entity.PullRequest, error) {
+					return &entity.PullRequest{ID: "p1", AuthorID: "a1", Status: entity.StatusOpen, AssignedReviewers: tt.reviewers}, nil
+				},
+			}
+			teamRepo := &mockTeamRepo{GetTeamFn: func(string) (*entity.Team, error) {
+				return &entity.Team{Name: "team1", FallbackTeams: tt.fallbackTeams, SharedReviewers: tt.shared}, nil
+			}}
+			svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+
+			var got []string
+			if tt.reassign != "" {
+				pr, _, err := svc.ReassignReviewer("p1", tt.reassign)
+				if tt.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+						t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				got = pr.AssignedReviewers
+			} else {
+				if _, err := svc.CreatePR("p1", "n1", "a1"); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				got = created.AssignedReviewers
+			}
+
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("expected reviewers %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPullRequestService_ReassignReviewer_DeletedAuthor(t *testing.T) {
+	users := map[string]*entity.User{
+		"r1": {ID: "r1", Team: "team1", IsActive: true},
+		"r2": {ID: "r2", Team: "team1", IsActive: true},
+	}
+	userRepo := &mockUserRepo{
+		GetUserFn: func(id string) (*entity.User, error) { return users[id], nil },
+		GetUserIncludingDeletedFn: func(id string) (*entity.User, error) {
+			if id == "a1" {
+				return &entity.User{ID: "a1", Name: entity.DeletedUsername, Team: "team1"}, nil
+			}
+			return users[id], nil
+		},
+		GetActiveUsersByTeamFn: func(string) ([]*entity.User, error) { return makeMembers("r1", "r2"), nil },
+	}
+	var updated *entity.PullRequest
+	prRepo := &mockPRRepo{
+		GetPRFn: func(string) (*entity.PullRequest, error) {
+			return &entity.PullRequest{ID: "p1", AuthorID: "a1", Status: entity.StatusOpen, AssignedReviewers: []string{"r1"}}, nil
+		},
+		UpdatePRFn: func(pr *entity.PullRequest) (bool, error) {
+			updated = pr
+			return true, nil
+		},
+	}
+	teamRepo := &mockTeamRepo{GetTeamFn: func(string) (*entity.Team, error) { return &entity.Team{Name: "team1"}, nil }}
+	svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+
+	_, newReviewer, err := svc.ReassignReviewer("p1", "r1")
+	if err != nil {
+		t.Fatalf("expected reassignment on a PR by a deleted author to succeed, got %v", err)
+	}
+	if newReviewer != "r2" {
+		t.Fatalf("expected r2 as the replacement, got %q", newReviewer)
+	}
+	if updated == nil || strings.Join(updated.AssignedReviewers, ",") != "r2" {
+		t.Fatalf("expected reviewers [r2] to be saved, got %+v", updated)
+	}
+}
+
+func TestPullRequestService_CreatePR_PolicyReviewerCount(t *testing.T) {
+	var created *entity.PullRequest
+	prRepo := &mockPRRepo{
+		PRExistsFn: func(string) (bool, error) { return false, nil },
+		CreatePRFn: func(pr *entity.PullRequest) error {
+			created = pr
+			return nil
+		},
+	}
+	userRepo := &mockUserRepo{
+		GetUserFn:              func(string) (*entity.User, error) { return &entity.User{ID: "a1", Team: "team1"}, nil },
+		GetActiveUsersByTeamFn: func(string) ([]*entity.User, error) { return makeMembers("a1", "r1", "r2", "r3", "r4"), nil },
+	}
+	teamRepo := &mockTeamRepo{
+		GetTeamFn: func(string) (*entity.Team, error) { return &entity.Team{Name: "team1"}, nil },
+		GetPolicyFn: func(string) (*entity.TeamPolicy, error) {
+			return &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 3, SelectionStrategy: entity.SelectionStrategyRandom}, nil
+		},
+	}
+	svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+
+	if _, err := svc.CreatePR("p1", "n1", "a1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(created.AssignedReviewers) != 3 {
+		t.Fatalf("expected 3 reviewers from team policy, got %v", created.AssignedReviewers)
+	}
+}
+
+func TestPullRequestService_MergePR_Policy(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    *entity.TeamPolicy
+		mergedBy  string
+		approvals int
+		errMsg    string
+	}{
+		{name: "self_merge_blocked", policy: &entity.TeamPolicy{RequiredReviewers: 2}, mergedBy: "a1", errMsg: "author cannot merge their own PR"},
+		{name: "merger_required", policy: &entity.TeamPolicy{RequiredReviewers: 2}, errMsg: "merged_by is required"},
+		{name: "not_enough_approvals", policy: &entity.TeamPolicy{RequiredReviewers: 2, AllowSelfMerge: true, RequiredApprovals: 2}, approvals: 1, errMsg: "PR requires 2 approvals, has 1"},
+		{name: "approved", policy: &entity.TeamPolicy{RequiredReviewers: 2, RequiredApprovals: 2}, mergedBy: "r1", approvals: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := false
+			prRepo := &mockPRRepo{
+				GetPRFn: func(string) (*entity.PullRequest, error) {
+					return &entity.PullRequest{ID: "p1", AuthorID: "a1", Status: entity.StatusOpen, AssignedReviewers: []string{"r1", "r2"}}, nil
+				},
+				UpdatePRFn: func(*entity.PullRequest) (bool, error) {
+					updated = true
+					return true, nil
+				},
+				CountApprovalsFn: func(string) (int, error) { return tt.approvals, nil },
+			}
+			userRepo := &mockUserRepo{GetUserFn: func(id string) (*entity.User, error) { return &entity.User{ID: id, Team: "team1"}, nil }}
+			teamRepo := &mockTeamRepo{
+				GetTeamFn:   func(string) (*entity.Team, error) { return &entity.Team{Name: "team1"}, nil },
+				GetPolicyFn: func(string) (*entity.TeamPolicy, error) { return tt.policy, nil },
+			}
+			svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+
+			pr, err := svc.MergePR("p1", tt.mergedBy)
+			if tt.errMsg != "" {
+				var derr *entity.DomainError
+				if !errors.As(err, &derr) || derr.Code != entity.ErrorCodeMergeBlocked || !strings.Contains(derr.Message, tt.errMsg) {
+					t.Fatalf("expected MERGE_BLOCKED %q, got %v", tt.errMsg, err)
+				}
+				if updated {
+					t.Fatalf("blocked merge must not update the PR")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if pr.Status != entity.StatusMerged || !updated {
+				t.Fatalf("expected PR to be merged")
+			}
+		})
+	}
+}
+
+func TestPullRequestService_ApprovePR(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   entity.Status
+		reviewer string
+		wantCode entity.ErrorCode
+	}{
+		{name: "not_assigned", status: entity.StatusOpen, reviewer: "x1", wantCode: entity.ErrorCodeNotAssigned},
+		{name: "merged", status: entity.StatusMerged, reviewer: "r1", wantCode: entity.ErrorCodePRMerged},
+		{name: "success", status: entity.StatusOpen, reviewer: "r1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var approvedBy string
+			prRepo := &mockPRRepo{
+				GetPRFn: func(string) (*entity.PullRequest, error) {
+					return &entity.PullRequest{ID: "p1", AuthorID: "a1", Status: tt.status, AssignedReviewers: []string{"r1", "r2"}}, nil
+				},
+				AddApprovalFn: func(_, reviewerID string) error {
+					approvedBy = reviewerID
+					return nil
+				},
+			}
+			svc := service.NewPullRequestService(prRepo, &mockUserRepo{}, &mockTeamRepo{}, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+
+			_, err := svc.ApprovePR("p1", tt.reviewer)
+			if tt.wantCode != "" {
+				var derr *entity.DomainError
+				if !errors.As(err, &derr) || derr.Code != tt.wantCode {
+					t.Fatalf("expected %s, got %v", tt.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if approvedBy != tt.reviewer {
+				t.Fatalf("expected approval by %s, got %q", tt.reviewer, approvedBy)
+			}
+		})
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestPullRequestService_CompositionRules(t *testing.T) {
+	users := map[string]*entity.User{
+		"a1": {ID: "a1", Team: "team1", IsActive: true, Grade: entity.GradeMiddle},
+		"aj": {ID: "aj", Team: "team1", IsActive: true, Grade: entity.GradeJunior},
+		"j1": {ID: "j1", Team: "team1", IsActive: true, Grade: entity.GradeJunior},
+		"j2": {ID: "j2", Team: "team1", IsActive: true, Grade: entity.GradeJunior},
+		"m1": {ID: "m1", Team: "team1", IsActive: true},
+		"s1": {ID: "s1", Team: "team1", IsActive: true, Grade: entity.GradeSenior},
+		"s2": {ID: "s2", Team: "team1", IsActive: true, Grade: entity.GradeLead},
+	}
+	seniorRequired := entity.CompositionRule{MinGrade: entity.GradeSenior, AtLeast: 1}
+
+	tests := []struct {
+		name      string
+		author    string
+		members   []string
+		required  int
+		rules     []entity.CompositionRule
+		reviewers []string
+		reassign  string
+		want      []string
+		wantErr   string
+	}{
+		{name: "senior_required", author: "a1", members: []string{"j1", "j2", "m1", "s1"}, required: 1, rules: []entity.CompositionRule{seniorRequired}, want: []string{"s1"}},
+		{name: "max_one_junior", author: "a1", members: []string{"j1", "j2", "m1", "s1"}, required: 3, rules: []entity.CompositionRule{{MaxGrade: entity.GradeJunior, AtMost: intPtr(1)}}, want: []string{"j", "m1", "s1"}},
+		{
+			name:     "junior_author_mentorship",
+			author:   "aj",
+			members:  []string{"j1", "m1", "s1"},
+			required: 2,
+			rules: []entity.CompositionRule{
+				{AuthorGrade: entity.GradeJunior, MinGrade: entity.GradeSenior, AtLeast: 1},
+				{AuthorGrade: entity.GradeJunior, MaxGrade: entity.GradeJunior, AtLeast: 1},
+			},
+			want: []string{"j1", "s1"},
+		},
+		{name: "rule_for_other_author_grade", author: "a1", members: []string{"j1", "j2"}, required: 2, rules: []entity.CompositionRule{{AuthorGrade: entity.GradeJunior, MinGrade: entity.GradeSenior, AtLeast: 1}}, want: []string{"j1", "j2"}},
+		{name: "unsatisfiable", author: "a1", members: []string{"j1", "m1"}, required: 2, rules: []entity.CompositionRule{seniorRequired}, wantErr: "need at least 1 reviewers with grade >= senior (have 0)"},
+		{name: "reassign_keeps_senior", author: "a1", members: []string{"j1", "m1", "s1", "s2"}, rules: []entity.CompositionRule{seniorRequired}, reviewers: []string{"s1", "m1"}, reassign: "s1", want: []string{"m1", "s2"}},
+		{name: "reassign_counts_existing", author: "a1", members: []string{"j1", "j2", "s1"}, rules: []entity.CompositionRule{seniorRequired}, reviewers: []string{"s1", "m1"}, reassign: "m1", want: []string{"j", "s1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *entity.PullRequest
+			prRepo := &mockPRRepo{
+				PRExistsFn: func(string) (bool, error) { return false, nil },
+				CreatePRFn: func(pr *entity.PullRequest) error {
+					created = pr
+					return nil
+				},
+				GetPRFn: func(string) (*entity.PullRequest, error) {
+					return &entity.PullRequest{ID: "p1", AuthorID: tt.author, Status: entity.StatusOpen, AssignedReviewers: tt.reviewers}, nil
+				},
+			}
+			userRepo := &mockUserRepo{
+				GetUserFn: func(id string) (*entity.User, error) { return users[id], nil },
+				GetActiveUsersByTeamFn: func(string) ([]*entity.User, error) {
+					members := make([]*entity.User, 0, len(tt.members))
+					for _, id := range tt.members {
+						members = append(members, users[id])
+					}
+					return members, nil
+				},
+			}
+			teamRepo := &mockTeamRepo{
+				GetTeamFn: func(string) (*entity.Team, error) { return &entity.Team{Name: "team1"}, nil },
+				GetPolicyFn: func(string) (*entity.TeamPolicy, error) {
+					return &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: tt.required, SelectionStrategy: entity.SelectionStrategyRandom, CompositionRules: tt.rules}, nil
+				},
+			}
+			svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+
+			for i := 0; i < 20; i++ {
+				var got []string
+				var err error
+				if tt.reassign != "" {
+					var pr *entity.PullRequest
+					pr, _, err = svc.ReassignReviewer("p1", tt.reassign)
+					if err == nil {
+						got = pr.AssignedReviewers
+					}
+				} else {
+					_, err = svc.CreatePR("p1", "n1", tt.author)
+					if err == nil {
+						got = created.AssignedReviewers
+					}
+				}
+
+				if tt.wantErr != "" {
+					var derr *entity.DomainError
+					if !errors.As(err, &derr) || derr.Code != entity.ErrorCodeNoCandidate || !strings.Contains(err.Error(), tt.wantErr) {
+						t.Fatalf("expected NO_CANDIDATE error %q, got %v", tt.wantErr, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				sorted := append([]string(nil), got...)
+				sort.Strings(sorted)
+				if len(sorted) != len(tt.want) {
+					t.Fatalf("expected reviewers %v, got %v", tt.want, got)
+				}
+				for j, prefix := range tt.want {
+					if !strings.HasPrefix(sorted[j], prefix) {
+						t.Fatalf("expected reviewers %v, got %v", tt.want, got)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPullRequestService_LabelPolicies(t *testing.T) {
+	users := map[string]*entity.User{
+		"a1":  {ID: "a1", Team: "team1", IsActive: true},
+		"r1":  {ID: "r1", Team: "team1", IsActive: true},
+		"r2":  {ID: "r2", Team: "team1", IsActive: true},
+		"r3":  {ID: "r3", Team: "team1", IsActive: true},
+		"sec": {ID: "sec", Team: "security", IsActive: true},
+	}
+	members := map[string][]string{
+		"team1":    {"a1", "r1", "r2", "r3"},
+		"security": {"sec"},
+	}
+
+	tests := []struct {
+		name         string
+		labels       []string
+		priority     entity.Priority
+		securityTeam string
+		maxOpen      int
+		want         []string
+		wantErr      string
+	}{
+		{name: "security_label_adds_security_reviewer", labels: []string{"Security"}, securityTeam: "security", want: []string{"r", "sec"}},
+		{name: "security_label_without_policy", labels: []string{"security"}, want: []string{"r", "r"}},
+		{name: "security_team_unavailable", labels: []string{"security"}, securityTeam: "security", maxOpen: 2, wantErr: "need at least 1 reviewers from team security (have 0)"},
+		{name: "capacity_excludes_busy_reviewers", maxOpen: 2, want: []string{"r2", "r3"}},
+		{name: "hotfix_bypasses_capacity", labels: []string{"hotfix"}, maxOpen: 1, want: []string{"r", "r"}},
+		{name: "capacity_exhausted", maxOpen: 1, want: []string{}},
+		{name: "invalid_priority", priority: "urgent", wantErr: "priority must be one of: low, normal, high"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *entity.PullRequest
+			prRepo := &mockPRRepo{
+				PRExistsFn: func(string) (bool, error) { return false, nil },
+				CreatePRFn: func(pr *entity.PullRequest) error {
+					created = pr
+					return nil
+				},
+				CountOpenReviewsFn: func([]string) (map[string]int, error) {
+					return map[string]int{"r1": 3, "r2": 1, "r3": 1, "sec": 5}, nil
+				},
+			}
+			userRepo := &mockUserRepo{
+				GetUserFn: func(id string) (*entity.User, error) { return users[id], nil },
+				GetActiveUsersByTeamFn: func(team string) ([]*entity.User, error) {
+					result := make([]*entity.User, 0)
+					for _, id := range members[team] {
+						result = append(result, users[id])
+					}
+					return result, nil
+				},
+			}
+			teamRepo := &mockTeamRepo{
+				GetTeamFn: func(string) (*entity.Team, error) { return &entity.Team{Name: "team1"}, nil },
+				GetPolicyFn: func(string) (*entity.TeamPolicy, error) {
+					return &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 2, SelectionStrategy: entity.SelectionStrategyRandom, SecurityTeam: tt.securityTeam, MaxOpenReviews: tt.maxOpen}, nil
+				},
+			}
+			svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+
+			_, err := svc.CreatePullRequest(&entity.PullRequest{ID: "p1", Name: "n1", AuthorID: "a1", Labels: tt.labels, Priority: tt.priority})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if created.Priority != entity.PriorityNormal {
+				t.Fatalf("expected default priority, got %q", created.Priority)
+			}
+
+			sorted := append([]string(nil), created.AssignedReviewers...)
+			sort.Strings(sorted)
+			if len(sorted) != len(tt.want) {
+				t.Fatalf("expected reviewers %v, got %v", tt.want, sorted)
+			}
+			for i, prefix := range tt.want {
+				if !strings.HasPrefix(sorted[i], prefix) {
+					t.Fatalf("expected reviewers %v, got %v", tt.want, sorted)
+				}
+			}
+		})
+	}
+}
+
+func TestPullRequestService_GetReviewPRsFilter(t *testing.T) {
+	prRepo := &mockPRRepo{GetPRsByReviewerFn: func(string) ([]*entity.PullRequest, error) {
+		return []*entity.PullRequest{
+			{ID: "p1", Labels: []string{"hotfix"}, Priority: entity.PriorityHigh},
+			{ID: "p2", Labels: []string{"Security", "hotfix"}, Priority: entity.PriorityNormal},
+			{ID: "p3", Priority: entity.PriorityLow},
+			{ID: "p4"},
+		}, nil
+	}}
+	userRepo := &mockUserRepo{GetUserFn: func(id string) (*entity.User, error) { return &entity.User{ID: id}, nil }}
+	svc := service.NewPullRequestService(prRepo, userRepo, &mockTeamRepo{}, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+
+	tests := []struct {
+		name   string
+		filter entity.ReviewQueueFilter
+		want   string
+	}{
+		{name: "no_filter", want: "p1,p2,p3,p4"},
+		{name: "label", filter: entity.ReviewQueueFilter{Labels: []string{"hotfix"}}, want: "p1,p2"},
+		{name: "all_labels", filter: entity.ReviewQueueFilter{Labels: []string{"hotfix", "security"}}, want: "p2"},
+		{name: "priority", filter: entity.ReviewQueueFilter{Priority: entity.PriorityHigh}, want: "p1"},
+		{name: "default_priority", filter: entity.ReviewQueueFilter{Priority: entity.PriorityNormal}, want: "p2,p4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prs, err := svc.GetReviewPRs("u1", tt.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ids := make([]string, 0, len(prs))
+			for _, pr := range prs {
+				ids = append(ids, pr.ID)
+			}
+			if got := strings.Join(ids, ","); got != tt.want {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPullRequestService_UpdatePullRequest(t *testing.T) {
+	name := "renamed"
+	description := "handover"
+	labels := []string{"hotfix"}
+	author := func(id string) *string { return &id }
+	cyrillicMax := strings.Repeat("я", config.MaxDescriptionLength)
+	cyrillicTooLong := cyrillicMax + "я"
+
+	tests := []struct {
+		name       string
+		status     entity.Status
+		version    int
+		changes    entity.PullRequestChanges
+		repoResult bool
+		want       []string
+		wantAuthor string
+		wantErr    entity.ErrorCode
+	}{
+		{name: "metadata", version: 3, changes: entity.PullRequestChanges{Name: &name, Description: &description, Labels: &labels}, repoResult: true, want: []string{"r1", "r2"}, wantAuthor: "a1"},
+		{name: "multibyte_description_at_limit", version: 3, changes: entity.PullRequestChanges{Description: &cyrillicMax}, repoResult: true, want: []string{"r1", "r2"}, wantAuthor: "a1"},
+		{name: "description_too_long", version: 3, changes: entity.PullRequestChanges{Description: &cyrillicTooLong}, wantErr: entity.ErrorCodeValidationFailed},
+		{name: "author_not_reviewer", version: 3, changes: entity.PullRequestChanges{AuthorID: author("r3")}, repoResult: true, want: []string{"r1", "r2"}, wantAuthor: "r3"},
+		{name: "author_was_reviewer", version: 3, changes: entity.PullRequestChanges{AuthorID: author("r1")}, repoResult: true, want: []string{"r2", "r3"}, wantAuthor: "r1"},
+		{name: "unknown_author", version: 3, changes: entity.PullRequestChanges{AuthorID: author("ghost")}, wantErr: entity.ErrorCodeNotFound},
+		{name: "stale_version", version: 2, changes: entity.PullRequestChanges{Name: &name}, wantErr: entity.ErrorCodeVersionConflict},
+		{name: "concurrent_update", version: 3, changes: entity.PullRequestChanges{Name: &name}, repoResult: false, wantErr: entity.ErrorCodeVersionConflict},
+		{name: "merged", status: entity.StatusMerged, version: 3, changes: entity.PullRequestChanges{Name: &name}, wantErr: entity.ErrorCodePRMerged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := tt.status
+			if status == "" {
+				status = entity.StatusOpen
+			}
+			var saved *entity.PullRequest
+			var savedVersion int
+			prRepo := &mockPRRepo{
+				GetPRFn: func(string) (*entity.PullRequest, error) {
+					return &entity.PullRequest{ID: "p1", Name: "old", AuthorID: "a1", Status: status, AssignedReviewers: []string{"r1", "r2"}, Version: 3}, nil
+				},
+				UpdatePRMetadataFn: func(pr *entity.PullRequest, version int) (bool, error) {
+					saved, savedVersion = pr, version
+					if tt.repoResult {
+						pr.Version = version + 1
+					}
+					return tt.repoResult, nil
+				},
+			}
+			userRepo := &mockUserRepo{
+				GetUserFn: func(id string) (*entity.User, error) {
+					if id == "ghost" {
+						return nil, nil
+					}
+					return &entity.User{ID: id, Team: "team1", IsActive: true}, nil
+				},
+				GetActiveUsersByTeamFn: func(string) ([]*entity.User, error) { return makeMembers("r1", "r2", "r3"), nil },
+			}
+			teamRepo := &mockTeamRepo{GetTeamFn: func(string) (*entity.Team, error) { return &entity.Team{Name: "team1"}, nil }}
+			svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+
+			pr, err := svc.UpdatePullRequest("p1", tt.changes, tt.version)
+			if tt.wantErr != "" {
+				var derr *entity.DomainError
+				if !errors.As(err, &derr) || derr.Code != tt.wantErr {
+					t.Fatalf("expected %s error, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if saved == nil || savedVersion != tt.version || pr.Version != tt.version+1 {
+				t.Fatalf("expected update with version %d, got %d (pr version %d)", tt.version, savedVersion, pr.Version)
+			}
+			if pr.AuthorID != tt.wantAuthor {
+				t.Fatalf("expected author %s, got %s", tt.wantAuthor, pr.AuthorID)
+			}
+			if tt.changes.Name != nil && (pr.Name != name || pr.Description != description || strings.Join(pr.Labels, ",") != "hotfix") {
+				t.Fatalf("expected metadata to be updated, got %+v", pr)
+			}
+
+			sorted := append([]string(nil), pr.AssignedReviewers...)
+			sort.Strings(sorted)
+			if strings.Join(sorted, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("expected reviewers %v, got %v", tt.want, sorted)
+			}
+		})
+	}
+}
+
+func TestPullRequestService_HierarchyFallback(t *testing.T) {
+	parents := map[string]string{"eng": "", "backend": "eng", "frontend": "eng"}
+	teamRepo := hierarchyTeamRepo(parents)
+	teamRepo.GetPolicyFn = func(string) (*entity.TeamPolicy, error) {
+		return &entity.TeamPolicy{TeamName: "backend", RequiredReviewers: 2, HierarchyFallback: true}, nil
+	}
+	userRepo := &mockUserRepo{
+		GetUserFn: func(id string) (*entity.User, error) { return &entity.User{ID: id, Team: "backend", IsActive: true}, nil },
+		GetActiveUsersByTeamFn: func(team string) ([]*entity.User, error) {
+			switch team {
+			case "backend":
+				return []*entity.User{{ID: "a1", Team: "backend", IsActive: true}, {ID: "b1", Team: "backend", IsActive: true}}, nil
+			case "frontend":
+				return []*entity.User{{ID: "f1", Team: "frontend", IsActive: true}}, nil
+			}
+			return nil, nil
+		},
+	}
+	var created *entity.PullRequest
+	prRepo := &mockPRRepo{
+		PRExistsFn: func(string) (bool, error) { return false, nil },
+		CreatePRFn: func(pr *entity.PullRequest) error {
+			created = pr
+			return nil
+		},
+	}
+	svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+
+	if _, err := svc.CreatePullRequest(&entity.PullRequest{ID: "p1", Name: "n1", AuthorID: "a1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(created.AssignedReviewers, ",") != "b1,f1" {
+		t.Fatalf("expected own team first then sibling team, got %v", created.AssignedReviewers)
+	}
+}
@@ -0,0 +1,196 @@
+package service_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"pr-review/internal/entity"
+	"pr-review/internal/service"
+)
+
+type mockRepositoryRepo struct {
+	SaveRepositoryFn func(*entity.Repository) error
+	GetRepositoryFn  func(string) (*entity.Repository, error)
+	SetCodeOwnersFn  func(string, string) error
+}
+
+func (m *mockRepositoryRepo) SaveRepository(repository *entity.Repository) error {
+	if m.SaveRepositoryFn != nil {
+		return m.SaveRepositoryFn(repository)
+	}
+	return nil
+}
+func (m *mockRepositoryRepo) GetRepository(name string) (*entity.Repository, error) {
+	if m.GetRepositoryFn != nil {
+		return m.GetRepositoryFn(name)
+	}
+	return nil, nil
+}
+func (m *mockRepositoryRepo) SetCodeOwners(name, content string) error {
+	if m.SetCodeOwnersFn != nil {
+		return m.SetCodeOwnersFn(name, content)
+	}
+	return nil
+}
+
+func TestRepositoryService_UploadCodeOwners(t *testing.T) {
+	tests := []struct {
+		name       string
+		repository string
+		content    string
+		wantErr    bool
+		errMsg     string
+	}{
+		{name: "invalid_syntax", repository: "api", content: "*.go @backend\n!*.md @docs", wantErr: true, errMsg: "invalid CODEOWNERS file: line 2"},
+		{name: "repository_not_found", repository: "missing", content: "* @lead", wantErr: true, errMsg: "repository not found"},
+		{name: "too_large", repository: "api", content: strings.Repeat("#", 64*1024+1), wantErr: true, errMsg: "cannot exceed 64 KiB"},
+		{name: "success", repository: "api", content: "* @lead\n/db/ @acme/dba"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved string
+			repo := &mockRepositoryRepo{
+				GetRepositoryFn: func(name string) (*entity.Repository, error) {
+					if name != "api" {
+						return nil, nil
+					}
+					return &entity.Repository{Name: name, CodeOwners: saved}, nil
+				},
+				SetCodeOwnersFn: func(name, content string) error {
+					saved = content
+					return nil
+				},
+			}
+			svc := service.NewRepositoryService(repo, &mockTeamRepo{})
+
+			repository, err := svc.UploadCodeOwners(tt.repository, tt.content)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("expected error %q, got %v", tt.errMsg, err)
+				}
+				if saved != "" {
+					t.Fatalf("CODEOWNERS must not be saved on error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if repository.CodeOwners != tt.content {
+				t.Fatalf("expected stored CODEOWNERS, got %q", repository.CodeOwners)
+			}
+		})
+	}
+}
+
+func TestRepositoryService_SaveRepositoryUnknownTeam(t *testing.T) {
+	svc := service.NewRepositoryService(&mockRepositoryRepo{}, &mockTeamRepo{})
+	_, err := svc.SaveRepository(&entity.Repository{Name: "api", TeamName: "ghosts"})
+	if err == nil || !strings.Contains(err.Error(), "team not found") {
+		t.Fatalf("expected team not found error, got %v", err)
+	}
+}
+
+func TestPullRequestService_CodeOwners(t *testing.T) {
+	users := map[string]*entity.User{
+		"a1":    {ID: "a1", Team: "team1", IsActive: true},
+		"alice": {ID: "alice", Team: "platform", IsActive: true},
+		"bob":   {ID: "bob", Team: "platform", IsActive: false},
+		"d1":    {ID: "d1", Team: "dba", IsActive: true},
+		"d2":    {ID: "d2", Team: "dba", IsActive: true},
+	}
+	members := map[string][]*entity.User{
+		"team1": makeMembers("a1", "r1", "r2"),
+		"dba":   {users["d1"], users["d2"]},
+	}
+	repositories := map[string]*entity.Repository{
+		"api": {Name: "api", CodeOwners: "* @a1\n/internal/ @alice @bob\n*.sql @acme/dba\n"},
+	}
+
+	tests := []struct {
+		name      string
+		files     []string
+		repo      string
+		reviewers []string
+		reassign  string
+		want      []string
+		wantErr   string
+	}{
+		{name: "owner_first_then_team", repo: "api", files: []string{"internal/service.go"}, want: []string{"alice", "r"}},
+		{name: "team_owners", repo: "api", files: []string{"db/001.sql"}, want: []string{"d1", "d2"}},
+		{name: "author_only_owner", repo: "api", files: []string{"README.md"}, want: []string{"r1", "r2"}},
+		{name: "no_repository", files: []string{"internal/service.go"}, want: []string{"r1", "r2"}},
+		{name: "unknown_repository", repo: "web", files: []string{"index.js"}, wantErr: "repository not found"},
+		{name: "empty_path", repo: "api", files: []string{" "}, wantErr: "changed_files cannot contain empty paths"},
+		{name: "reassign_prefers_owner", repo: "api", files: []string{"db/001.sql"}, reviewers: []string{"d1", "r1"}, reassign: "r1", want: []string{"d1", "d2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *entity.PullRequest
+			prRepo := &mockPRRepo{
+				PRExistsFn: func(string) (bool, error) { return false, nil },
+				CreatePRFn: func(pr *entity.PullRequest) error {
+					created = pr
+					return nil
+				},
+				GetPRFn: func(string) (*entity.PullRequest, error) {
+					return &entity.PullRequest{ID: "p1", AuthorID: "a1", Status: entity.StatusOpen, AssignedReviewers: tt.reviewers, Repository: tt.repo, ChangedFiles: tt.files}, nil
+				},
+			}
+			userRepo := &mockUserRepo{
+				GetUserFn: func(id string) (*entity.User, error) {
+					if user, ok := users[id]; ok {
+						return user, nil
+					}
+					return &entity.User{ID: id, Team: "team1", IsActive: true}, nil
+				},
+				GetActiveUsersByTeamFn: func(team string) ([]*entity.User, error) { return members[team], nil },
+			}
+			teamRepo := &mockTeamRepo{GetTeamFn: func(string) (*entity.Team, error) { return &entity.Team{Name: "team1"}, nil }}
+			repositoryRepo := &mockRepositoryRepo{GetRepositoryFn: func(name string) (*entity.Repository, error) { return repositories[name], nil }}
+			svc := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, repositoryRepo, &mockExpertiseRepo{})
+
+			var got []string
+			var err error
+			if tt.reassign != "" {
+				var pr *entity.PullRequest
+				pr, _, err = svc.ReassignReviewer("p1", tt.reassign)
+				if err == nil {
+					got = pr.AssignedReviewers
+				}
+			} else {
+				_, err = svc.CreatePullRequest(&entity.PullRequest{ID: "p1", Name: "n1", AuthorID: "a1", Repository: tt.repo, ChangedFiles: tt.files})
+				if err == nil {
+					got = created.AssignedReviewers
+					if created.Repository != tt.repo || len(created.ChangedFiles) != len(tt.files) {
+						t.Fatalf("expected repository and changed files to be stored, got %+v", created)
+					}
+				}
+			}
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			sorted := append([]string(nil), got...)
+			sort.Strings(sorted)
+			if len(sorted) != len(tt.want) {
+				t.Fatalf("expected reviewers %v, got %v", tt.want, got)
+			}
+			for i, prefix := range tt.want {
+				if !strings.HasPrefix(sorted[i], prefix) {
+					t.Fatalf("expected reviewers %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package service_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-review/internal/entity"
+	"pr-review/internal/service"
+)
+
+type mockSLARepo struct {
+	GetSettingsFn        func(string) (*entity.SLASettings, error)
+	SaveSettingsFn       func(*entity.SLASettings) error
+	ListSettingsFn       func() ([]*entity.SLASettings, error)
+	FindReviewBreachesFn func(string, time.Time) ([]*entity.SLABreach, error)
+	FindMergeBreachesFn  func(string, time.Time) ([]*entity.SLABreach, error)
+	RecordBreachFn       func(*entity.SLABreach) error
+	MarkReassignedFn     func(int64, string) error
+	ResolveBreachesFn    func(time.Time) error
+	ListBreachesFn       func(entity.SLABreachFilter) ([]*entity.SLABreach, error)
+}
+
+func (m *mockSLARepo) GetSettings(teamName string) (*entity.SLASettings, error) {
+	if m.GetSettingsFn != nil {
+		return m.GetSettingsFn(teamName)
+	}
+	return nil, nil
+}
+func (m *mockSLARepo) SaveSettings(settings *entity.SLASettings) error {
+	if m.SaveSettingsFn != nil {
+		return m.SaveSettingsFn(settings)
+	}
+	return nil
+}
+func (m *mockSLARepo) ListSettings() ([]*entity.SLASettings, error) {
+	if m.ListSettingsFn != nil {
+		return m.ListSettingsFn()
+	}
+	return nil, nil
+}
+func (m *mockSLARepo) FindReviewBreaches(teamName string, assignedBefore time.Time) ([]*entity.SLABreach, error) {
+	if m.FindReviewBreachesFn != nil {
+		return m.FindReviewBreachesFn(teamName, assignedBefore)
+	}
+	return nil, nil
+}
+func (m *mockSLARepo) FindMergeBreaches(teamName string, createdBefore time.Time) ([]*entity.SLABreach, error) {
+	if m.FindMergeBreachesFn != nil {
+		return m.FindMergeBreachesFn(teamName, createdBefore)
+	}
+	return nil, nil
+}
+func (m *mockSLARepo) RecordBreach(breach *entity.SLABreach) error {
+	if m.RecordBreachFn != nil {
+		return m.RecordBreachFn(breach)
+	}
+	return nil
+}
+func (m *mockSLARepo) MarkReassigned(breachID int64, newReviewerID string) error {
+	if m.MarkReassignedFn != nil {
+		return m.MarkReassignedFn(breachID, newReviewerID)
+	}
+	return nil
+}
+func (m *mockSLARepo) ResolveBreaches(at time.Time) error {
+	if m.ResolveBreachesFn != nil {
+		return m.ResolveBreachesFn(at)
+	}
+	return nil
+}
+func (m *mockSLARepo) ListBreaches(filter entity.SLABreachFilter) ([]*entity.SLABreach, error) {
+	if m.ListBreachesFn != nil {
+		return m.ListBreachesFn(filter)
+	}
+	return nil, nil
+}
+
+type recordingPublisher struct {
+	published []*entity.SLABreach
+}
+
+func (p *recordingPublisher) PublishEscalation(breach *entity.SLABreach) error {
+	p.published = append(p.published, breach)
+	return nil
+}
+
+func TestSLAService_SetSettings(t *testing.T) {
+	teamExists := &mockTeamRepo{TeamExistsFn: func(string) (bool, error) { return true, nil }}
+
+	tests := []struct {
+		name     string
+		settings *entity.SLASettings
+		teamRepo *mockTeamRepo
+		slaRepo  *mockSLARepo
+		wantErr  bool
+		errMsg   string
+	}{
+		{name: "empty_team", settings: &entity.SLASettings{}, wantErr: true, errMsg: "team_name cannot be empty"},
+		{name: "negative_first_review", settings: &entity.SLASettings{TeamName: "t1", FirstReviewMinutes: -1}, wantErr: true, errMsg: "time_to_first_review_minutes must be between"},
+		{name: "merge_too_long", settings: &entity.SLASettings{TeamName: "t1", MergeMinutes: 10 * 365 * 24 * 60}, wantErr: true, errMsg: "time_to_merge_minutes must be between"},
+		{name: "team_not_found", settings: &entity.SLASettings{TeamName: "t1", FirstReviewMinutes: 60}, teamRepo: &mockTeamRepo{}, wantErr: true, errMsg: "team not found"},
+		{name: "save_error", settings: &entity.SLASettings{TeamName: "t1", FirstReviewMinutes: 60}, teamRepo: teamExists, slaRepo: &mockSLARepo{SaveSettingsFn: func(*entity.SLASettings) error { return errors.New("save failed") }}, wantErr: true, errMsg: "save failed"},
+		{name: "success", settings: &entity.SLASettings{TeamName: "t1", FirstReviewMinutes: 60, MergeMinutes: 2880, AutoReassign: true}, teamRepo: teamExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamRepo := tt.teamRepo
+			if teamRepo == nil {
+				teamRepo = &mockTeamRepo{}
+			}
+			slaRepo := tt.slaRepo
+			if slaRepo == nil {
+				slaRepo = &mockSLARepo{}
+			}
+			prService := service.NewPullRequestService(&mockPRRepo{}, &mockUserRepo{}, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+			svc := service.NewSLAService(slaRepo, teamRepo, prService, &recordingPublisher{})
+
+			settings, err := svc.SetSettings(tt.settings)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if settings.TeamName != tt.settings.TeamName {
+				t.Fatalf("expected settings for %s, got %+v", tt.settings.TeamName, settings)
+			}
+		})
+	}
+}
+
+func TestSLAService_CheckBreaches(t *testing.T) {
+	now := time.Now().UTC()
+	assignedAt := now.Add(-3 * time.Hour)
+	createdAt := now.Add(-72 * time.Hour)
+
+	tests := []struct {
+		name           string
+		autoReassign   bool
+		candidates     []*entity.User
+		wantReassigned string
+	}{
+		{name: "escalates_without_reassign", autoReassign: false},
+		{name: "auto_reassigns_stale_reviewer", autoReassign: true, candidates: makeMembers("r1", "r2", "r3"), wantReassigned: "r3"},
+		{name: "no_candidate_keeps_breach", autoReassign: true, candidates: makeMembers("r1", "r2")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var recorded []*entity.SLABreach
+			markedReassigned := map[int64]string{}
+			slaRepo := &mockSLARepo{
+				ListSettingsFn: func() ([]*entity.SLASettings, error) {
+					return []*entity.SLASettings{{TeamName: "team1", FirstReviewMinutes: 60, MergeMinutes: 48 * 60, AutoReassign: tt.autoReassign}}, nil
+				},
+				FindReviewBreachesFn: func(team string, before time.Time) ([]*entity.SLABreach, error) {
+					if !before.Equal(now.Add(-time.Hour)) {
+						return nil, errors.New("unexpected review cutoff")
+					}
+					return []*entity.SLABreach{{Kind: entity.SLABreachFirstReview, PullRequestID: "p1", ReviewerID: "r1", TeamName: team, StartedAt: assignedAt}}, nil
+				},
+				FindMergeBreachesFn: func(team string, before time.Time) ([]*entity.SLABreach, error) {
+					return []*entity.SLABreach{{Kind: entity.SLABreachMerge, PullRequestID: "p1", TeamName: team, StartedAt: createdAt}}, nil
+				},
+				RecordBreachFn: func(b *entity.SLABreach) error {
+					b.ID = int64(len(recorded) + 1)
+					recorded = append(recorded, b)
+					return nil
+				},
+				MarkReassignedFn: func(id int64, reviewer string) error {
+					markedReassigned[id] = reviewer
+					return nil
+				},
+			}
+			prRepo := &mockPRRepo{GetPRFn: func(string) (*entity.PullRequest, error) {
+				return &entity.PullRequest{ID: "p1", AuthorID: "a1", Status: entity.StatusOpen, AssignedReviewers: []string{"r1", "r2"}}, nil
+			}}
+			userRepo := &mockUserRepo{
+				GetUserFn:              func(id string) (*entity.User, error) { return &entity.User{ID: id, Team: "team1", IsActive: true}, nil },
+				GetActiveUsersByTeamFn: func(string) ([]*entity.User, error) { return tt.candidates, nil },
+			}
+			publisher := &recordingPublisher{}
+			prService := service.NewPullRequestService(prRepo, userRepo, &mockTeamRepo{}, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+			svc := service.NewSLAService(slaRepo, &mockTeamRepo{}, prService, publisher)
+
+			breaches, err := svc.CheckBreaches(now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(breaches) != 2 || len(recorded) != 2 || len(publisher.published) != 2 {
+				t.Fatalf("expected 2 breaches recorded and published, got %d/%d/%d", len(breaches), len(recorded), len(publisher.published))
+			}
+			if !recorded[0].Deadline.Equal(assignedAt.Add(time.Hour)) {
+				t.Fatalf("unexpected first review deadline %v", recorded[0].Deadline)
+			}
+			if !recorded[1].Deadline.Equal(createdAt.Add(48 * time.Hour)) {
+				t.Fatalf("unexpected merge deadline %v", recorded[1].Deadline)
+			}
+			if got := markedReassigned[recorded[0].ID]; got != tt.wantReassigned {
+				t.Fatalf("expected reassignment to %q, got %q", tt.wantReassigned, got)
+			}
+			if _, ok := markedReassigned[recorded[1].ID]; ok {
+				t.Fatalf("merge breaches must not trigger reassignment")
+			}
+		})
+	}
+}
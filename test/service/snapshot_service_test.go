@@ -0,0 +1,206 @@
+package service_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/service"
+	"pr-review/internal/snapshot"
+)
+
+type mockSnapshotRepo struct {
+	SchemaVersionFn func() (int, error)
+	CountRowsFn     func([]string) (map[string]int64, error)
+	DumpFn          func([]string) ([]*entity.SnapshotTable, error)
+	RestoreFn       func([]*entity.SnapshotTable) error
+}
+
+func (m *mockSnapshotRepo) SchemaVersion() (int, error) {
+	if m.SchemaVersionFn != nil {
+		return m.SchemaVersionFn()
+	}
+	return config.SchemaVersion, nil
+}
+func (m *mockSnapshotRepo) CountRows(tables []string) (map[string]int64, error) {
+	if m.CountRowsFn != nil {
+		return m.CountRowsFn(tables)
+	}
+	return map[string]int64{}, nil
+}
+func (m *mockSnapshotRepo) Dump(tables []string) ([]*entity.SnapshotTable, error) {
+	if m.DumpFn != nil {
+		return m.DumpFn(tables)
+	}
+	return nil, nil
+}
+func (m *mockSnapshotRepo) Restore(tables []*entity.SnapshotTable) error {
+	if m.RestoreFn != nil {
+		return m.RestoreFn(tables)
+	}
+	return nil
+}
+
+func dumpTables(tables []string) ([]*entity.SnapshotTable, error) {
+	result := make([]*entity.SnapshotTable, 0, len(tables))
+	for _, name := range tables {
+		result = append(result, &entity.SnapshotTable{
+			Name:    name,
+			Columns: []string{"org_id"},
+			Rows:    1,
+			Data:    []byte("default\n"),
+		})
+	}
+	return result, nil
+}
+
+func createSnapshot(t *testing.T, repo *mockSnapshotRepo) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if _, err := service.NewSnapshotService(repo).Create(&buf); err != nil {
+		t.Fatalf("create snapshot: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestSnapshotService_CreateAndRestore(t *testing.T) {
+	archive := createSnapshot(t, &mockSnapshotRepo{DumpFn: dumpTables})
+
+	var restored []*entity.SnapshotTable
+	repo := &mockSnapshotRepo{
+		CountRowsFn: func(tables []string) (map[string]int64, error) {
+			return map[string]int64{"organizations": 1}, nil
+		},
+		RestoreFn: func(tables []*entity.SnapshotTable) error {
+			restored = tables
+			return nil
+		},
+	}
+
+	manifest, err := service.NewSnapshotService(repo).Restore(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if manifest.SchemaVersion != config.SchemaVersion {
+		t.Fatalf("expected schema version %d, got %d", config.SchemaVersion, manifest.SchemaVersion)
+	}
+	if len(restored) != len(snapshot.Tables) {
+		t.Fatalf("expected %d restored tables, got %d", len(snapshot.Tables), len(restored))
+	}
+	for i, table := range restored {
+		if table.Name != snapshot.Tables[i] || string(table.Data) != "default\n" {
+			t.Fatalf("table %d: unexpected %+v", i, table)
+		}
+	}
+}
+
+func TestSnapshotService_CreateSchemaMismatch(t *testing.T) {
+	dumped := false
+	repo := &mockSnapshotRepo{
+		SchemaVersionFn: func() (int, error) { return config.SchemaVersion - 1, nil },
+		DumpFn: func(tables []string) ([]*entity.SnapshotTable, error) {
+			dumped = true
+			return dumpTables(tables)
+		},
+	}
+
+	var buf bytes.Buffer
+	_, err := service.NewSnapshotService(repo).Create(&buf)
+	if !errors.Is(err, snapshot.ErrSchemaMismatch) {
+		t.Fatalf("expected schema mismatch, got %v", err)
+	}
+	if dumped || buf.Len() > 0 {
+		t.Fatal("expected nothing to be dumped")
+	}
+}
+
+func TestSnapshotService_RestoreRejected(t *testing.T) {
+	tests := []struct {
+		name          string
+		archive       func(t *testing.T) []byte
+		targetVersion int
+		counts        map[string]int64
+		wantErr       error
+	}{
+		{
+			name: "archive_schema_version",
+			archive: func(t *testing.T) []byte {
+				var buf bytes.Buffer
+				tables, _ := dumpTables(snapshot.Tables)
+				manifest := &entity.SnapshotManifest{SchemaVersion: config.SchemaVersion - 1, Tables: tables}
+				if err := snapshot.Write(&buf, manifest); err != nil {
+					t.Fatalf("write: %v", err)
+				}
+				return buf.Bytes()
+			},
+			wantErr: snapshot.ErrSchemaMismatch,
+		},
+		{
+			name:          "target_schema_version",
+			targetVersion: config.SchemaVersion + 1,
+			wantErr:       snapshot.ErrSchemaMismatch,
+		},
+		{
+			name:    "target_not_empty",
+			counts:  map[string]int64{"organizations": 1, "pull_requests": 3},
+			wantErr: snapshot.ErrDatabaseNotEmpty,
+		},
+		{
+			name: "missing_table",
+			archive: func(t *testing.T) []byte {
+				return createSnapshot(t, &mockSnapshotRepo{
+					DumpFn: func(tables []string) ([]*entity.SnapshotTable, error) {
+						return dumpTables(tables[:len(tables)-1])
+					},
+				})
+			},
+			wantErr: snapshot.ErrUnsupportedFormat,
+		},
+		{
+			name: "corrupted",
+			archive: func(t *testing.T) []byte {
+				archive := createSnapshot(t, &mockSnapshotRepo{DumpFn: dumpTables})
+				return archive[:len(archive)/2]
+			},
+			wantErr: snapshot.ErrUnsupportedFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var archive []byte
+			if tt.archive != nil {
+				archive = tt.archive(t)
+			} else {
+				archive = createSnapshot(t, &mockSnapshotRepo{DumpFn: dumpTables})
+			}
+
+			restored := false
+			repo := &mockSnapshotRepo{
+				SchemaVersionFn: func() (int, error) {
+					if tt.targetVersion != 0 {
+						return tt.targetVersion, nil
+					}
+					return config.SchemaVersion, nil
+				},
+				CountRowsFn: func([]string) (map[string]int64, error) {
+					return tt.counts, nil
+				},
+				RestoreFn: func([]*entity.SnapshotTable) error {
+					restored = true
+					return nil
+				},
+			}
+
+			_, err := service.NewSnapshotService(repo).Restore(bytes.NewReader(archive))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if restored {
+				t.Fatal("expected restore not to run")
+			}
+		})
+	}
+}
@@ -15,9 +15,16 @@ var validMember = entity.User{ID: "u1", Name: "n1", Team: "team1", IsActive: tru
 
 
 type mockTeamRepo struct {
-	CreateTeamFn func(*entity.Team) error
-	GetTeamFn    func(string) (*entity.Team, error)
-	TeamExistsFn func(string) (bool, error)
+	CreateTeamFn       func(*entity.Team) error
+	GetTeamFn          func(string) (*entity.Team, error)
+	TeamExistsFn       func(string) (bool, error)
+	SetReviewerPoolsFn func(string, []string, []string) error
+	GetPolicyFn        func(string) (*entity.TeamPolicy, error)
+	SavePolicyFn       func(*entity.TeamPolicy) error
+	SetParentTeamFn    func(string, string) error
+	GetSubTeamsFn      func(string) ([]string, error)
+	GetTeamAncestorsFn func(string, int) ([]string, error)
+	GetTeamStatsFn     func([]string) (*entity.TeamStats, error)
 }
 
 func (m *mockTeamRepo) CreateTeam(team *entity.Team) error {
@@ -38,6 +45,48 @@ func (m *mockTeamRepo) TeamExists(name string) (bool, error) {
 	}
 	return false, nil
 }
+func (m *mockTeamRepo) SetReviewerPools(name string, fallbackTeams, sharedReviewers []string) error {
+	if m.SetReviewerPoolsFn != nil {
+		return m.SetReviewerPoolsFn(name, fallbackTeams, sharedReviewers)
+	}
+	return nil
+}
+func (m *mockTeamRepo) GetPolicy(name string) (*entity.TeamPolicy, error) {
+	if m.GetPolicyFn != nil {
+		return m.GetPolicyFn(name)
+	}
+	return nil, nil
+}
+func (m *mockTeamRepo) SavePolicy(policy *entity.TeamPolicy) error {
+	if m.SavePolicyFn != nil {
+		return m.SavePolicyFn(policy)
+	}
+	return nil
+}
+func (m *mockTeamRepo) SetParentTeam(name, parentTeam string) error {
+	if m.SetParentTeamFn != nil {
+		return m.SetParentTeamFn(name, parentTeam)
+	}
+	return nil
+}
+func (m *mockTeamRepo) GetSubTeams(name string) ([]string, error) {
+	if m.GetSubTeamsFn != nil {
+		return m.GetSubTeamsFn(name)
+	}
+	return nil, nil
+}
+func (m *mockTeamRepo) GetTeamAncestors(name string, maxDepth int) ([]string, error) {
+	if m.GetTeamAncestorsFn != nil {
+		return m.GetTeamAncestorsFn(name, maxDepth)
+	}
+	return nil, nil
+}
+func (m *mockTeamRepo) GetTeamStats(teamNames []string) (*entity.TeamStats, error) {
+	if m.GetTeamStatsFn != nil {
+		return m.GetTeamStatsFn(teamNames)
+	}
+	return &entity.TeamStats{}, nil
+}
 
 func TestTeamService_AddTeam(t *testing.T) {
 
@@ -59,7 +108,7 @@ func TestTeamService_AddTeam(t *testing.T) {
 		{name: "member_team_mismatch", team: &entity.Team{Name: "team1", Members: []entity.User{{ID: "u", Name: "n", Team: "other"}}}, wantErr: true, errMsg: "member team_name must match team name"},
 		{name: "team_exists_check_error", team: &entity.Team{Name: "team1", Members: []entity.User{validMember}}, teamRepo: &mockTeamRepo{TeamExistsFn: func(string) (bool, error) { return false, errors.New("exists err") }}, wantErr: true, errMsg: "exists err"},
 		{name: "team_already_exists", team: &entity.Team{Name: "team1", Members: []entity.User{validMember}}, teamRepo: &mockTeamRepo{TeamExistsFn: func(string) (bool, error) { return true, nil }}, wantErr: true, errMsg: "team_name already exists"},
-		{name: "user_create_error", team: &entity.Team{Name: "team1", Members: []entity.User{validMember}}, teamRepo: &mockTeamRepo{TeamExistsFn: func(string) (bool, error) { return false, nil }}, userRepo: &mockUserRepo{CreateOrUpdateUserFn: func(_ *entity.User) error { return errors.New("create user failed") }}, wantErr: true, errMsg: "create user failed"},
+		{name: "member_deleted", team: &entity.Team{Name: "team1", Members: []entity.User{validMember}}, userRepo: &mockUserRepo{GetDeletedUserIDsFn: func(ids []string) ([]string, error) { return ids, nil }}, wantErr: true, errMsg: "has been deleted"},
 		{name: "create_team_error", team: &entity.Team{Name: "team1", Members: []entity.User{validMember}}, teamRepo: &mockTeamRepo{TeamExistsFn: func(string) (bool, error) { return false, nil }, CreateTeamFn: func(_ *entity.Team) error { return errors.New("create team failed") }}, wantErr: true, errMsg: "create team failed"},
 		{name: "success", team: &entity.Team{Name: "team1", Members: []entity.User{validMember}}, teamRepo: &mockTeamRepo{TeamExistsFn: func(string) (bool, error) { return false, nil }}, userRepo: &mockUserRepo{CreateOrUpdateUserFn: func(_ *entity.User) error { return nil }}, wantErr: false},
 	}
@@ -102,6 +151,39 @@ func TestTeamService_AddTeam(t *testing.T) {
 	}
 }
 
+func TestTeamService_AddTeam_SingleRepositoryCall(t *testing.T) {
+	var created *entity.Team
+	teamRepo := &mockTeamRepo{
+		TeamExistsFn: func(name string) (bool, error) { return name == "backup", nil },
+		CreateTeamFn: func(team *entity.Team) error {
+			created = team
+			return nil
+		},
+		SetReviewerPoolsFn: func(string, []string, []string) error {
+			t.Fatalf("reviewer pools must be saved together with the team")
+			return nil
+		},
+	}
+	userRepo := &mockUserRepo{CreateOrUpdateUserFn: func(*entity.User) error {
+		t.Fatalf("members must be saved together with the team")
+		return nil
+	}}
+	svc := service.NewTeamService(teamRepo, userRepo)
+
+	team := &entity.Team{
+		Name:            "team1",
+		Members:         []entity.User{{ID: "u1", Name: "Alice", Team: "team1", IsActive: true}, {ID: "u2", Name: "Bob", Team: "team1", IsActive: true}},
+		FallbackTeams:   []string{"backup"},
+		SharedReviewers: []string{"u2"},
+	}
+	if err := svc.AddTeam(team); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created == nil || len(created.Members) != 2 || strings.Join(created.FallbackTeams, ",") != "backup" || strings.Join(created.SharedReviewers, ",") != "u2" {
+		t.Fatalf("expected members and reviewer pools in CreateTeam, got %+v", created)
+	}
+}
+
 func TestTeamService_GetTeam(t *testing.T) {
 	longName := strings.Repeat("a", 256)
 
@@ -152,3 +234,287 @@ func TestTeamService_GetTeam(t *testing.T) {
 		})
 	}
 }
+
+func TestTeamService_SetReviewerPools(t *testing.T) {
+	existingTeams := map[string]bool{"team1": true, "backup": true}
+	teamRepo := func(saved *[]string) *mockTeamRepo {
+		return &mockTeamRepo{
+			GetTeamFn: func(name string) (*entity.Team, error) {
+				if !existingTeams[name] {
+					return nil, nil
+				}
+				return &entity.Team{Name: name}, nil
+			},
+			TeamExistsFn: func(name string) (bool, error) { return existingTeams[name], nil },
+			SetReviewerPoolsFn: func(_ string, fallbackTeams, _ []string) error {
+				*saved = fallbackTeams
+				return nil
+			},
+		}
+	}
+	userRepo := &mockUserRepo{GetUserFn: func(id string) (*entity.User, error) {
+		if id == "s1" {
+			return &entity.User{ID: id, Team: "backup", IsActive: true}, nil
+		}
+		return nil, nil
+	}}
+
+	tests := []struct {
+		name     string
+		teamName string
+		fallback []string
+		shared   []string
+		wantErr  bool
+		errMsg   string
+	}{
+		{name: "team_not_found", teamName: "missing", wantErr: true, errMsg: "team not found"},
+		{name: "self_fallback", teamName: "team1", fallback: []string{"team1"}, wantErr: true, errMsg: "team cannot be its own fallback"},
+		{name: "duplicate_fallback", teamName: "team1", fallback: []string{"backup", "backup"}, wantErr: true, errMsg: "duplicate fallback team"},
+		{name: "unknown_fallback", teamName: "team1", fallback: []string{"ghost"}, wantErr: true, errMsg: "fallback team ghost not found"},
+		{name: "unknown_shared_reviewer", teamName: "team1", shared: []string{"nobody"}, wantErr: true, errMsg: "shared reviewer nobody not found"},
+		{name: "success", teamName: "team1", fallback: []string{"backup"}, shared: []string{"s1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved []string
+			svc := service.NewTeamService(teamRepo(&saved), userRepo)
+
+			team, err := svc.SetReviewerPools(tt.teamName, tt.fallback, tt.shared)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if saved != nil {
+					t.Fatalf("pools must not be saved on validation error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Join(saved, ",") != "backup" || strings.Join(team.SharedReviewers, ",") != "s1" {
+				t.Fatalf("unexpected pools: saved %v, team %+v", saved, team)
+			}
+		})
+	}
+}
+
+func TestTeamService_SetPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *entity.TeamPolicy
+		wantErr bool
+		errMsg  string
+	}{
+		{name: "negative_reviewers", policy: &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: -1}, wantErr: true, errMsg: "required_reviewers must be between"},
+		{name: "too_many_reviewers", policy: &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 11}, wantErr: true, errMsg: "required_reviewers must be between"},
+		{name: "approvals_exceed_reviewers", policy: &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 1, RequiredApprovals: 2}, wantErr: true, errMsg: "required_approvals must be between"},
+		{name: "invalid_strategy", policy: &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 2, SelectionStrategy: "round_robin"}, wantErr: true, errMsg: "selection_strategy must be one of"},
+		{name: "team_not_found", policy: &entity.TeamPolicy{TeamName: "missing", RequiredReviewers: 2}, wantErr: true, errMsg: "team not found"},
+		{name: "invalid_rule_grade", policy: &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 2, CompositionRules: []entity.CompositionRule{{MinGrade: "principal", AtLeast: 1}}}, wantErr: true, errMsg: "composition rule grades must be one of"},
+		{name: "rule_bounds_reversed", policy: &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 2, CompositionRules: []entity.CompositionRule{{MaxGrade: entity.GradeJunior, AtLeast: 2, AtMost: intPtr(1)}}}, wantErr: true, errMsg: "at_most cannot be less than at_least"},
+		{name: "rule_exceeds_reviewers", policy: &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 1, CompositionRules: []entity.CompositionRule{{MinGrade: entity.GradeSenior, AtLeast: 2}}}, wantErr: true, errMsg: "at_least must be between 0 and required_reviewers"},
+		{name: "rule_grades_reversed", policy: &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 2, CompositionRules: []entity.CompositionRule{{MinGrade: entity.GradeLead, MaxGrade: entity.GradeJunior}}}, wantErr: true, errMsg: "min_grade cannot be above max_grade"},
+		{name: "unknown_security_team", policy: &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 2, SecurityTeam: "missing"}, wantErr: true, errMsg: "team not found"},
+		{name: "negative_max_open_reviews", policy: &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 2, MaxOpenReviews: -1}, wantErr: true, errMsg: "max_open_reviews must be between 0 and 100"},
+		{name: "too_many_max_open_reviews", policy: &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 2, MaxOpenReviews: 101}, wantErr: true, errMsg: "max_open_reviews must be between 0 and 100"},
+		{name: "success", policy: &entity.TeamPolicy{TeamName: "team1", RequiredReviewers: 3, RequiredApprovals: 2, AllowSelfMerge: false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *entity.TeamPolicy
+			repo := &mockTeamRepo{
+				GetTeamFn: func(name string) (*entity.Team, error) {
+					if name != "team1" {
+						return nil, nil
+					}
+					return &entity.Team{Name: name}, nil
+				},
+				SavePolicyFn: func(p *entity.TeamPolicy) error {
+					saved = p
+					return nil
+				},
+			}
+			svc := service.NewTeamService(repo, &mockUserRepo{})
+
+			policy, err := svc.SetPolicy(tt.policy)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("expected error %q, got %v", tt.errMsg, err)
+				}
+				if saved != nil {
+					t.Fatalf("policy must not be saved on error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if saved == nil || policy.SelectionStrategy != entity.SelectionStrategyRandom {
+				t.Fatalf("expected saved policy with default strategy, got %+v", policy)
+			}
+		})
+	}
+}
+
+func TestTeamService_GetPolicyDefaults(t *testing.T) {
+	repo := &mockTeamRepo{GetTeamFn: func(name string) (*entity.Team, error) {
+		return &entity.Team{Name: name, ReassignOnDeactivation: true}, nil
+	}}
+	svc := service.NewTeamService(repo, &mockUserRepo{})
+
+	policy, err := svc.GetPolicy("team1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policy.RequiredReviewers != 2 || !policy.AllowSelfMerge || policy.RequiredApprovals != 0 ||
+		policy.SelectionStrategy != entity.SelectionStrategyRandom || !policy.ReassignOnDeactivation {
+		t.Fatalf("unexpected default policy %+v", policy)
+	}
+}
+
+func hierarchyTeamRepo(parents map[string]string) *mockTeamRepo {
+	return &mockTeamRepo{
+		GetTeamFn: func(name string) (*entity.Team, error) {
+			if _, ok := parents[name]; !ok {
+				return nil, nil
+			}
+			return &entity.Team{Name: name, ParentTeam: parents[name]}, nil
+		},
+		GetSubTeamsFn: func(name string) ([]string, error) {
+			children := make([]string, 0)
+			for _, team := range []string{"eng", "backend", "frontend", "api", "ops"} {
+				if parent, ok := parents[team]; ok && parent == name {
+					children = append(children, team)
+				}
+			}
+			return children, nil
+		},
+		GetTeamAncestorsFn: func(name string, maxDepth int) ([]string, error) {
+			ancestors := make([]string, 0)
+			for parent := parents[name]; parent != "" && len(ancestors) < maxDepth; parent = parents[parent] {
+				ancestors = append(ancestors, parent)
+			}
+			return ancestors, nil
+		},
+	}
+}
+
+func TestTeamService_SetParentTeam(t *testing.T) {
+	parents := map[string]string{"eng": "", "backend": "eng", "frontend": "eng", "api": "backend", "ops": ""}
+
+	tests := []struct {
+		name       string
+		team       string
+		parent     string
+		wantErr    bool
+		errMsg     string
+		wantParent string
+	}{
+		{name: "unknown_team", team: "ghost", parent: "eng", wantErr: true, errMsg: "team not found"},
+		{name: "unknown_parent", team: "ops", parent: "ghost", wantErr: true, errMsg: "team not found"},
+		{name: "self_parent", team: "ops", parent: "ops", wantErr: true, errMsg: "team cannot be its own parent"},
+		{name: "cycle", team: "eng", parent: "api", wantErr: true, errMsg: "team hierarchy cannot contain cycles"},
+		{name: "success", team: "ops", parent: "eng", wantParent: "eng"},
+		{name: "detach", team: "backend", parent: "", wantParent: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := make(map[string]string, len(parents))
+			for k, v := range parents {
+				current[k] = v
+			}
+			repo := hierarchyTeamRepo(current)
+			repo.SetParentTeamFn = func(name, parent string) error {
+				current[name] = parent
+				return nil
+			}
+			svc := service.NewTeamService(repo, &mockUserRepo{})
+
+			team, err := svc.SetParentTeam(tt.team, tt.parent)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("expected error containing %q, got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if team.ParentTeam != tt.wantParent {
+				t.Fatalf("expected parent %q, got %q", tt.wantParent, team.ParentTeam)
+			}
+		})
+	}
+}
+
+func TestTeamService_SetParentTeamDepthLimit(t *testing.T) {
+	parents := map[string]string{"t0": ""}
+	for i := 1; i < 10; i++ {
+		parents["t"+strings.Repeat("x", i)] = ""
+	}
+	chain := []string{"t0"}
+	for i := 1; i < 10; i++ {
+		name := "t" + strings.Repeat("x", i)
+		parents[name] = chain[len(chain)-1]
+		chain = append(chain, name)
+	}
+	parents["leaf"] = ""
+	repo := hierarchyTeamRepo(parents)
+	svc := service.NewTeamService(repo, &mockUserRepo{})
+
+	_, err := svc.SetParentTeam("leaf", chain[len(chain)-1])
+	if err == nil || !strings.Contains(err.Error(), "cannot be deeper than 10 levels") {
+		t.Fatalf("expected depth error, got %v", err)
+	}
+	if _, err := svc.SetParentTeam("leaf", chain[len(chain)-2]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTeamService_HierarchyAndStats(t *testing.T) {
+	repo := hierarchyTeamRepo(map[string]string{"eng": "", "backend": "eng", "frontend": "eng", "api": "backend", "ops": ""})
+	var statsTeams []string
+	repo.GetTeamStatsFn = func(names []string) (*entity.TeamStats, error) {
+		statsTeams = names
+		return &entity.TeamStats{Teams: names, Members: 7}, nil
+	}
+	svc := service.NewTeamService(repo, &mockUserRepo{})
+
+	hierarchy, err := svc.GetHierarchy("backend")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(hierarchy.Ancestors, ",") != "eng" || len(hierarchy.SubTeams) != 1 || hierarchy.SubTeams[0].Name != "api" {
+		t.Fatalf("unexpected hierarchy %+v", hierarchy)
+	}
+
+	team, err := svc.GetTeamWithSubTeams("eng")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(team.SubTeams) != 2 || team.SubTeams[0].Name != "backend" || len(team.SubTeams[0].SubTeams) != 1 {
+		t.Fatalf("unexpected sub-teams %+v", team.SubTeams)
+	}
+
+	stats, err := svc.GetStats("eng", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats.TeamName != "eng" || strings.Join(statsTeams, ",") != "eng,backend,api,frontend" {
+		t.Fatalf("expected stats over subtree, got %+v for %v", stats, statsTeams)
+	}
+
+	if _, err := svc.GetStats("eng", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(statsTeams, ",") != "eng" {
+		t.Fatalf("expected stats for team only, got %v", statsTeams)
+	}
+}
@@ -5,18 +5,29 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
+	"pr-review/internal/config"
 	"pr-review/internal/entity"
+	"pr-review/internal/repo"
 	"pr-review/internal/service"
 )
 
 
 type mockUserRepo struct {
-	GetUserFn              func(string) (*entity.User, error)
-	CreateOrUpdateUserFn   func(*entity.User) error
-	UpdateUserFn           func(*entity.User) error
-	GetUsersByTeamFn       func(string) ([]*entity.User, error)
-	GetActiveUsersByTeamFn func(string) ([]*entity.User, error)
+	GetUserFn                     func(string) (*entity.User, error)
+	GetUserIncludingDeletedFn     func(string) (*entity.User, error)
+	CreateOrUpdateUserFn          func(*entity.User) error
+	UpdateUserFn                  func(*entity.User) error
+	UpdateUserWithReassignmentsFn func(*entity.User, []entity.ReviewerReassignment) error
+	GetUsersByTeamFn              func(string) ([]*entity.User, error)
+	GetActiveUsersByTeamFn        func(string) ([]*entity.User, error)
+	SetWorkScheduleFn             func(string, *entity.WorkSchedule) error
+	GetWorkSchedulesFn            func([]string) (map[string]*entity.WorkSchedule, error)
+	ListUsersFn                   func(entity.UserFilter) ([]*entity.User, error)
+	UpdateUsernameFn              func(string, string) error
+	GetDeletedUserIDsFn           func([]string) ([]string, error)
+	DeleteUserFn                  func(string, []entity.ReviewerReassignment, time.Time) error
 }
 
 func (m *mockUserRepo) GetUser(userID string) (*entity.User, error) {
@@ -25,6 +36,12 @@ func (m *mockUserRepo) GetUser(userID string) (*entity.User, error) {
 	}
 	return nil, nil
 }
+func (m *mockUserRepo) GetUserIncludingDeleted(userID string) (*entity.User, error) {
+	if m.GetUserIncludingDeletedFn != nil {
+		return m.GetUserIncludingDeletedFn(userID)
+	}
+	return m.GetUser(userID)
+}
 func (m *mockUserRepo) CreateOrUpdateUser(user *entity.User) error {
 	if m.CreateOrUpdateUserFn != nil {
 		return m.CreateOrUpdateUserFn(user)
@@ -37,6 +54,12 @@ func (m *mockUserRepo) UpdateUser(user *entity.User) error {
 	}
 	return nil
 }
+func (m *mockUserRepo) UpdateUserWithReassignments(user *entity.User, reassignments []entity.ReviewerReassignment) error {
+	if m.UpdateUserWithReassignmentsFn != nil {
+		return m.UpdateUserWithReassignmentsFn(user, reassignments)
+	}
+	return nil
+}
 func (m *mockUserRepo) GetUsersByTeam(teamName string) ([]*entity.User, error) {
 	if m.GetUsersByTeamFn != nil {
 		return m.GetUsersByTeamFn(teamName)
@@ -51,12 +74,54 @@ func (m *mockUserRepo) GetActiveUsersByTeam(teamName string) ([]*entity.User, er
 }
 
 
+func (m *mockUserRepo) SetWorkSchedule(userID string, schedule *entity.WorkSchedule) error {
+	if m.SetWorkScheduleFn != nil {
+		return m.SetWorkScheduleFn(userID, schedule)
+	}
+	return nil
+}
+func (m *mockUserRepo) GetWorkSchedules(userIDs []string) (map[string]*entity.WorkSchedule, error) {
+	if m.GetWorkSchedulesFn != nil {
+		return m.GetWorkSchedulesFn(userIDs)
+	}
+	return nil, nil
+}
+func (m *mockUserRepo) ListUsers(filter entity.UserFilter) ([]*entity.User, error) {
+	if m.ListUsersFn != nil {
+		return m.ListUsersFn(filter)
+	}
+	return nil, nil
+}
+func (m *mockUserRepo) UpdateUsername(userID, username string) error {
+	if m.UpdateUsernameFn != nil {
+		return m.UpdateUsernameFn(userID, username)
+	}
+	return nil
+}
+func (m *mockUserRepo) GetDeletedUserIDs(userIDs []string) ([]string, error) {
+	if m.GetDeletedUserIDsFn != nil {
+		return m.GetDeletedUserIDsFn(userIDs)
+	}
+	return nil, nil
+}
+func (m *mockUserRepo) DeleteUser(userID string, reassignments []entity.ReviewerReassignment, deletedAt time.Time) error {
+	if m.DeleteUserFn != nil {
+		return m.DeleteUserFn(userID, reassignments, deletedAt)
+	}
+	return nil
+}
+
 type mockPRRepo struct {
-	CreatePRFn         func(*entity.PullRequest) error
-	GetPRFn            func(string) (*entity.PullRequest, error)
-	UpdatePRFn         func(*entity.PullRequest) error
-	PRExistsFn         func(string) (bool, error)
-	GetPRsByReviewerFn func(string) ([]*entity.PullRequest, error)
+	CreatePRFn                func(*entity.PullRequest) error
+	GetPRFn                   func(string) (*entity.PullRequest, error)
+	UpdatePRFn                func(*entity.PullRequest) (bool, error)
+	UpdatePRMetadataFn        func(*entity.PullRequest, int) (bool, error)
+	PRExistsFn                func(string) (bool, error)
+	GetPRsByReviewerFn        func(string) ([]*entity.PullRequest, error)
+	GetReviewersWithOpenPRsFn func() ([]string, error)
+	AddApprovalFn             func(string, string) error
+	CountApprovalsFn          func(string) (int, error)
+	CountOpenReviewsFn        func([]string) (map[string]int, error)
 }
 
 func (m *mockPRRepo) CreatePR(pr *entity.PullRequest) error {
@@ -71,11 +136,17 @@ func (m *mockPRRepo) GetPR(prID string) (*entity.PullRequest, error) {
 	}
 	return nil, nil
 }
-func (m *mockPRRepo) UpdatePR(pr *entity.PullRequest) error {
+func (m *mockPRRepo) UpdatePR(pr *entity.PullRequest) (bool, error) {
 	if m.UpdatePRFn != nil {
 		return m.UpdatePRFn(pr)
 	}
-	return nil
+	return true, nil
+}
+func (m *mockPRRepo) UpdatePRMetadata(pr *entity.PullRequest, version int) (bool, error) {
+	if m.UpdatePRMetadataFn != nil {
+		return m.UpdatePRMetadataFn(pr, version)
+	}
+	return true, nil
 }
 func (m *mockPRRepo) PRExists(prID string) (bool, error) {
 	if m.PRExistsFn != nil {
@@ -89,6 +160,30 @@ func (m *mockPRRepo) GetPRsByReviewer(userID string) ([]*entity.PullRequest, err
 	}
 	return nil, nil
 }
+func (m *mockPRRepo) GetReviewersWithOpenPRs() ([]string, error) {
+	if m.GetReviewersWithOpenPRsFn != nil {
+		return m.GetReviewersWithOpenPRsFn()
+	}
+	return nil, nil
+}
+func (m *mockPRRepo) AddApproval(prID, reviewerID string) error {
+	if m.AddApprovalFn != nil {
+		return m.AddApprovalFn(prID, reviewerID)
+	}
+	return nil
+}
+func (m *mockPRRepo) CountApprovals(prID string) (int, error) {
+	if m.CountApprovalsFn != nil {
+		return m.CountApprovalsFn(prID)
+	}
+	return 0, nil
+}
+func (m *mockPRRepo) CountOpenReviews(userIDs []string) (map[string]int, error) {
+	if m.CountOpenReviewsFn != nil {
+		return m.CountOpenReviewsFn(userIDs)
+	}
+	return map[string]int{}, nil
+}
 
 func TestUserService_SetIsActive(t *testing.T) {
 	longID := strings.Repeat("a", 256)
@@ -97,6 +192,7 @@ func TestUserService_SetIsActive(t *testing.T) {
 		name     string
 		userID   string
 		isActive bool
+		reassign *bool
 		repo     *mockUserRepo
 		wantErr  bool
 		errMsg   string
@@ -165,9 +261,10 @@ func TestUserService_SetIsActive(t *testing.T) {
 				repo = &mockUserRepo{}
 			}
 
-			svc := service.NewUserService(repo, nil)
+			prService := service.NewPullRequestService(&mockPRRepo{}, repo, &mockTeamRepo{}, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+			svc := service.NewUserService(repo, prService)
 
-			u, err := svc.SetIsActive(tt.userID, tt.isActive)
+			u, _, err := svc.SetIsActive(tt.userID, tt.isActive, tt.reassign)
 
 			if tt.wantErr {
 				if err == nil {
@@ -200,6 +297,231 @@ func TestUserService_SetIsActive(t *testing.T) {
 	}
 }
 
+func TestUserService_SetIsActive_Handover(t *testing.T) {
+	yes, no := true, false
+
+	openPRs := func(string) ([]*entity.PullRequest, error) {
+		return []*entity.PullRequest{
+			{ID: "p1", AuthorID: "a1", Status: entity.StatusOpen, AssignedReviewers: []string{"u1", "r1"}},
+			{ID: "p2", AuthorID: "r2", Status: entity.StatusOpen, AssignedReviewers: []string{"u1", "r1"}},
+			{ID: "p3", AuthorID: "a1", Status: entity.StatusMerged, AssignedReviewers: []string{"u1"}},
+		}, nil
+	}
+
+	tests := []struct {
+		name           string
+		reassign       *bool
+		teamDefault    bool
+		wantHandover   bool
+		wantReassigned map[string]string
+		wantUnreplaced []string
+	}{
+		{name: "explicit_flag", reassign: &yes, wantHandover: true, wantReassigned: map[string]string{"p1": "r2"}, wantUnreplaced: []string{"p2"}},
+		{name: "team_default", teamDefault: true, wantHandover: true, wantReassigned: map[string]string{"p1": "r2"}, wantUnreplaced: []string{"p2"}},
+		{name: "flag_overrides_team_default", reassign: &no, teamDefault: true, wantHandover: false},
+		{name: "disabled_by_default", wantHandover: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var plainUpdates int
+			var applied []entity.ReviewerReassignment
+			userRepo := &mockUserRepo{
+				GetUserFn: func(id string) (*entity.User, error) {
+					return &entity.User{ID: id, Name: id, Team: "team1", IsActive: true}, nil
+				},
+				GetActiveUsersByTeamFn: func(string) ([]*entity.User, error) { return makeMembers("u1", "r1", "r2"), nil },
+				UpdateUserFn: func(*entity.User) error {
+					plainUpdates++
+					return nil
+				},
+				UpdateUserWithReassignmentsFn: func(u *entity.User, r []entity.ReviewerReassignment) error {
+					if u.IsActive {
+						return errors.New("user must be deactivated with reassignments")
+					}
+					applied = r
+					return nil
+				},
+			}
+			teamRepo := &mockTeamRepo{GetTeamFn: func(string) (*entity.Team, error) {
+				return &entity.Team{Name: "team1", ReassignOnDeactivation: tt.teamDefault}, nil
+			}}
+			prService := service.NewPullRequestService(&mockPRRepo{GetPRsByReviewerFn: openPRs}, userRepo, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+			svc := service.NewUserService(userRepo, prService)
+
+			u, report, err := svc.SetIsActive("u1", false, tt.reassign)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if u.IsActive {
+				t.Fatalf("expected user to be deactivated")
+			}
+
+			if !tt.wantHandover {
+				if report != nil {
+					t.Fatalf("expected no handover report, got %+v", report)
+				}
+				if plainUpdates != 1 {
+					t.Fatalf("expected plain update, got %d", plainUpdates)
+				}
+				return
+			}
+
+			if report == nil {
+				t.Fatalf("expected handover report")
+			}
+			if len(applied) != len(tt.wantReassigned) {
+				t.Fatalf("expected %d applied reassignments, got %d", len(tt.wantReassigned), len(applied))
+			}
+			for _, r := range report.Reassignments {
+				if tt.wantReassigned[r.PullRequestID] != r.NewReviewerID || r.OldReviewerID != "u1" {
+					t.Fatalf("unexpected reassignment %+v", r)
+				}
+			}
+			if strings.Join(report.UnreplacedPRs, ",") != strings.Join(tt.wantUnreplaced, ",") {
+				t.Fatalf("expected unreplaced %v, got %v", tt.wantUnreplaced, report.UnreplacedPRs)
+			}
+		})
+	}
+}
+
+func TestUserService_SetIsActive_ReplansStaleHandover(t *testing.T) {
+	yes := true
+	tests := []struct {
+		name        string
+		staleWrites int
+		wantErr     bool
+		wantPlans   int
+	}{
+		{name: "applied_first_time", staleWrites: 0, wantPlans: 1},
+		{name: "replanned_after_concurrent_change", staleWrites: 1, wantPlans: 2},
+		{name: "gives_up_after_repeated_changes", staleWrites: config.HandoverAttempts, wantErr: true, wantPlans: config.HandoverAttempts},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plans, writes := 0, 0
+			reviewers := []string{"u1", "r1"}
+			prRepo := &mockPRRepo{GetPRsByReviewerFn: func(string) ([]*entity.PullRequest, error) {
+				plans++
+				return []*entity.PullRequest{{ID: "p1", AuthorID: "a1", Status: entity.StatusOpen, AssignedReviewers: reviewers}}, nil
+			}}
+			var applied []entity.ReviewerReassignment
+			userRepo := &mockUserRepo{
+				GetUserFn: func(id string) (*entity.User, error) {
+					return &entity.User{ID: id, Name: id, Team: "team1", IsActive: true}, nil
+				},
+				GetActiveUsersByTeamFn: func(string) ([]*entity.User, error) { return makeMembers("u1", "r1", "r2", "r3"), nil },
+				UpdateUserWithReassignmentsFn: func(_ *entity.User, r []entity.ReviewerReassignment) error {
+					writes++
+					if writes <= tt.staleWrites {
+						reviewers = []string{"u1", "r1", r[0].NewReviewerID}
+						return repo.ErrReviewerChanged
+					}
+					applied = r
+					return nil
+				},
+			}
+			teamRepo := &mockTeamRepo{GetTeamFn: func(string) (*entity.Team, error) { return &entity.Team{Name: "team1"}, nil }}
+			prService := service.NewPullRequestService(prRepo, userRepo, teamRepo, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+			svc := service.NewUserService(userRepo, prService)
+
+			_, report, err := svc.SetIsActive("u1", false, &yes)
+			if plans != tt.wantPlans {
+				t.Errorf("expected %d plans, got %d", tt.wantPlans, plans)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, repo.ErrReviewerChanged) {
+					t.Fatalf("expected ErrReviewerChanged, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(applied) != 1 || len(report.Reassignments) != 1 || report.Reassignments[0] != applied[0] {
+				t.Fatalf("expected report to match applied reassignments, got %+v vs %+v", report.Reassignments, applied)
+			}
+			for _, reviewer := range reviewers {
+				if reviewer == applied[0].NewReviewerID {
+					t.Fatalf("expected replan to skip reviewer %s already on the PR", reviewer)
+				}
+			}
+		})
+	}
+}
+
+func TestUserService_SetWorkSchedule(t *testing.T) {
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	existingUser := &mockUserRepo{GetUserFn: func(id string) (*entity.User, error) { return &entity.User{ID: id}, nil }}
+
+	tests := []struct {
+		name     string
+		userID   string
+		schedule *entity.WorkSchedule
+		repo     *mockUserRepo
+		wantErr  bool
+		errMsg   string
+	}{
+		{name: "empty_user_id", userID: "", schedule: &entity.WorkSchedule{}, wantErr: true, errMsg: "user_id cannot be empty"},
+		{name: "unknown_time_zone", userID: "u1", schedule: &entity.WorkSchedule{TimeZone: "Mars/Olympus", Start: "09:00", End: "18:00", Days: weekdays}, wantErr: true, errMsg: "unknown time_zone"},
+		{name: "bad_start", userID: "u1", schedule: &entity.WorkSchedule{TimeZone: "Europe/Moscow", Start: "9am", End: "18:00", Days: weekdays}, wantErr: true, errMsg: "work_start must be in HH:MM"},
+		{name: "empty_shift", userID: "u1", schedule: &entity.WorkSchedule{TimeZone: "Europe/Moscow", Start: "09:00", End: "09:00", Days: weekdays}, wantErr: true, errMsg: "work_end must differ from work_start"},
+		{name: "no_days", userID: "u1", schedule: &entity.WorkSchedule{TimeZone: "Europe/Moscow", Start: "09:00", End: "18:00"}, wantErr: true, errMsg: "work_days cannot be empty"},
+		{name: "invalid_day", userID: "u1", schedule: &entity.WorkSchedule{TimeZone: "Europe/Moscow", Start: "09:00", End: "18:00", Days: []time.Weekday{7}}, wantErr: true, errMsg: "work_days must contain values"},
+		{name: "duplicate_day", userID: "u1", schedule: &entity.WorkSchedule{TimeZone: "Europe/Moscow", Start: "09:00", End: "18:00", Days: []time.Weekday{1, 1}}, wantErr: true, errMsg: "duplicates"},
+		{name: "user_not_found", userID: "u1", schedule: &entity.WorkSchedule{TimeZone: "Asia/Yerevan", Start: "09:00", End: "18:00", Days: weekdays}, repo: &mockUserRepo{}, wantErr: true, errMsg: "user not found"},
+		{name: "repo_error", userID: "u1", schedule: &entity.WorkSchedule{TimeZone: "Asia/Novosibirsk", Start: "09:00", End: "18:00", Days: weekdays}, repo: &mockUserRepo{GetUserFn: existingUser.GetUserFn, SetWorkScheduleFn: func(string, *entity.WorkSchedule) error { return errors.New("save failed") }}, wantErr: true, errMsg: "save failed"},
+		{name: "success", userID: "u1", schedule: &entity.WorkSchedule{TimeZone: "Asia/Novosibirsk", Start: "09:00", End: "18:00", Days: weekdays}, repo: existingUser},
+		{name: "overnight_shift", userID: "u1", schedule: &entity.WorkSchedule{TimeZone: "Europe/Moscow", Start: "22:00", End: "06:00", Days: weekdays}, repo: existingUser},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo
+			if repo == nil {
+				repo = &mockUserRepo{}
+			}
+			svc := service.NewUserService(repo, nil)
+
+			schedule, err := svc.SetWorkSchedule(tt.userID, tt.schedule)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if schedule == nil {
+				t.Fatalf("expected schedule, got nil")
+			}
+		})
+	}
+}
+
+func TestUserService_SetWorkSchedule_ValidationFailed(t *testing.T) {
+	svc := service.NewUserService(&mockUserRepo{}, nil)
+
+	_, err := svc.SetWorkSchedule("u1", &entity.WorkSchedule{TimeZone: "Europe/Moscow", Start: "18:00", End: "18:00", Days: []time.Weekday{time.Monday}})
+
+	var derr *entity.DomainError
+	if !errors.As(err, &derr) || derr.Code != entity.ErrorCodeValidationFailed {
+		t.Fatalf("expected VALIDATION_FAILED, got %v", err)
+	}
+	if len(derr.Violations) != 1 {
+		t.Fatalf("expected one violation, got %+v", derr.Violations)
+	}
+	violation := derr.Violations[0]
+	if violation.Field != "work_end" || violation.Rule != entity.RuleConsistent || violation.Message != "work_end must differ from work_start" {
+		t.Fatalf("unexpected violation %+v", violation)
+	}
+}
+
 func TestUserService_GetReviewPRs(t *testing.T) {
 	longID := strings.Repeat("a", 256)
 
@@ -233,10 +555,10 @@ func TestUserService_GetReviewPRs(t *testing.T) {
 				prRepo = &mockPRRepo{}
 			}
 
-			prService := service.NewPullRequestService(prRepo, userRepo, nil)
+			prService := service.NewPullRequestService(prRepo, userRepo, nil, nil, nil, nil, nil)
 			svc := service.NewUserService(userRepo, prService)
 
-			prs, err := svc.GetReviewPRs(tt.userID)
+			prs, err := svc.GetReviewPRs(tt.userID, entity.ReviewQueueFilter{})
 
 			if tt.wantErr {
 				if err == nil {
@@ -264,3 +586,163 @@ func TestUserService_GetReviewPRs(t *testing.T) {
 		})
 	}
 }
+
+func TestUserService_ListUsers(t *testing.T) {
+	users := []*entity.User{
+		{ID: "u1", Name: "Alice", Team: "t1"},
+		{ID: "u2", Name: "Bob", Team: "t1"},
+		{ID: "u3", Name: "Carol", Team: "t1"},
+	}
+
+	tests := []struct {
+		name       string
+		filter     entity.UserFilter
+		wantLimit  int
+		wantIDs    []string
+		wantNext   int
+		wantErrMsg string
+	}{
+		{name: "default_limit", filter: entity.UserFilter{TeamName: "t1"}, wantLimit: 50, wantIDs: []string{"u1", "u2", "u3"}},
+		{name: "first_page", filter: entity.UserFilter{Limit: 2}, wantLimit: 2, wantIDs: []string{"u1", "u2"}, wantNext: 2},
+		{name: "last_page", filter: entity.UserFilter{Limit: 2, Offset: 2}, wantLimit: 2, wantIDs: []string{"u3"}},
+		{name: "limit_too_large", filter: entity.UserFilter{Limit: 101}, wantErrMsg: "limit must be between 1 and 100"},
+		{name: "negative_offset", filter: entity.UserFilter{Offset: -1}, wantErrMsg: "offset cannot be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested entity.UserFilter
+			userRepo := &mockUserRepo{
+				ListUsersFn: func(filter entity.UserFilter) ([]*entity.User, error) {
+					requested = filter
+					end := filter.Offset + filter.Limit
+					if end > len(users) {
+						end = len(users)
+					}
+					return users[filter.Offset:end], nil
+				},
+			}
+			svc := service.NewUserService(userRepo, nil)
+
+			page, err := svc.ListUsers(tt.filter)
+			if tt.wantErrMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrMsg) {
+					t.Fatalf("expected error %q, got %v", tt.wantErrMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if requested.Limit != tt.wantLimit+1 {
+				t.Fatalf("expected repository limit %d, got %d", tt.wantLimit+1, requested.Limit)
+			}
+			if page.Limit != tt.wantLimit || len(page.Users) != len(tt.wantIDs) {
+				t.Fatalf("unexpected page: limit=%d users=%d", page.Limit, len(page.Users))
+			}
+			for i, id := range tt.wantIDs {
+				if page.Users[i].ID != id {
+					t.Fatalf("expected user %s at %d, got %s", id, i, page.Users[i].ID)
+				}
+			}
+			if tt.wantNext == 0 && page.NextOffset != nil {
+				t.Fatalf("expected no next page, got %d", *page.NextOffset)
+			}
+			if tt.wantNext != 0 && (page.NextOffset == nil || *page.NextOffset != tt.wantNext) {
+				t.Fatalf("expected next offset %d, got %v", tt.wantNext, page.NextOffset)
+			}
+		})
+	}
+}
+
+func TestUserService_UpdateUsername(t *testing.T) {
+	var updated string
+	userRepo := &mockUserRepo{
+		GetUserFn: func(id string) (*entity.User, error) {
+			if id != "u1" {
+				return nil, nil
+			}
+			return &entity.User{ID: "u1", Name: "Alice", Team: "t1", IsActive: true}, nil
+		},
+		UpdateUsernameFn: func(_ string, username string) error {
+			updated = username
+			return nil
+		},
+		UpdateUserFn: func(*entity.User) error {
+			t.Fatalf("username change must not rewrite the team membership")
+			return nil
+		},
+		CreateOrUpdateUserFn: func(*entity.User) error {
+			t.Fatalf("username change must not upsert the user")
+			return nil
+		},
+	}
+	svc := service.NewUserService(userRepo, nil)
+
+	if _, err := svc.UpdateUsername("missing", "Bob"); err == nil || !strings.Contains(err.Error(), "user not found") {
+		t.Fatalf("expected user not found, got %v", err)
+	}
+	if _, err := svc.UpdateUsername("u1", strings.Repeat("a", 256)); err == nil || !strings.Contains(err.Error(), "cannot exceed 255") {
+		t.Fatalf("expected length error, got %v", err)
+	}
+
+	user, err := svc.UpdateUsername("u1", "Alicia")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != "Alicia" || user.Name != "Alicia" || user.Team != "t1" {
+		t.Fatalf("unexpected update: stored=%q user=%+v", updated, user)
+	}
+}
+
+func TestUserService_DeleteUser(t *testing.T) {
+	deleted := map[string]bool{"gone": true}
+	var applied []entity.ReviewerReassignment
+	var deletedAt time.Time
+	userRepo := &mockUserRepo{
+		GetUserFn: func(id string) (*entity.User, error) {
+			if deleted[id] {
+				return nil, nil
+			}
+			return &entity.User{ID: id, Name: id, Team: "team1", IsActive: true}, nil
+		},
+		GetActiveUsersByTeamFn: func(string) ([]*entity.User, error) { return makeMembers("u1", "r1", "r2"), nil },
+		DeleteUserFn: func(id string, r []entity.ReviewerReassignment, at time.Time) error {
+			deleted[id] = true
+			applied = r
+			deletedAt = at
+			return nil
+		},
+		UpdateUserWithReassignmentsFn: func(*entity.User, []entity.ReviewerReassignment) error {
+			t.Fatalf("deletion must not go through the deactivation path")
+			return nil
+		},
+	}
+	prRepo := &mockPRRepo{GetPRsByReviewerFn: func(string) ([]*entity.PullRequest, error) {
+		return []*entity.PullRequest{
+			{ID: "p1", AuthorID: "a1", Status: entity.StatusOpen, AssignedReviewers: []string{"u1", "r1"}},
+			{ID: "p2", AuthorID: "r2", Status: entity.StatusOpen, AssignedReviewers: []string{"u1", "r1"}},
+			{ID: "p3", AuthorID: "a1", Status: entity.StatusMerged, AssignedReviewers: []string{"u1"}},
+		}, nil
+	}}
+	prService := service.NewPullRequestService(prRepo, userRepo, &mockTeamRepo{}, &mockAvailabilityRepo{}, &mockAffinityRepo{}, &mockRepositoryRepo{}, &mockExpertiseRepo{})
+	svc := service.NewUserService(userRepo, prService)
+
+	report, err := svc.DeleteUser("u1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(applied) != 1 || applied[0] != (entity.ReviewerReassignment{PullRequestID: "p1", OldReviewerID: "u1", NewReviewerID: "r2"}) {
+		t.Fatalf("unexpected reassignments %+v", applied)
+	}
+	if len(report.UnreplacedPRs) != 1 || report.UnreplacedPRs[0] != "p2" {
+		t.Fatalf("expected p2 to stay unreplaced, got %v", report.UnreplacedPRs)
+	}
+	if deletedAt.IsZero() {
+		t.Fatalf("expected deletion timestamp")
+	}
+
+	if _, err := svc.DeleteUser("u1"); err == nil || !strings.Contains(err.Error(), "user not found") {
+		t.Fatalf("expected deleted user to be gone, got %v", err)
+	}
+}
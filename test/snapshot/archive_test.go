@@ -0,0 +1,209 @@
+package snapshot_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"pr-review/internal/config"
+	"pr-review/internal/entity"
+	"pr-review/internal/snapshot"
+)
+
+func TestArchive_RoundTrip(t *testing.T) {
+	createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	manifest := &entity.SnapshotManifest{
+		SchemaVersion: config.SchemaVersion,
+		CreatedAt:     createdAt,
+		Tables: []*entity.SnapshotTable{
+			{Name: "teams", Columns: []string{"team_name", "org_id"}, Rows: 2, Data: []byte("backend\tdefault\nfrontend\tdefault\n")},
+			{Name: "users", Columns: []string{"user_id"}, Rows: 0, Data: []byte{}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := snapshot.Write(&buf, manifest); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	got, err := snapshot.Read(&buf)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if got.FormatVersion != snapshot.FormatVersion || got.SchemaVersion != config.SchemaVersion || !got.CreatedAt.Equal(createdAt) {
+		t.Fatalf("unexpected manifest: %+v", got)
+	}
+	if len(got.Tables) != 2 {
+		t.Fatalf("expected 2 tables, got %d", len(got.Tables))
+	}
+	for i, table := range got.Tables {
+		want := manifest.Tables[i]
+		if table.Name != want.Name || table.Rows != want.Rows || !bytes.Equal(table.Data, want.Data) {
+			t.Fatalf("table %d: expected %+v, got %+v", i, want, table)
+		}
+		if table.SHA256 != snapshot.Checksum(want.Data) {
+			t.Fatalf("table %s: unexpected checksum %s", table.Name, table.SHA256)
+		}
+	}
+}
+
+func TestArchive_ReadErrors(t *testing.T) {
+	data := []byte("backend\tdefault\n")
+	valid := &entity.SnapshotManifest{
+		FormatVersion: snapshot.FormatVersion,
+		SchemaVersion: config.SchemaVersion,
+		Tables: []*entity.SnapshotTable{
+			{Name: "teams", Columns: []string{"team_name", "org_id"}, Rows: 1, SHA256: snapshot.Checksum(data)},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		archive  []byte
+		manifest func(m entity.SnapshotManifest) entity.SnapshotManifest
+		entries  map[string][]byte
+		wantErr  error
+	}{
+		{
+			name:    "not_gzip",
+			archive: []byte("teams,users"),
+			wantErr: snapshot.ErrUnsupportedFormat,
+		},
+		{
+			name: "checksum_mismatch",
+			entries: map[string][]byte{
+				"tables/teams.copy": []byte("backend\tacme\n"),
+			},
+			wantErr: snapshot.ErrChecksumMismatch,
+		},
+		{
+			name: "format_version",
+			manifest: func(m entity.SnapshotManifest) entity.SnapshotManifest {
+				m.FormatVersion = snapshot.FormatVersion + 1
+				return m
+			},
+			entries: map[string][]byte{"tables/teams.copy": data},
+			wantErr: snapshot.ErrUnsupportedFormat,
+		},
+		{
+			name:    "missing_table",
+			entries: map[string][]byte{},
+			wantErr: snapshot.ErrUnsupportedFormat,
+		},
+		{
+			name: "unexpected_entry",
+			entries: map[string][]byte{
+				"tables/teams.copy": data,
+				"tables/users.copy": data,
+			},
+			wantErr: snapshot.ErrUnsupportedFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			archive := tt.archive
+			if archive == nil {
+				manifest := *valid
+				if tt.manifest != nil {
+					manifest = tt.manifest(manifest)
+				}
+				archive = writeArchive(t, &manifest, tt.entries)
+			}
+
+			_, err := snapshot.Read(bytes.NewReader(archive))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSchemaVersion_MatchesMigrations(t *testing.T) {
+	entries, err := os.ReadDir("../../migrations")
+	if err != nil {
+		t.Fatalf("read migrations: %v", err)
+	}
+
+	pattern := regexp.MustCompile(`^(\d+)_.*\.sql$`)
+	latest := 0
+	for _, entry := range entries {
+		match := pattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		version, _ := strconv.Atoi(match[1])
+		if version > latest {
+			latest = version
+		}
+	}
+
+	if latest != config.SchemaVersion {
+		t.Fatalf("config.SchemaVersion is %d, latest migration is %d", config.SchemaVersion, latest)
+	}
+}
+
+func TestTables_CoverMigrations(t *testing.T) {
+	entries, err := os.ReadDir("../../migrations")
+	if err != nil {
+		t.Fatalf("read migrations: %v", err)
+	}
+
+	included := make(map[string]bool, len(snapshot.Tables))
+	for _, table := range snapshot.Tables {
+		included[table] = true
+	}
+
+	pattern := regexp.MustCompile(`(?i)CREATE TABLE IF NOT EXISTS (\w+)`)
+	for _, entry := range entries {
+		sql, err := os.ReadFile("../../migrations/" + entry.Name())
+		if err != nil {
+			t.Fatalf("read %s: %v", entry.Name(), err)
+		}
+		for _, match := range pattern.FindAllStringSubmatch(string(sql), -1) {
+			if match[1] != "schema_migrations" && !included[match[1]] {
+				t.Errorf("table %s from %s is not included in snapshots", match[1], entry.Name())
+			}
+		}
+	}
+}
+
+func writeArchive(t *testing.T, manifest *entity.SnapshotManifest, entries map[string][]byte) []byte {
+	t.Helper()
+
+	header, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatalf("marshal manifest: %v", err)
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	archive := tar.NewWriter(gz)
+	write := func(name string, data []byte) {
+		if err := archive.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(data))}); err != nil {
+			t.Fatalf("write header: %v", err)
+		}
+		if _, err := archive.Write(data); err != nil {
+			t.Fatalf("write entry: %v", err)
+		}
+	}
+
+	write("manifest.json", header)
+	for name, data := range entries {
+		write(name, data)
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatalf("close tar: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("close gzip: %v", err)
+	}
+	return buf.Bytes()
+}